@@ -7,6 +7,14 @@ import { EmailVerify } from './email-verify';
 import { PasswordReset } from './password-reset';
 import { SignInPasswordless } from './signin-passwordless';
 import { SignInOTP } from './signin-otp';
+import { UserInvite } from './user-invite';
+import { PasswordChanged } from './password-changed';
+import { EmailChanged } from './email-changed';
+import { MfaEnabled } from './mfa-enabled';
+import { MfaDisabled } from './mfa-disabled';
+import { SecurityKeyAdded } from './security-key-added';
+import { PatCreated } from './pat-created';
+import { SignInNewDevice } from './signin-new-device';
 
 function renderEmails(targetLocale: string) {
   const emails = [
@@ -50,6 +58,70 @@ function renderEmails(targetLocale: string) {
       }),
       subject: '<subject>',
     },
+    {
+      name: 'user-invite',
+      body: prettier.format(render(UserInvite()), {
+        parser: 'html',
+        printWidth: 500,
+      }),
+      subject: '<subject>',
+    },
+    {
+      name: 'password-changed',
+      body: prettier.format(render(PasswordChanged()), {
+        parser: 'html',
+        printWidth: 500,
+      }),
+      subject: '<subject>',
+    },
+    {
+      name: 'email-changed',
+      body: prettier.format(render(EmailChanged()), {
+        parser: 'html',
+        printWidth: 500,
+      }),
+      subject: '<subject>',
+    },
+    {
+      name: 'mfa-enabled',
+      body: prettier.format(render(MfaEnabled()), {
+        parser: 'html',
+        printWidth: 500,
+      }),
+      subject: '<subject>',
+    },
+    {
+      name: 'mfa-disabled',
+      body: prettier.format(render(MfaDisabled()), {
+        parser: 'html',
+        printWidth: 500,
+      }),
+      subject: '<subject>',
+    },
+    {
+      name: 'security-key-added',
+      body: prettier.format(render(SecurityKeyAdded()), {
+        parser: 'html',
+        printWidth: 500,
+      }),
+      subject: '<subject>',
+    },
+    {
+      name: 'pat-created',
+      body: prettier.format(render(PatCreated()), {
+        parser: 'html',
+        printWidth: 500,
+      }),
+      subject: '<subject>',
+    },
+    {
+      name: 'signin-new-device',
+      body: prettier.format(render(SignInNewDevice()), {
+        parser: 'html',
+        printWidth: 500,
+      }),
+      subject: '<subject>',
+    },
   ];
 
   const targetFolder = path.resolve(`./email-templates/${targetLocale}`);
@@ -21,6 +21,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 	strictgin "github.com/oapi-codegen/runtime/strictmiddleware/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
@@ -28,6 +29,15 @@ type ServerInterface interface {
 	// Get public keys for JWT verification in JWK Set format
 	// (GET /.well-known/jwks.json)
 	GetJWKs(c *gin.Context)
+	// Purge a user's cached custom claims
+	// (POST /admin/custom-claims/purge)
+	PurgeCustomClaims(c *gin.Context)
+	// Impersonate a user
+	// (POST /admin/impersonate)
+	ImpersonateUser(c *gin.Context)
+	// Invite a user
+	// (POST /admin/invite)
+	InviteUser(c *gin.Context)
 	// Elevate access for an already signed in user using FIDO2 Webauthn
 	// (POST /elevate/webauthn)
 	ElevateWebauthn(c *gin.Context)
@@ -46,6 +56,15 @@ type ServerInterface interface {
 	// Generate TOTP secret
 	// (GET /mfa/totp/generate)
 	ChangeUserMfa(c *gin.Context)
+	// List the user's organizations
+	// (GET /organizations)
+	GetOrganizations(c *gin.Context)
+	// Create an organization
+	// (POST /organizations)
+	CreateOrganization(c *gin.Context)
+	// Invite a user to an organization
+	// (POST /organizations/{organizationId}/invitations)
+	CreateOrganizationInvitation(c *gin.Context, organizationId openapi_types.UUID)
 	// Create a Personal Access Token (PAT)
 	// (POST /pat)
 	CreatePAT(c *gin.Context)
@@ -58,6 +77,9 @@ type ServerInterface interface {
 	// Sign in with an ID token
 	// (POST /signin/idtoken)
 	SignInIdToken(c *gin.Context)
+	// Verify email or SMS OTP for MFA
+	// (POST /signin/mfa/otp)
+	VerifySignInMfaOtp(c *gin.Context)
 	// Verify TOTP for MFA
 	// (POST /signin/mfa/totp)
 	VerifySignInMfaTotp(c *gin.Context)
@@ -79,6 +101,9 @@ type ServerInterface interface {
 	// Sign in with Personal Access Token (PAT)
 	// (POST /signin/pat)
 	SignInPAT(c *gin.Context)
+	// Sign in with phone number and password
+	// (POST /signin/phone-password)
+	SignInPhonePassword(c *gin.Context)
 	// Sign in with an OAuth2 provider
 	// (GET /signin/provider/{provider})
 	SignInProvider(c *gin.Context, provider SignInProviderParamsProvider, params SignInProviderParams)
@@ -100,6 +125,12 @@ type ServerInterface interface {
 	// Sign up with email and password
 	// (POST /signup/email-password)
 	SignUpEmailPassword(c *gin.Context)
+	// Sign up with phone number and password
+	// (POST /signup/phone-password)
+	SignUpPhonePassword(c *gin.Context)
+	// Verify phone number after sign up
+	// (POST /signup/phone-password/verify)
+	VerifySignUpPhonePassword(c *gin.Context)
 	// Sign up with Webauthn
 	// (POST /signup/webauthn)
 	SignUpWebauthn(c *gin.Context)
@@ -109,12 +140,21 @@ type ServerInterface interface {
 	// Refresh access token
 	// (POST /token)
 	RefreshToken(c *gin.Context)
+	// Switch the active organization
+	// (POST /token/organization)
+	RefreshTokenOrganization(c *gin.Context)
 	// Verify JWT token
 	// (POST /token/verify)
 	VerifyToken(c *gin.Context)
+	// Delete the user's account
+	// (DELETE /user)
+	DeleteUser(c *gin.Context)
 	// Get user information
 	// (GET /user)
 	GetUser(c *gin.Context)
+	// Get the user's security history
+	// (GET /user/audit-events)
+	GetUserAuditEvents(c *gin.Context, params GetUserAuditEventsParams)
 	// Deanonymize an anonymous user
 	// (POST /user/deanonymize)
 	DeanonymizeUser(c *gin.Context)
@@ -124,15 +164,33 @@ type ServerInterface interface {
 	// Send verification email
 	// (POST /user/email/send-verification-email)
 	SendVerificationEmail(c *gin.Context)
+	// Export the user's data
+	// (GET /user/export)
+	ExportUser(c *gin.Context)
 	// Manage multi-factor authentication
 	// (POST /user/mfa)
 	VerifyChangeUserMfa(c *gin.Context)
+	// Send an MFA code to change multi-factor authentication
+	// (POST /user/mfa/otp)
+	SendUserMfaOtp(c *gin.Context)
+	// List pending organization invitations
+	// (GET /user/organization-invitations)
+	GetUserOrganizationInvitations(c *gin.Context)
+	// Accept an organization invitation
+	// (POST /user/organization-invitations/{invitationId}/accept)
+	AcceptOrganizationInvitation(c *gin.Context, invitationId openapi_types.UUID)
 	// Change user password
 	// (POST /user/password)
 	ChangeUserPassword(c *gin.Context)
 	// Request password reset
 	// (POST /user/password/reset)
 	SendPasswordResetEmail(c *gin.Context)
+	// Request password reset by SMS
+	// (POST /user/password/reset/sms)
+	SendPasswordResetSms(c *gin.Context)
+	// Reset password with SMS OTP
+	// (POST /user/password/reset/sms/verify)
+	VerifyPasswordResetSms(c *gin.Context)
 	// Initialize adding of a new webauthn security key
 	// (POST /user/webauthn/add)
 	AddSecurityKey(c *gin.Context)
@@ -169,6 +227,51 @@ func (siw *ServerInterfaceWrapper) GetJWKs(c *gin.Context) {
 	siw.Handler.GetJWKs(c)
 }
 
+// PurgeCustomClaims operation middleware
+func (siw *ServerInterfaceWrapper) PurgeCustomClaims(c *gin.Context) {
+
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PurgeCustomClaims(c)
+}
+
+// ImpersonateUser operation middleware
+func (siw *ServerInterfaceWrapper) ImpersonateUser(c *gin.Context) {
+
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImpersonateUser(c)
+}
+
+// InviteUser operation middleware
+func (siw *ServerInterfaceWrapper) InviteUser(c *gin.Context) {
+
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.InviteUser(c)
+}
+
 // ElevateWebauthn operation middleware
 func (siw *ServerInterfaceWrapper) ElevateWebauthn(c *gin.Context) {
 
@@ -255,6 +358,62 @@ func (siw *ServerInterfaceWrapper) ChangeUserMfa(c *gin.Context) {
 	siw.Handler.ChangeUserMfa(c)
 }
 
+// GetOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizations(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOrganizations(c)
+}
+
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateOrganization(c)
+}
+
+// CreateOrganizationInvitation operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationInvitation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter organizationId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateOrganizationInvitation(c, organizationId)
+}
+
 // CreatePAT operation middleware
 func (siw *ServerInterfaceWrapper) CreatePAT(c *gin.Context) {
 
@@ -309,6 +468,19 @@ func (siw *ServerInterfaceWrapper) SignInIdToken(c *gin.Context) {
 	siw.Handler.SignInIdToken(c)
 }
 
+// VerifySignInMfaOtp operation middleware
+func (siw *ServerInterfaceWrapper) VerifySignInMfaOtp(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifySignInMfaOtp(c)
+}
+
 // VerifySignInMfaTotp operation middleware
 func (siw *ServerInterfaceWrapper) VerifySignInMfaTotp(c *gin.Context) {
 
@@ -400,6 +572,19 @@ func (siw *ServerInterfaceWrapper) SignInPAT(c *gin.Context) {
 	siw.Handler.SignInPAT(c)
 }
 
+// SignInPhonePassword operation middleware
+func (siw *ServerInterfaceWrapper) SignInPhonePassword(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SignInPhonePassword(c)
+}
+
 // SignInProvider operation middleware
 func (siw *ServerInterfaceWrapper) SignInProvider(c *gin.Context) {
 
@@ -666,6 +851,32 @@ func (siw *ServerInterfaceWrapper) SignUpEmailPassword(c *gin.Context) {
 	siw.Handler.SignUpEmailPassword(c)
 }
 
+// SignUpPhonePassword operation middleware
+func (siw *ServerInterfaceWrapper) SignUpPhonePassword(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SignUpPhonePassword(c)
+}
+
+// VerifySignUpPhonePassword operation middleware
+func (siw *ServerInterfaceWrapper) VerifySignUpPhonePassword(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifySignUpPhonePassword(c)
+}
+
 // SignUpWebauthn operation middleware
 func (siw *ServerInterfaceWrapper) SignUpWebauthn(c *gin.Context) {
 
@@ -705,6 +916,19 @@ func (siw *ServerInterfaceWrapper) RefreshToken(c *gin.Context) {
 	siw.Handler.RefreshToken(c)
 }
 
+// RefreshTokenOrganization operation middleware
+func (siw *ServerInterfaceWrapper) RefreshTokenOrganization(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RefreshTokenOrganization(c)
+}
+
 // VerifyToken operation middleware
 func (siw *ServerInterfaceWrapper) VerifyToken(c *gin.Context) {
 
@@ -720,6 +944,21 @@ func (siw *ServerInterfaceWrapper) VerifyToken(c *gin.Context) {
 	siw.Handler.VerifyToken(c)
 }
 
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUser(c)
+}
+
 // GetUser operation middleware
 func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
 
@@ -735,6 +974,42 @@ func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
 	siw.Handler.GetUser(c)
 }
 
+// GetUserAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetUserAuditEvents(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserAuditEventsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserAuditEvents(c, params)
+}
+
 // DeanonymizeUser operation middleware
 func (siw *ServerInterfaceWrapper) DeanonymizeUser(c *gin.Context) {
 
@@ -778,6 +1053,21 @@ func (siw *ServerInterfaceWrapper) SendVerificationEmail(c *gin.Context) {
 	siw.Handler.SendVerificationEmail(c)
 }
 
+// ExportUser operation middleware
+func (siw *ServerInterfaceWrapper) ExportUser(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportUser(c)
+}
+
 // VerifyChangeUserMfa operation middleware
 func (siw *ServerInterfaceWrapper) VerifyChangeUserMfa(c *gin.Context) {
 
@@ -793,6 +1083,62 @@ func (siw *ServerInterfaceWrapper) VerifyChangeUserMfa(c *gin.Context) {
 	siw.Handler.VerifyChangeUserMfa(c)
 }
 
+// SendUserMfaOtp operation middleware
+func (siw *ServerInterfaceWrapper) SendUserMfaOtp(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SendUserMfaOtp(c)
+}
+
+// GetUserOrganizationInvitations operation middleware
+func (siw *ServerInterfaceWrapper) GetUserOrganizationInvitations(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserOrganizationInvitations(c)
+}
+
+// AcceptOrganizationInvitation operation middleware
+func (siw *ServerInterfaceWrapper) AcceptOrganizationInvitation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", c.Param("invitationId"), &invitationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter invitationId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AcceptOrganizationInvitation(c, invitationId)
+}
+
 // ChangeUserPassword operation middleware
 func (siw *ServerInterfaceWrapper) ChangeUserPassword(c *gin.Context) {
 
@@ -821,6 +1167,32 @@ func (siw *ServerInterfaceWrapper) SendPasswordResetEmail(c *gin.Context) {
 	siw.Handler.SendPasswordResetEmail(c)
 }
 
+// SendPasswordResetSms operation middleware
+func (siw *ServerInterfaceWrapper) SendPasswordResetSms(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SendPasswordResetSms(c)
+}
+
+// VerifyPasswordResetSms operation middleware
+func (siw *ServerInterfaceWrapper) VerifyPasswordResetSms(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifyPasswordResetSms(c)
+}
+
 // AddSecurityKey operation middleware
 func (siw *ServerInterfaceWrapper) AddSecurityKey(c *gin.Context) {
 
@@ -948,16 +1320,23 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/.well-known/jwks.json", wrapper.GetJWKs)
+	router.POST(options.BaseURL+"/admin/custom-claims/purge", wrapper.PurgeCustomClaims)
+	router.POST(options.BaseURL+"/admin/impersonate", wrapper.ImpersonateUser)
+	router.POST(options.BaseURL+"/admin/invite", wrapper.InviteUser)
 	router.POST(options.BaseURL+"/elevate/webauthn", wrapper.ElevateWebauthn)
 	router.POST(options.BaseURL+"/elevate/webauthn/verify", wrapper.VerifyElevateWebauthn)
 	router.GET(options.BaseURL+"/healthz", wrapper.HealthCheckGet)
 	router.HEAD(options.BaseURL+"/healthz", wrapper.HealthCheckHead)
 	router.POST(options.BaseURL+"/link/idtoken", wrapper.LinkIdToken)
 	router.GET(options.BaseURL+"/mfa/totp/generate", wrapper.ChangeUserMfa)
+	router.GET(options.BaseURL+"/organizations", wrapper.GetOrganizations)
+	router.POST(options.BaseURL+"/organizations", wrapper.CreateOrganization)
+	router.POST(options.BaseURL+"/organizations/:organizationId/invitations", wrapper.CreateOrganizationInvitation)
 	router.POST(options.BaseURL+"/pat", wrapper.CreatePAT)
 	router.POST(options.BaseURL+"/signin/anonymous", wrapper.SignInAnonymous)
 	router.POST(options.BaseURL+"/signin/email-password", wrapper.SignInEmailPassword)
 	router.POST(options.BaseURL+"/signin/idtoken", wrapper.SignInIdToken)
+	router.POST(options.BaseURL+"/signin/mfa/otp", wrapper.VerifySignInMfaOtp)
 	router.POST(options.BaseURL+"/signin/mfa/totp", wrapper.VerifySignInMfaTotp)
 	router.POST(options.BaseURL+"/signin/otp/email", wrapper.SignInOTPEmail)
 	router.POST(options.BaseURL+"/signin/otp/email/verify", wrapper.VerifySignInOTPEmail)
@@ -965,6 +1344,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/signin/passwordless/sms", wrapper.SignInPasswordlessSms)
 	router.POST(options.BaseURL+"/signin/passwordless/sms/otp", wrapper.VerifySignInPasswordlessSms)
 	router.POST(options.BaseURL+"/signin/pat", wrapper.SignInPAT)
+	router.POST(options.BaseURL+"/signin/phone-password", wrapper.SignInPhonePassword)
 	router.GET(options.BaseURL+"/signin/provider/:provider", wrapper.SignInProvider)
 	router.GET(options.BaseURL+"/signin/provider/:provider/callback", wrapper.SignInProviderCallbackGet)
 	router.POST(options.BaseURL+"/signin/provider/:provider/callback", wrapper.SignInProviderCallbackPost)
@@ -972,17 +1352,28 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/signin/webauthn/verify", wrapper.VerifySignInWebauthn)
 	router.POST(options.BaseURL+"/signout", wrapper.SignOut)
 	router.POST(options.BaseURL+"/signup/email-password", wrapper.SignUpEmailPassword)
+	router.POST(options.BaseURL+"/signup/phone-password", wrapper.SignUpPhonePassword)
+	router.POST(options.BaseURL+"/signup/phone-password/verify", wrapper.VerifySignUpPhonePassword)
 	router.POST(options.BaseURL+"/signup/webauthn", wrapper.SignUpWebauthn)
 	router.POST(options.BaseURL+"/signup/webauthn/verify", wrapper.VerifySignUpWebauthn)
 	router.POST(options.BaseURL+"/token", wrapper.RefreshToken)
+	router.POST(options.BaseURL+"/token/organization", wrapper.RefreshTokenOrganization)
 	router.POST(options.BaseURL+"/token/verify", wrapper.VerifyToken)
+	router.DELETE(options.BaseURL+"/user", wrapper.DeleteUser)
 	router.GET(options.BaseURL+"/user", wrapper.GetUser)
+	router.GET(options.BaseURL+"/user/audit-events", wrapper.GetUserAuditEvents)
 	router.POST(options.BaseURL+"/user/deanonymize", wrapper.DeanonymizeUser)
 	router.POST(options.BaseURL+"/user/email/change", wrapper.ChangeUserEmail)
 	router.POST(options.BaseURL+"/user/email/send-verification-email", wrapper.SendVerificationEmail)
+	router.GET(options.BaseURL+"/user/export", wrapper.ExportUser)
 	router.POST(options.BaseURL+"/user/mfa", wrapper.VerifyChangeUserMfa)
+	router.POST(options.BaseURL+"/user/mfa/otp", wrapper.SendUserMfaOtp)
+	router.GET(options.BaseURL+"/user/organization-invitations", wrapper.GetUserOrganizationInvitations)
+	router.POST(options.BaseURL+"/user/organization-invitations/:invitationId/accept", wrapper.AcceptOrganizationInvitation)
 	router.POST(options.BaseURL+"/user/password", wrapper.ChangeUserPassword)
 	router.POST(options.BaseURL+"/user/password/reset", wrapper.SendPasswordResetEmail)
+	router.POST(options.BaseURL+"/user/password/reset/sms", wrapper.SendPasswordResetSms)
+	router.POST(options.BaseURL+"/user/password/reset/sms/verify", wrapper.VerifyPasswordResetSms)
 	router.POST(options.BaseURL+"/user/webauthn/add", wrapper.AddSecurityKey)
 	router.POST(options.BaseURL+"/user/webauthn/verify", wrapper.VerifyAddSecurityKey)
 	router.GET(options.BaseURL+"/verify", wrapper.VerifyTicket)
@@ -1017,67 +1408,154 @@ func (response GetJWKsdefaultJSONResponse) VisitGetJWKsResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type ElevateWebauthnRequestObject struct {
+type PurgeCustomClaimsRequestObject struct {
+	Body *PurgeCustomClaimsJSONRequestBody
 }
 
-type ElevateWebauthnResponseObject interface {
-	VisitElevateWebauthnResponse(w http.ResponseWriter) error
+type PurgeCustomClaimsResponseObject interface {
+	VisitPurgeCustomClaimsResponse(w http.ResponseWriter) error
 }
 
-type ElevateWebauthn200JSONResponse PublicKeyCredentialRequestOptions
+type PurgeCustomClaims200JSONResponse OKResponse
 
-func (response ElevateWebauthn200JSONResponse) VisitElevateWebauthnResponse(w http.ResponseWriter) error {
+func (response PurgeCustomClaims200JSONResponse) VisitPurgeCustomClaimsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ElevateWebauthndefaultJSONResponse struct {
+type PurgeCustomClaimsdefaultJSONResponse struct {
 	Body       ErrorResponse
 	StatusCode int
 }
 
-func (response ElevateWebauthndefaultJSONResponse) VisitElevateWebauthnResponse(w http.ResponseWriter) error {
+func (response PurgeCustomClaimsdefaultJSONResponse) VisitPurgeCustomClaimsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type VerifyElevateWebauthnRequestObject struct {
-	Body *VerifyElevateWebauthnJSONRequestBody
+type ImpersonateUserRequestObject struct {
+	Body *ImpersonateUserJSONRequestBody
 }
 
-type VerifyElevateWebauthnResponseObject interface {
-	VisitVerifyElevateWebauthnResponse(w http.ResponseWriter) error
+type ImpersonateUserResponseObject interface {
+	VisitImpersonateUserResponse(w http.ResponseWriter) error
 }
 
-type VerifyElevateWebauthn200JSONResponse SessionPayload
+type ImpersonateUser200JSONResponse SessionPayload
 
-func (response VerifyElevateWebauthn200JSONResponse) VisitVerifyElevateWebauthnResponse(w http.ResponseWriter) error {
+func (response ImpersonateUser200JSONResponse) VisitImpersonateUserResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type VerifyElevateWebauthndefaultJSONResponse struct {
+type ImpersonateUserdefaultJSONResponse struct {
 	Body       ErrorResponse
 	StatusCode int
 }
 
-func (response VerifyElevateWebauthndefaultJSONResponse) VisitVerifyElevateWebauthnResponse(w http.ResponseWriter) error {
+func (response ImpersonateUserdefaultJSONResponse) VisitImpersonateUserResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type HealthCheckGetRequestObject struct {
+type InviteUserRequestObject struct {
+	Body *InviteUserJSONRequestBody
 }
 
-type HealthCheckGetResponseObject interface {
+type InviteUserResponseObject interface {
+	VisitInviteUserResponse(w http.ResponseWriter) error
+}
+
+type InviteUser200JSONResponse InviteUserResponse
+
+func (response InviteUser200JSONResponse) VisitInviteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteUserdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response InviteUserdefaultJSONResponse) VisitInviteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ElevateWebauthnRequestObject struct {
+}
+
+type ElevateWebauthnResponseObject interface {
+	VisitElevateWebauthnResponse(w http.ResponseWriter) error
+}
+
+type ElevateWebauthn200JSONResponse PublicKeyCredentialRequestOptions
+
+func (response ElevateWebauthn200JSONResponse) VisitElevateWebauthnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ElevateWebauthndefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ElevateWebauthndefaultJSONResponse) VisitElevateWebauthnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type VerifyElevateWebauthnRequestObject struct {
+	Body *VerifyElevateWebauthnJSONRequestBody
+}
+
+type VerifyElevateWebauthnResponseObject interface {
+	VisitVerifyElevateWebauthnResponse(w http.ResponseWriter) error
+}
+
+type VerifyElevateWebauthn200JSONResponse SessionPayload
+
+func (response VerifyElevateWebauthn200JSONResponse) VisitVerifyElevateWebauthnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyElevateWebauthndefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response VerifyElevateWebauthndefaultJSONResponse) VisitVerifyElevateWebauthnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type HealthCheckGetRequestObject struct {
+}
+
+type HealthCheckGetResponseObject interface {
 	VisitHealthCheckGetResponse(w http.ResponseWriter) error
 }
 
@@ -1186,6 +1664,93 @@ func (response ChangeUserMfadefaultJSONResponse) VisitChangeUserMfaResponse(w ht
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type GetOrganizationsRequestObject struct {
+}
+
+type GetOrganizationsResponseObject interface {
+	VisitGetOrganizationsResponse(w http.ResponseWriter) error
+}
+
+type GetOrganizations200JSONResponse OrganizationsResponse
+
+func (response GetOrganizations200JSONResponse) VisitGetOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetOrganizationsdefaultJSONResponse) VisitGetOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOrganizationRequestObject struct {
+	Body *CreateOrganizationJSONRequestBody
+}
+
+type CreateOrganizationResponseObject interface {
+	VisitCreateOrganizationResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganization200JSONResponse Organization
+
+func (response CreateOrganization200JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response CreateOrganizationdefaultJSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOrganizationInvitationRequestObject struct {
+	OrganizationId openapi_types.UUID `json:"organizationId"`
+	Body           *CreateOrganizationInvitationJSONRequestBody
+}
+
+type CreateOrganizationInvitationResponseObject interface {
+	VisitCreateOrganizationInvitationResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganizationInvitation200JSONResponse OrganizationInvitation
+
+func (response CreateOrganizationInvitation200JSONResponse) VisitCreateOrganizationInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationInvitationdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response CreateOrganizationInvitationdefaultJSONResponse) VisitCreateOrganizationInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type CreatePATRequestObject struct {
 	Body *CreatePATJSONRequestBody
 }
@@ -1302,6 +1867,35 @@ func (response SignInIdTokendefaultJSONResponse) VisitSignInIdTokenResponse(w ht
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type VerifySignInMfaOtpRequestObject struct {
+	Body *VerifySignInMfaOtpJSONRequestBody
+}
+
+type VerifySignInMfaOtpResponseObject interface {
+	VisitVerifySignInMfaOtpResponse(w http.ResponseWriter) error
+}
+
+type VerifySignInMfaOtp200JSONResponse SessionPayload
+
+func (response VerifySignInMfaOtp200JSONResponse) VisitVerifySignInMfaOtpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifySignInMfaOtpdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response VerifySignInMfaOtpdefaultJSONResponse) VisitVerifySignInMfaOtpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type VerifySignInMfaTotpRequestObject struct {
 	Body *VerifySignInMfaTotpJSONRequestBody
 }
@@ -1505,6 +2099,35 @@ func (response SignInPATdefaultJSONResponse) VisitSignInPATResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type SignInPhonePasswordRequestObject struct {
+	Body *SignInPhonePasswordJSONRequestBody
+}
+
+type SignInPhonePasswordResponseObject interface {
+	VisitSignInPhonePasswordResponse(w http.ResponseWriter) error
+}
+
+type SignInPhonePassword200JSONResponse SignInEmailPasswordResponse
+
+func (response SignInPhonePassword200JSONResponse) VisitSignInPhonePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignInPhonePassworddefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SignInPhonePassworddefaultJSONResponse) VisitSignInPhonePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type SignInProviderRequestObject struct {
 	Provider SignInProviderParamsProvider `json:"provider"`
 	Params   SignInProviderParams
@@ -1726,6 +2349,64 @@ func (response SignUpEmailPassworddefaultJSONResponse) VisitSignUpEmailPasswordR
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type SignUpPhonePasswordRequestObject struct {
+	Body *SignUpPhonePasswordJSONRequestBody
+}
+
+type SignUpPhonePasswordResponseObject interface {
+	VisitSignUpPhonePasswordResponse(w http.ResponseWriter) error
+}
+
+type SignUpPhonePassword200JSONResponse OKResponse
+
+func (response SignUpPhonePassword200JSONResponse) VisitSignUpPhonePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignUpPhonePassworddefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SignUpPhonePassworddefaultJSONResponse) VisitSignUpPhonePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type VerifySignUpPhonePasswordRequestObject struct {
+	Body *VerifySignUpPhonePasswordJSONRequestBody
+}
+
+type VerifySignUpPhonePasswordResponseObject interface {
+	VisitVerifySignUpPhonePasswordResponse(w http.ResponseWriter) error
+}
+
+type VerifySignUpPhonePassword200JSONResponse SessionPayload
+
+func (response VerifySignUpPhonePassword200JSONResponse) VisitVerifySignUpPhonePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifySignUpPhonePassworddefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response VerifySignUpPhonePassworddefaultJSONResponse) VisitVerifySignUpPhonePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type SignUpWebauthnRequestObject struct {
 	Body *SignUpWebauthnJSONRequestBody
 }
@@ -1813,74 +2494,160 @@ func (response RefreshTokendefaultJSONResponse) VisitRefreshTokenResponse(w http
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type VerifyTokenRequestObject struct {
-	Body *VerifyTokenJSONRequestBody
+type RefreshTokenOrganizationRequestObject struct {
+	Body *RefreshTokenOrganizationJSONRequestBody
 }
 
-type VerifyTokenResponseObject interface {
-	VisitVerifyTokenResponse(w http.ResponseWriter) error
+type RefreshTokenOrganizationResponseObject interface {
+	VisitRefreshTokenOrganizationResponse(w http.ResponseWriter) error
 }
 
-type VerifyToken200JSONResponse string
+type RefreshTokenOrganization200JSONResponse Session
 
-func (response VerifyToken200JSONResponse) VisitVerifyTokenResponse(w http.ResponseWriter) error {
+func (response RefreshTokenOrganization200JSONResponse) VisitRefreshTokenOrganizationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type VerifyTokendefaultJSONResponse struct {
+type RefreshTokenOrganizationdefaultJSONResponse struct {
 	Body       ErrorResponse
 	StatusCode int
 }
 
-func (response VerifyTokendefaultJSONResponse) VisitVerifyTokenResponse(w http.ResponseWriter) error {
+func (response RefreshTokenOrganizationdefaultJSONResponse) VisitRefreshTokenOrganizationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetUserRequestObject struct {
+type VerifyTokenRequestObject struct {
+	Body *VerifyTokenJSONRequestBody
 }
 
-type GetUserResponseObject interface {
-	VisitGetUserResponse(w http.ResponseWriter) error
+type VerifyTokenResponseObject interface {
+	VisitVerifyTokenResponse(w http.ResponseWriter) error
 }
 
-type GetUser200JSONResponse User
+type VerifyToken200JSONResponse string
 
-func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+func (response VerifyToken200JSONResponse) VisitVerifyTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserdefaultJSONResponse struct {
+type VerifyTokendefaultJSONResponse struct {
 	Body       ErrorResponse
 	StatusCode int
 }
 
-func (response GetUserdefaultJSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+func (response VerifyTokendefaultJSONResponse) VisitVerifyTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeanonymizeUserRequestObject struct {
-	Body *DeanonymizeUserJSONRequestBody
+type DeleteUserRequestObject struct {
 }
 
-type DeanonymizeUserResponseObject interface {
-	VisitDeanonymizeUserResponse(w http.ResponseWriter) error
+type DeleteUserResponseObject interface {
+	VisitDeleteUserResponse(w http.ResponseWriter) error
 }
 
-type DeanonymizeUser200JSONResponse OKResponse
+type DeleteUser200JSONResponse OKResponse
 
-func (response DeanonymizeUser200JSONResponse) VisitDeanonymizeUserResponse(w http.ResponseWriter) error {
+func (response DeleteUser200JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response DeleteUserdefaultJSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUserRequestObject struct {
+}
+
+type GetUserResponseObject interface {
+	VisitGetUserResponse(w http.ResponseWriter) error
+}
+
+type GetUser200JSONResponse User
+
+func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetUserdefaultJSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUserAuditEventsRequestObject struct {
+	Params GetUserAuditEventsParams
+}
+
+type GetUserAuditEventsResponseObject interface {
+	VisitGetUserAuditEventsResponse(w http.ResponseWriter) error
+}
+
+type GetUserAuditEvents200JSONResponse AuditEventsResponse
+
+func (response GetUserAuditEvents200JSONResponse) VisitGetUserAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserAuditEventsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetUserAuditEventsdefaultJSONResponse) VisitGetUserAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeanonymizeUserRequestObject struct {
+	Body *DeanonymizeUserJSONRequestBody
+}
+
+type DeanonymizeUserResponseObject interface {
+	VisitDeanonymizeUserResponse(w http.ResponseWriter) error
+}
+
+type DeanonymizeUser200JSONResponse OKResponse
+
+func (response DeanonymizeUser200JSONResponse) VisitDeanonymizeUserResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
@@ -1957,6 +2724,34 @@ func (response SendVerificationEmaildefaultJSONResponse) VisitSendVerificationEm
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type ExportUserRequestObject struct {
+}
+
+type ExportUserResponseObject interface {
+	VisitExportUserResponse(w http.ResponseWriter) error
+}
+
+type ExportUser200JSONResponse UserExport
+
+func (response ExportUser200JSONResponse) VisitExportUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response ExportUserdefaultJSONResponse) VisitExportUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type VerifyChangeUserMfaRequestObject struct {
 	Body *VerifyChangeUserMfaJSONRequestBody
 }
@@ -1986,6 +2781,91 @@ func (response VerifyChangeUserMfadefaultJSONResponse) VisitVerifyChangeUserMfaR
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type SendUserMfaOtpRequestObject struct {
+}
+
+type SendUserMfaOtpResponseObject interface {
+	VisitSendUserMfaOtpResponse(w http.ResponseWriter) error
+}
+
+type SendUserMfaOtp200JSONResponse MFAChallengePayload
+
+func (response SendUserMfaOtp200JSONResponse) VisitSendUserMfaOtpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendUserMfaOtpdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SendUserMfaOtpdefaultJSONResponse) VisitSendUserMfaOtpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUserOrganizationInvitationsRequestObject struct {
+}
+
+type GetUserOrganizationInvitationsResponseObject interface {
+	VisitGetUserOrganizationInvitationsResponse(w http.ResponseWriter) error
+}
+
+type GetUserOrganizationInvitations200JSONResponse OrganizationInvitationsResponse
+
+func (response GetUserOrganizationInvitations200JSONResponse) VisitGetUserOrganizationInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserOrganizationInvitationsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetUserOrganizationInvitationsdefaultJSONResponse) VisitGetUserOrganizationInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AcceptOrganizationInvitationRequestObject struct {
+	InvitationId openapi_types.UUID `json:"invitationId"`
+}
+
+type AcceptOrganizationInvitationResponseObject interface {
+	VisitAcceptOrganizationInvitationResponse(w http.ResponseWriter) error
+}
+
+type AcceptOrganizationInvitation200JSONResponse OKResponse
+
+func (response AcceptOrganizationInvitation200JSONResponse) VisitAcceptOrganizationInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOrganizationInvitationdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response AcceptOrganizationInvitationdefaultJSONResponse) VisitAcceptOrganizationInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type ChangeUserPasswordRequestObject struct {
 	Body *ChangeUserPasswordJSONRequestBody
 }
@@ -2044,6 +2924,64 @@ func (response SendPasswordResetEmaildefaultJSONResponse) VisitSendPasswordReset
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type SendPasswordResetSmsRequestObject struct {
+	Body *SendPasswordResetSmsJSONRequestBody
+}
+
+type SendPasswordResetSmsResponseObject interface {
+	VisitSendPasswordResetSmsResponse(w http.ResponseWriter) error
+}
+
+type SendPasswordResetSms200JSONResponse OKResponse
+
+func (response SendPasswordResetSms200JSONResponse) VisitSendPasswordResetSmsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPasswordResetSmsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response SendPasswordResetSmsdefaultJSONResponse) VisitSendPasswordResetSmsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type VerifyPasswordResetSmsRequestObject struct {
+	Body *VerifyPasswordResetSmsJSONRequestBody
+}
+
+type VerifyPasswordResetSmsResponseObject interface {
+	VisitVerifyPasswordResetSmsResponse(w http.ResponseWriter) error
+}
+
+type VerifyPasswordResetSms200JSONResponse OKResponse
+
+func (response VerifyPasswordResetSms200JSONResponse) VisitVerifyPasswordResetSmsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPasswordResetSmsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response VerifyPasswordResetSmsdefaultJSONResponse) VisitVerifyPasswordResetSmsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type AddSecurityKeyRequestObject struct {
 }
 
@@ -2171,6 +3109,15 @@ type StrictServerInterface interface {
 	// Get public keys for JWT verification in JWK Set format
 	// (GET /.well-known/jwks.json)
 	GetJWKs(ctx context.Context, request GetJWKsRequestObject) (GetJWKsResponseObject, error)
+	// Purge a user's cached custom claims
+	// (POST /admin/custom-claims/purge)
+	PurgeCustomClaims(ctx context.Context, request PurgeCustomClaimsRequestObject) (PurgeCustomClaimsResponseObject, error)
+	// Impersonate a user
+	// (POST /admin/impersonate)
+	ImpersonateUser(ctx context.Context, request ImpersonateUserRequestObject) (ImpersonateUserResponseObject, error)
+	// Invite a user
+	// (POST /admin/invite)
+	InviteUser(ctx context.Context, request InviteUserRequestObject) (InviteUserResponseObject, error)
 	// Elevate access for an already signed in user using FIDO2 Webauthn
 	// (POST /elevate/webauthn)
 	ElevateWebauthn(ctx context.Context, request ElevateWebauthnRequestObject) (ElevateWebauthnResponseObject, error)
@@ -2189,6 +3136,15 @@ type StrictServerInterface interface {
 	// Generate TOTP secret
 	// (GET /mfa/totp/generate)
 	ChangeUserMfa(ctx context.Context, request ChangeUserMfaRequestObject) (ChangeUserMfaResponseObject, error)
+	// List the user's organizations
+	// (GET /organizations)
+	GetOrganizations(ctx context.Context, request GetOrganizationsRequestObject) (GetOrganizationsResponseObject, error)
+	// Create an organization
+	// (POST /organizations)
+	CreateOrganization(ctx context.Context, request CreateOrganizationRequestObject) (CreateOrganizationResponseObject, error)
+	// Invite a user to an organization
+	// (POST /organizations/{organizationId}/invitations)
+	CreateOrganizationInvitation(ctx context.Context, request CreateOrganizationInvitationRequestObject) (CreateOrganizationInvitationResponseObject, error)
 	// Create a Personal Access Token (PAT)
 	// (POST /pat)
 	CreatePAT(ctx context.Context, request CreatePATRequestObject) (CreatePATResponseObject, error)
@@ -2201,6 +3157,9 @@ type StrictServerInterface interface {
 	// Sign in with an ID token
 	// (POST /signin/idtoken)
 	SignInIdToken(ctx context.Context, request SignInIdTokenRequestObject) (SignInIdTokenResponseObject, error)
+	// Verify email or SMS OTP for MFA
+	// (POST /signin/mfa/otp)
+	VerifySignInMfaOtp(ctx context.Context, request VerifySignInMfaOtpRequestObject) (VerifySignInMfaOtpResponseObject, error)
 	// Verify TOTP for MFA
 	// (POST /signin/mfa/totp)
 	VerifySignInMfaTotp(ctx context.Context, request VerifySignInMfaTotpRequestObject) (VerifySignInMfaTotpResponseObject, error)
@@ -2222,6 +3181,9 @@ type StrictServerInterface interface {
 	// Sign in with Personal Access Token (PAT)
 	// (POST /signin/pat)
 	SignInPAT(ctx context.Context, request SignInPATRequestObject) (SignInPATResponseObject, error)
+	// Sign in with phone number and password
+	// (POST /signin/phone-password)
+	SignInPhonePassword(ctx context.Context, request SignInPhonePasswordRequestObject) (SignInPhonePasswordResponseObject, error)
 	// Sign in with an OAuth2 provider
 	// (GET /signin/provider/{provider})
 	SignInProvider(ctx context.Context, request SignInProviderRequestObject) (SignInProviderResponseObject, error)
@@ -2243,6 +3205,12 @@ type StrictServerInterface interface {
 	// Sign up with email and password
 	// (POST /signup/email-password)
 	SignUpEmailPassword(ctx context.Context, request SignUpEmailPasswordRequestObject) (SignUpEmailPasswordResponseObject, error)
+	// Sign up with phone number and password
+	// (POST /signup/phone-password)
+	SignUpPhonePassword(ctx context.Context, request SignUpPhonePasswordRequestObject) (SignUpPhonePasswordResponseObject, error)
+	// Verify phone number after sign up
+	// (POST /signup/phone-password/verify)
+	VerifySignUpPhonePassword(ctx context.Context, request VerifySignUpPhonePasswordRequestObject) (VerifySignUpPhonePasswordResponseObject, error)
 	// Sign up with Webauthn
 	// (POST /signup/webauthn)
 	SignUpWebauthn(ctx context.Context, request SignUpWebauthnRequestObject) (SignUpWebauthnResponseObject, error)
@@ -2252,12 +3220,21 @@ type StrictServerInterface interface {
 	// Refresh access token
 	// (POST /token)
 	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
+	// Switch the active organization
+	// (POST /token/organization)
+	RefreshTokenOrganization(ctx context.Context, request RefreshTokenOrganizationRequestObject) (RefreshTokenOrganizationResponseObject, error)
 	// Verify JWT token
 	// (POST /token/verify)
 	VerifyToken(ctx context.Context, request VerifyTokenRequestObject) (VerifyTokenResponseObject, error)
+	// Delete the user's account
+	// (DELETE /user)
+	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
 	// Get user information
 	// (GET /user)
 	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
+	// Get the user's security history
+	// (GET /user/audit-events)
+	GetUserAuditEvents(ctx context.Context, request GetUserAuditEventsRequestObject) (GetUserAuditEventsResponseObject, error)
 	// Deanonymize an anonymous user
 	// (POST /user/deanonymize)
 	DeanonymizeUser(ctx context.Context, request DeanonymizeUserRequestObject) (DeanonymizeUserResponseObject, error)
@@ -2267,15 +3244,33 @@ type StrictServerInterface interface {
 	// Send verification email
 	// (POST /user/email/send-verification-email)
 	SendVerificationEmail(ctx context.Context, request SendVerificationEmailRequestObject) (SendVerificationEmailResponseObject, error)
+	// Export the user's data
+	// (GET /user/export)
+	ExportUser(ctx context.Context, request ExportUserRequestObject) (ExportUserResponseObject, error)
 	// Manage multi-factor authentication
 	// (POST /user/mfa)
 	VerifyChangeUserMfa(ctx context.Context, request VerifyChangeUserMfaRequestObject) (VerifyChangeUserMfaResponseObject, error)
+	// Send an MFA code to change multi-factor authentication
+	// (POST /user/mfa/otp)
+	SendUserMfaOtp(ctx context.Context, request SendUserMfaOtpRequestObject) (SendUserMfaOtpResponseObject, error)
+	// List pending organization invitations
+	// (GET /user/organization-invitations)
+	GetUserOrganizationInvitations(ctx context.Context, request GetUserOrganizationInvitationsRequestObject) (GetUserOrganizationInvitationsResponseObject, error)
+	// Accept an organization invitation
+	// (POST /user/organization-invitations/{invitationId}/accept)
+	AcceptOrganizationInvitation(ctx context.Context, request AcceptOrganizationInvitationRequestObject) (AcceptOrganizationInvitationResponseObject, error)
 	// Change user password
 	// (POST /user/password)
 	ChangeUserPassword(ctx context.Context, request ChangeUserPasswordRequestObject) (ChangeUserPasswordResponseObject, error)
 	// Request password reset
 	// (POST /user/password/reset)
 	SendPasswordResetEmail(ctx context.Context, request SendPasswordResetEmailRequestObject) (SendPasswordResetEmailResponseObject, error)
+	// Request password reset by SMS
+	// (POST /user/password/reset/sms)
+	SendPasswordResetSms(ctx context.Context, request SendPasswordResetSmsRequestObject) (SendPasswordResetSmsResponseObject, error)
+	// Reset password with SMS OTP
+	// (POST /user/password/reset/sms/verify)
+	VerifyPasswordResetSms(ctx context.Context, request VerifyPasswordResetSmsRequestObject) (VerifyPasswordResetSmsResponseObject, error)
 	// Initialize adding of a new webauthn security key
 	// (POST /user/webauthn/add)
 	AddSecurityKey(ctx context.Context, request AddSecurityKeyRequestObject) (AddSecurityKeyResponseObject, error)
@@ -2327,6 +3322,105 @@ func (sh *strictHandler) GetJWKs(ctx *gin.Context) {
 	}
 }
 
+// PurgeCustomClaims operation middleware
+func (sh *strictHandler) PurgeCustomClaims(ctx *gin.Context) {
+	var request PurgeCustomClaimsRequestObject
+
+	var body PurgeCustomClaimsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PurgeCustomClaims(ctx, request.(PurgeCustomClaimsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PurgeCustomClaims")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PurgeCustomClaimsResponseObject); ok {
+		if err := validResponse.VisitPurgeCustomClaimsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImpersonateUser operation middleware
+func (sh *strictHandler) ImpersonateUser(ctx *gin.Context) {
+	var request ImpersonateUserRequestObject
+
+	var body ImpersonateUserJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ImpersonateUser(ctx, request.(ImpersonateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImpersonateUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ImpersonateUserResponseObject); ok {
+		if err := validResponse.VisitImpersonateUserResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// InviteUser operation middleware
+func (sh *strictHandler) InviteUser(ctx *gin.Context) {
+	var request InviteUserRequestObject
+
+	var body InviteUserJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.InviteUser(ctx, request.(InviteUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InviteUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(InviteUserResponseObject); ok {
+		if err := validResponse.VisitInviteUserResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ElevateWebauthn operation middleware
 func (sh *strictHandler) ElevateWebauthn(ctx *gin.Context) {
 	var request ElevateWebauthnRequestObject
@@ -2493,6 +3587,99 @@ func (sh *strictHandler) ChangeUserMfa(ctx *gin.Context) {
 	}
 }
 
+// GetOrganizations operation middleware
+func (sh *strictHandler) GetOrganizations(ctx *gin.Context) {
+	var request GetOrganizationsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizations(ctx, request.(GetOrganizationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetOrganizationsResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganization operation middleware
+func (sh *strictHandler) CreateOrganization(ctx *gin.Context) {
+	var request CreateOrganizationRequestObject
+
+	var body CreateOrganizationJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganization(ctx, request.(CreateOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateOrganizationResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganizationInvitation operation middleware
+func (sh *strictHandler) CreateOrganizationInvitation(ctx *gin.Context, organizationId openapi_types.UUID) {
+	var request CreateOrganizationInvitationRequestObject
+
+	request.OrganizationId = organizationId
+
+	var body CreateOrganizationInvitationJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganizationInvitation(ctx, request.(CreateOrganizationInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganizationInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateOrganizationInvitationResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationInvitationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // CreatePAT operation middleware
 func (sh *strictHandler) CreatePAT(ctx *gin.Context) {
 	var request CreatePATRequestObject
@@ -2627,6 +3814,39 @@ func (sh *strictHandler) SignInIdToken(ctx *gin.Context) {
 	}
 }
 
+// VerifySignInMfaOtp operation middleware
+func (sh *strictHandler) VerifySignInMfaOtp(ctx *gin.Context) {
+	var request VerifySignInMfaOtpRequestObject
+
+	var body VerifySignInMfaOtpJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifySignInMfaOtp(ctx, request.(VerifySignInMfaOtpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifySignInMfaOtp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(VerifySignInMfaOtpResponseObject); ok {
+		if err := validResponse.VisitVerifySignInMfaOtpResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // VerifySignInMfaTotp operation middleware
 func (sh *strictHandler) VerifySignInMfaTotp(ctx *gin.Context) {
 	var request VerifySignInMfaTotpRequestObject
@@ -2796,7 +4016,40 @@ func (sh *strictHandler) SignInPasswordlessSms(ctx *gin.Context) {
 func (sh *strictHandler) VerifySignInPasswordlessSms(ctx *gin.Context) {
 	var request VerifySignInPasswordlessSmsRequestObject
 
-	var body VerifySignInPasswordlessSmsJSONRequestBody
+	var body VerifySignInPasswordlessSmsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifySignInPasswordlessSms(ctx, request.(VerifySignInPasswordlessSmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifySignInPasswordlessSms")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(VerifySignInPasswordlessSmsResponseObject); ok {
+		if err := validResponse.VisitVerifySignInPasswordlessSmsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SignInPAT operation middleware
+func (sh *strictHandler) SignInPAT(ctx *gin.Context) {
+	var request SignInPATRequestObject
+
+	var body SignInPATJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -2805,10 +4058,10 @@ func (sh *strictHandler) VerifySignInPasswordlessSms(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.VerifySignInPasswordlessSms(ctx, request.(VerifySignInPasswordlessSmsRequestObject))
+		return sh.ssi.SignInPAT(ctx, request.(SignInPATRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "VerifySignInPasswordlessSms")
+		handler = middleware(handler, "SignInPAT")
 	}
 
 	response, err := handler(ctx, request)
@@ -2816,8 +4069,8 @@ func (sh *strictHandler) VerifySignInPasswordlessSms(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(VerifySignInPasswordlessSmsResponseObject); ok {
-		if err := validResponse.VisitVerifySignInPasswordlessSmsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(SignInPATResponseObject); ok {
+		if err := validResponse.VisitSignInPATResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2825,11 +4078,11 @@ func (sh *strictHandler) VerifySignInPasswordlessSms(ctx *gin.Context) {
 	}
 }
 
-// SignInPAT operation middleware
-func (sh *strictHandler) SignInPAT(ctx *gin.Context) {
-	var request SignInPATRequestObject
+// SignInPhonePassword operation middleware
+func (sh *strictHandler) SignInPhonePassword(ctx *gin.Context) {
+	var request SignInPhonePasswordRequestObject
 
-	var body SignInPATJSONRequestBody
+	var body SignInPhonePasswordJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -2838,10 +4091,10 @@ func (sh *strictHandler) SignInPAT(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SignInPAT(ctx, request.(SignInPATRequestObject))
+		return sh.ssi.SignInPhonePassword(ctx, request.(SignInPhonePasswordRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SignInPAT")
+		handler = middleware(handler, "SignInPhonePassword")
 	}
 
 	response, err := handler(ctx, request)
@@ -2849,8 +4102,8 @@ func (sh *strictHandler) SignInPAT(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(SignInPATResponseObject); ok {
-		if err := validResponse.VisitSignInPATResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(SignInPhonePasswordResponseObject); ok {
+		if err := validResponse.VisitSignInPhonePasswordResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3086,6 +4339,72 @@ func (sh *strictHandler) SignUpEmailPassword(ctx *gin.Context) {
 	}
 }
 
+// SignUpPhonePassword operation middleware
+func (sh *strictHandler) SignUpPhonePassword(ctx *gin.Context) {
+	var request SignUpPhonePasswordRequestObject
+
+	var body SignUpPhonePasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SignUpPhonePassword(ctx, request.(SignUpPhonePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SignUpPhonePassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SignUpPhonePasswordResponseObject); ok {
+		if err := validResponse.VisitSignUpPhonePasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifySignUpPhonePassword operation middleware
+func (sh *strictHandler) VerifySignUpPhonePassword(ctx *gin.Context) {
+	var request VerifySignUpPhonePasswordRequestObject
+
+	var body VerifySignUpPhonePasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifySignUpPhonePassword(ctx, request.(VerifySignUpPhonePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifySignUpPhonePassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(VerifySignUpPhonePasswordResponseObject); ok {
+		if err := validResponse.VisitVerifySignUpPhonePasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // SignUpWebauthn operation middleware
 func (sh *strictHandler) SignUpWebauthn(ctx *gin.Context) {
 	var request SignUpWebauthnRequestObject
@@ -3185,6 +4504,39 @@ func (sh *strictHandler) RefreshToken(ctx *gin.Context) {
 	}
 }
 
+// RefreshTokenOrganization operation middleware
+func (sh *strictHandler) RefreshTokenOrganization(ctx *gin.Context) {
+	var request RefreshTokenOrganizationRequestObject
+
+	var body RefreshTokenOrganizationJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshTokenOrganization(ctx, request.(RefreshTokenOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshTokenOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RefreshTokenOrganizationResponseObject); ok {
+		if err := validResponse.VisitRefreshTokenOrganizationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // VerifyToken operation middleware
 func (sh *strictHandler) VerifyToken(ctx *gin.Context) {
 	var request VerifyTokenRequestObject
@@ -3220,6 +4572,31 @@ func (sh *strictHandler) VerifyToken(ctx *gin.Context) {
 	}
 }
 
+// DeleteUser operation middleware
+func (sh *strictHandler) DeleteUser(ctx *gin.Context) {
+	var request DeleteUserRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUser(ctx, request.(DeleteUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteUserResponseObject); ok {
+		if err := validResponse.VisitDeleteUserResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetUser operation middleware
 func (sh *strictHandler) GetUser(ctx *gin.Context) {
 	var request GetUserRequestObject
@@ -3245,6 +4622,33 @@ func (sh *strictHandler) GetUser(ctx *gin.Context) {
 	}
 }
 
+// GetUserAuditEvents operation middleware
+func (sh *strictHandler) GetUserAuditEvents(ctx *gin.Context, params GetUserAuditEventsParams) {
+	var request GetUserAuditEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserAuditEvents(ctx, request.(GetUserAuditEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserAuditEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUserAuditEventsResponseObject); ok {
+		if err := validResponse.VisitGetUserAuditEventsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DeanonymizeUser operation middleware
 func (sh *strictHandler) DeanonymizeUser(ctx *gin.Context) {
 	var request DeanonymizeUserRequestObject
@@ -3344,6 +4748,31 @@ func (sh *strictHandler) SendVerificationEmail(ctx *gin.Context) {
 	}
 }
 
+// ExportUser operation middleware
+func (sh *strictHandler) ExportUser(ctx *gin.Context) {
+	var request ExportUserRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportUser(ctx, request.(ExportUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ExportUserResponseObject); ok {
+		if err := validResponse.VisitExportUserResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // VerifyChangeUserMfa operation middleware
 func (sh *strictHandler) VerifyChangeUserMfa(ctx *gin.Context) {
 	var request VerifyChangeUserMfaRequestObject
@@ -3377,6 +4806,83 @@ func (sh *strictHandler) VerifyChangeUserMfa(ctx *gin.Context) {
 	}
 }
 
+// SendUserMfaOtp operation middleware
+func (sh *strictHandler) SendUserMfaOtp(ctx *gin.Context) {
+	var request SendUserMfaOtpRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SendUserMfaOtp(ctx, request.(SendUserMfaOtpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SendUserMfaOtp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SendUserMfaOtpResponseObject); ok {
+		if err := validResponse.VisitSendUserMfaOtpResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserOrganizationInvitations operation middleware
+func (sh *strictHandler) GetUserOrganizationInvitations(ctx *gin.Context) {
+	var request GetUserOrganizationInvitationsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserOrganizationInvitations(ctx, request.(GetUserOrganizationInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserOrganizationInvitations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUserOrganizationInvitationsResponseObject); ok {
+		if err := validResponse.VisitGetUserOrganizationInvitationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AcceptOrganizationInvitation operation middleware
+func (sh *strictHandler) AcceptOrganizationInvitation(ctx *gin.Context, invitationId openapi_types.UUID) {
+	var request AcceptOrganizationInvitationRequestObject
+
+	request.InvitationId = invitationId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptOrganizationInvitation(ctx, request.(AcceptOrganizationInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptOrganizationInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AcceptOrganizationInvitationResponseObject); ok {
+		if err := validResponse.VisitAcceptOrganizationInvitationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ChangeUserPassword operation middleware
 func (sh *strictHandler) ChangeUserPassword(ctx *gin.Context) {
 	var request ChangeUserPasswordRequestObject
@@ -3443,6 +4949,72 @@ func (sh *strictHandler) SendPasswordResetEmail(ctx *gin.Context) {
 	}
 }
 
+// SendPasswordResetSms operation middleware
+func (sh *strictHandler) SendPasswordResetSms(ctx *gin.Context) {
+	var request SendPasswordResetSmsRequestObject
+
+	var body SendPasswordResetSmsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SendPasswordResetSms(ctx, request.(SendPasswordResetSmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SendPasswordResetSms")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SendPasswordResetSmsResponseObject); ok {
+		if err := validResponse.VisitSendPasswordResetSmsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyPasswordResetSms operation middleware
+func (sh *strictHandler) VerifyPasswordResetSms(ctx *gin.Context) {
+	var request VerifyPasswordResetSmsRequestObject
+
+	var body VerifyPasswordResetSmsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyPasswordResetSms(ctx, request.(VerifyPasswordResetSmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyPasswordResetSms")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(VerifyPasswordResetSmsResponseObject); ok {
+		if err := validResponse.VisitVerifyPasswordResetSmsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // AddSecurityKey operation middleware
 func (sh *strictHandler) AddSecurityKey(ctx *gin.Context) {
 	var request AddSecurityKeyRequestObject
@@ -3556,171 +5128,233 @@ func (sh *strictHandler) GetVersion(ctx *gin.Context) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x96XbbOLrgq+Do3jmVzCXlJU66yvNnVFmdzW7bqdwzqUwfiIQklEmADYB2VBm/+xxs",
-	"JECCFCUvsV3VP7pikcTy4ds3fB8lNC8oQUTw0f730QLBFDH1z2OUYoYS8Z4mUGBK5G8p4gnDhf5z9On4",
-	"PRAUMPMiEHQUjRj6d4kZSkf7gpUoGvFkgXIoP55RlkMx2h+VDI+ikVgWaLQ/4oJhMh9dXl5GowIymCPR",
-	"WMAp/WeJ2LI9/ylkcySAXMaMMiAWqFrLKBph+cq/1ZfRiMBcTsaqIXtXus406BvMi0wOvhCi4PtbW/ky",
-	"hkUxTmi+lUCRLGL7thwuWgWGaHSC5+SAHDF6jlPE9HoKhhIo6rU2VrhAQO4Q0JlaHqcJhhko7BAGGAUU",
-	"ixoWztNuSCBS5qP9LyNYyD1GozkWi3Iq/0HpXP2SYXKGUix3lmKeUJaOohEvqMAzCXhxgUWy0F9mUH45",
-	"xWJaJmdIAu+CsjPKR9EI/lkyBNWngsFzKOEEEzSl9Ey+hklKL3iGz5EZUiA2+hoC3imWQ3chDDbzhnBD",
-	"2IeD8cJ+UKPAOWJ4tnyZQ5ztfzP/G3Uv83RZIGepKw55WVQHrNc6Bi+qbyJAKMgomSMGSo7Srk3KlfRs",
-	"qTWH3J7BASS39ZvaofxV/vWckhlm+fMFJHM1Lp4TTI4g5xeUpRni8mwL8+cx4kjIU6vh5Q8Z4Ah6oYod",
-	"TIRAXChW9MqQ0PcAHcD6NSD/i3JEBDBEV2+mgMmZgpIocol/JGUUp/EZWjp/cThDYkkUFGY4pXG5O5OP",
-	"DTEQSlAAC6PRpBQLRATWjPPlN4EIx5SobcA0xfJXmB0xWiAmMOLhA59Ub4KaMQKJnYgLTOYAOi8wmiDO",
-	"5a/TpTq+JMNy45CkANbLoayGM53+IVlYz3qfqzEOS1GUYs3Ff4CFRCRkxwJUjwJmjObOAiV+OEN9l8DF",
-	"aftoJ0WRmfUBnMrFzjBirfHr3U0pzRAkcnsJQ6lcrxr/PxmajfZH/7FVC74tg2VbzxlSQ7vb07uXwyxy",
-	"mDxnCAp0ghKGAvj35sPkOeDq4ZCVXfYfBWUTzuUiKDlGvKCEo+4zmMGMoxYs3cFeQBGg+F8hR8/2SpYB",
-	"RBKaoga2gFR+FUBxfXpyzLcnhx+HjGsQUg4I1DeBUSX/gKJkaNBCLXRA/VlgzJIj9gaSNBs0qHwbLPTr",
-	"0YiUWQan8kuN421GXguLL02YRAH4u1v8uvL4hYDJQvKvDlbnnRSs3gY5TWGGxdLldxkUkgmOJDlQzuPq",
-	"hxUMTC3DctRN8bAe4VBvdQUhfjp+/1IfiD4huaYgMq87SBtt1x2hKKcZTt6h5ZU+nmRzyrBY5OGT1e+B",
-	"M7QE0L7psD1Xi8REPNur8R4TgeaIyckEg4QXlGnWvQp9nLejERYoV1+1MMP8ABmDywEE0Dr4lUh/gjKj",
-	"Kl+B1/mU03dKXQRX7+wYcQV5c+Q+HD8vkFggbRT48MxLLkCixAWAhvnFcqSYmQHdU04qyQM4LVmCgoKM",
-	"+Uvp25ez6mO9D7styeGUyoVru64Xjxvve8OtFGGnFq3Ww0EgFlCABBIwRVaftays5NKMILNEGhOKTfMc",
-	"MhEnUNkei+WUYa3/CsQIzIIc7jkl52gJSYKOGJohhkhi5MMMlpkkLKXdRSs0zKQaBhT1OPVazSCYVPZi",
-	"bTjK5RUM87AOqTWNo8npsVb41iQH9K3ADPFJAO4v5SO9/FRip9H3jyanLl+Rj2KB86BMzZGAqWHBfTph",
-	"pel/twZIvowLpYjLQ42nS/0TLIo4yfCojU4NDlNvK8RIHJhtJKdCiufBCx9AtfGymzx5On02exIne9Nf",
-	"4r2f0ZP4l3/8DON0L92e7aR7u2h3T5k/0lqVQ/3++/TLdvwLjGdfv/98+fvv07j6c++y89/uVzu78rPQ",
-	"iRSIcbnHSSLtgFN6hgIOmzu8g8Y5KwIO7anj2A3zvFaFeVP1q1tdriyrY8TLTPA1hFOPXXYZBRFXLrEW",
-	"Kz9xX39orZDBi4N0E52GOaAeLmlbB1WRfv8+gHwJMFQwxCUHTbW9izkw+DAIs4wbRO/Z2UILu6LRt3hO",
-	"Y/NjwaigCc3GfRjnfBLj3Iq+2ummRtBUtRjtG4+a8hbOaXyBphKtyFb1j+qLSw/TFav7G9HvPqIHjKd7",
-	"iuotlLsdTD+yvq91UTybd0F4WQg6Z7BY4KTLvgqYU+bIhjmQTuXbzQMxZyFX1i/HWu6nVVv3d1kPBGqY",
-	"hDxSPsTYWUD9IalEZMQB1lqQg5+YAwgqa0a7Tof4uBpQCh7SOcxw2iQG7jo0lPGkHLYh9fklY5QNZo7+",
-	"/CcCkhSyFP+JUoDkQIDVON/Qs+XjgI6tvpIMxKLVEpO5Ds4UKJHGFICOS1MPU+/OmCExoxmKpSkZT1GM",
-	"SQyzjF6gVP3OdcgFTjOUxoikBcVEuL9JS8966mOYMQTTpRykVPvwf1aRC6zsrBllU5ymiMSQULLMackd",
-	"ayrmiJ0jFtsVY6KOKtbDWV+/88A4rEfRKKMJzFBMqLD7cKIDsaA05gvJRJwfMYkXeFrE0tiYQrXuOobX",
-	"GEnByv+J4zkpi9hCRJodxO7Ugkf+R3/m7VYvXtsq9VZmDPFFLJQqWv9ehUoq0OczGAsqChUlUP+KtV/Y",
-	"/Uo/V69KFirXMKMlUVxbfmHPBiZCR77slyquMYpGVDJOvZoYJSoIE88g1jvVDwtGZzhD8QyJZBF4qEKA",
-	"rcPUK0sgkWviiKQxz3mQznLEOZwHiPhNmUMSzxhGJM2Whozs264RcqDnBAqB6kBN2zcsoCgDrqw3p6dH",
-	"QD80s0iyc6fY295uM/QGazaj1xuKDGmHGPVBqowRN0Tby15aUVQdPA3B8+3nd2tyq7cnhx/BZzQF79BS",
-	"Bajffj4F566DZ5BkrDySyt0CLrBYaGVD8/X6vI5Pdp8+Cx1QAAmOTybWy4W+aWHpjTX55+TX0FBnIT1P",
-	"7u/ghff9GVrGOI13gmOIZXgMI4XdHU1CA5DwfnKalplClXoEOE3Snd0ne+PxuCMEEV5K2aIGjucr9Tp5",
-	"fhLcGk56p3K5eqIQwr79/O4Eiasg1glSQVSNWEqWSTSrghm8hWRnaBkg1gljcAnozPF9ev7mPuVKksYq",
-	"D7QaLwSB95icGbLdzKGG0w6nykQSNTh4AaxUaCMSrZyL7ocf5c+a2tJSvqvACTCx0dygn8fhOX3AarKo",
-	"JqDc3JC027Xy4dXk+QJmGSJzdASXGYXpurqo/RwU+nuFRnmZCRzPYKIsSs/6a2GSka4d+RdAUAlDcLFA",
-	"BEhAZEhYVevDqwlI7PwemeUzeEpFsQ+nyc7ukxTN9kI8ram+64WE4HT4brCuaYXB4bugADhU2+N18tOa",
-	"eMq8D68/O6m19SMb02pbimYvm0cLV9pbIS/+pRd30rkiHSEw7bNnKO1NGxnModopKi1+1YhjetGuwU6F",
-	"+qvLaFQj+AY+DfQtycoU1UcX4tkgw1xIph046hfmVcqMV4LXHgtJhsEYlwnvQIYAoQLAJEGFkIqzJGZl",
-	"ZMotsaFg711W6AiQl5GziWNK5YNgEsKrN/JnuZEFygowL3GK1J5UZoFYMFrOF+oH9K1AUj1WAaNNN6pm",
-	"C+2xKKfmReVA6SCAFHHJ3VqGtsobEguEtemLlAXS8Jw42ZoD1x9y6gSWzorV0c1M6iBHkInlSyKwUN8J",
-	"nCNaihAGy0eRFK05zjLMUUJJyiONhjXCAczBhXxByg8KLiAWVcqnfEP+aEQMCvqIlEk5IJ5q19wQMKww",
-	"9ujIJevAWQ513g3gzTfpxOsnzE1ic+vyt01zIELx52EiIBz8DmD59fkTcRrUSTq5xTWxLKvIcJSUDIul",
-	"SZ40qQ4pOsfqNROPD+k6gRUa5XxDzSHL6MVAWXZlEXbrUuuKgv7hizxWHHSEh46PlIHmu6/5gpZZKgmc",
-	"J7RAqa5faGc83Qmpcp0ZO17KVoVUV5ApDaK9SZFyrF2waxnyDR+O/kpXrqjBACSKmjmvbPimTVVPGnAK",
-	"mVHUt9qeFxTMEUFMJ38RdNEc/z7kYHi7DgmYgBZ29XybCSgJ/neJ3CxvSy1mQqBmBEhNGYGLBU4WgCMl",
-	"tQ2tB90wCgHb8y2Up7qAGdTcXFXS2Cn1JCthpcbulMQdyXheupkqnikZnCtdo83C3DgbYM4oTtDIG6Ky",
-	"bd2SlpAUPkGcD8m3bBR/Sd7ue24A1yOBhBIBMdEM7wwRbU4oaYCJtqmDfum+3KW3n08Nhc0aLiMyB5Oj",
-	"A1sY4Ttm0fLtYvo6wYf47cGnPw92PuIDfkCOnybPD54dnBX//dvzt790OG2d1bzUWWcHpDeZTkoDmyvm",
-	"krsUEEY2uGv7ZXt7UBpvP/M59ZiOZWjNJdzdvDV3dyHpfdDmAszlt3d3Z0NtwbZ73yGDDjRsYEULjCEm",
-	"ZMh8Qy+upmhzCpbO+4iZ11ylDwKW+QRdi7oScmLjsps57lPMiwwuPxruX2PLW7og4CTHqiiydXw6WB3U",
-	"+i5onCwgg4kqyDIvelxHgiOH394jMpcqzm40yjFx/rqOhNoZZlzoXamtjKJRBqtf9L6C+bQdYFZVi0dV",
-	"rd4VVSuHQUs7QDJpHeCVksDJEmhkU8hXAoXGHLGfuB0gTZkOjtQA/4MuyJjLLf9vsqBcjDF1fdl62FA0",
-	"xS6ka0pnpfVsJ4JtS7WH84v/oCyNf9n7f//DP/Cn296JP1mlPtgFVtN9HXpMGyW42M8UMfuJG02RrmyZ",
-	"HC4BJspTDGBF/ZS1Aiz+aeazlTU6ofjSZXSNzOMhxPxo7QzphQaek0+FNcJuP1aoAf5BR9Y2AzgVRRtm",
-	"hwRp3cohxoCV3hck9IT+/7XBv/H//M+hMb9ILa1714enR4owNyzWCLO9CXAyY66F322GSUFmtRoWuq77",
-	"rkNkQ4xrwEQnTK0LmY1St6+PN25cXPTAik2G1pkYqDndDf6m+QBQTnJ+eN0CIPbIETCUIHyuM+JPPpwE",
-	"VbsFJehjmU9RID33SD4ERD21JrvNlq0A/l87u0/2nj77x8+/rMYgZ7JVoiIEqo0YwV1Qrxqb2fDQN9Vv",
-	"ftQRdx/uZ+O0vtM84XLl8q8iuusIy/DoZrAczIFGf2ccDz4rmuJcA+y88Em92S6kOCyFA8hWwNLzAofL",
-	"EaSFQEuhW6fALAMJJQQlQhoRKsrKO6rFh0ctqnhUyRgiwtp4w3HnU3G9/gOG5pgLxEz4RPmOVU7y5l6E",
-	"l677oNpxNTpMElo28pNvV+b2+SMsYIet+wd6KOr9bBC7R+mxKmxx/XRfRiq0onj31+GtKSJLVXJE3/Fn",
-	"xEBrgGGeQgeIT3Y9u/LL778X399fyv//qP7/5BJE45/ir//1n38hD2N0+ymoGu/uhey9FX28hsVtC/JW",
-	"jehlNCI4OQvHXj+aJxVTs6lEfjHhNcNuhcw+paJ4bYL3V/WvOoHQ08PTI8CRKAs3bKJ2/uHVpCXDcA7n",
-	"6BPLOrtu/vPYlBfKF3VUJoFETaXkJCTNuu2i8PBXMoN99fVWQeb/a6pShSL826+Hxxfb717PO8Kigoqi",
-	"q/+Z2aPqf3ZmqpFySEqYmZ0PW9nk1+cvXr56/ebg7Tulnq8udLbA8pYXOtxWalR3S7DYtgSbYgLZ0nZC",
-	"qwh8uhTB3iif+ICKsEAs3VTq6Y59WqaviJYLfI4+zGC4iHaiU3Y/vJroOnJLYkb4rehsFo3gORSQ9WGg",
-	"He0nXq29wIlpwxbi+pbp66H5lvx4Z/fJ+I9iHmwloLq5pJOgVzdHXMC80GUfVa6ZhdsF5Kb3kq/z727v",
-	"Pom3d+Kdp6c7u/tP9vafPvs/g5veNPQJf0Uv9EOF2ZThPzV1M5q1IL+2HhIMTJl3gEk8GRrVvO0AW93g",
-	"E6O0v31W6a5hATmYIkSAU5dcrcbDWMfgCaUTfepMJmodx53KHsC8Cnv3gQ1zVYVPQFW83GkUGM7TBluX",
-	"HmpDoFWNjH4RPMogmZdS6kj++PiW9NJGPkLJBc2B/RhArnoei7pytX3Amyu0vY4mCybH39T0MW3vPn36",
-	"dHtn98kKR+VahOJO2E8vnSfPrLHlT/bepEirxxK0eE50flEIrF+qigV1JutZac3Ul0rouOzfZ70+f2yy",
-	"mEh3PXHJp8JwB9nCYLcQCWoOHLEXSJMZ/hNtqFJrx03VTb3fr+U6si5wloEpAnhOqM7pG8rb74oF0+fd",
-	"mNSufToDOSY4L3PwBNRG8HW7N3RviAPyAYkFDRKcSirFcxJjIvnMgqam1LTZEtttelG4ra+/rtJbvSX0",
-	"hQ9VzZBquK2aPGyGfARdvLxjKNIudm0l1tpF94LlBJHUzXh/QHG51SBagTabJGb3aqArMqZd/SMCmAhE",
-	"pBVFSaYNQjN2UOnpKN9wmjK7oZxK3e9K0r6VnG9fGnVkgMuT+DCDV0+rk1adatrJQIqqv9Ypp19hN9or",
-	"AJThWE84Bp84AigvxBJoeMinpt+NfHnssEXT2cbv9W9+bJt5NA0sw6Vm7eioGse3nAfaCDQrlSvTDpV6",
-	"6p1hbgS1kq7TWzey0eK9R3dK+q1uqsAQR7qMya4usnUHqW0GplP6ua7idjI+Uz/hyrsB4vffByVeuRBb",
-	"fSYcib/ZfV/dl1dr4paGNPftlJYohqiO2G0nVLFJCUbLYizlVwv160/cypRQCYp2U0/S9MQ4gE2lzF10",
-	"WusTghkg63mv1/NChyFybV2NpVStOxsTdJEtAUylnG5swmOiaO/ps3/E6OdfpvHObvokhntPn8V7u8+e",
-	"7ezt/GNve3s7KII7IamuLbJAtFcXOdNLLmNyT9MhzS674XiFHGCxqhxJUNMUaUisSiXf6B2eSDzUc/yK",
-	"IENsUkpm3fJHq2fNtGzl5JBrcKt8lIxOW9n3tjBKPigYFTpzwDYM5GN7H5ByDqjZ6p0shCgkGOsVvszQ",
-	"uTZZh61UJZCbg+IAma9BgViOVY4BN8vWhSWEY+W3rpgLr1PQzSjuvTcVuuQI8lLOwMtkASBXKWJENFYz",
-	"Bq+U4iQgzjjgCAHrnU5pwseWoW+pEma+JT/eskuOnSWvBpk8aUxm1Fj9AupLL4y4GfGyKCgTrggx9bEf",
-	"5S/gRD8fRaOSZY4TvXr/sl2hkxcMLSQAz1G7MI+d4wTZgAycSy1JC2/FhCSyRzbpg0fNW4PkENoEVt4U",
-	"nCDDhcyaPxycgvfm1+aKaYGIvtJgTNl8y3zMtz4cnGo9RGT1tv3aczA5OhhFo3PEdLbaaGe8Pd7WwhQR",
-	"WODR/uiJ+knXEita2hpfoCyLzwi9IFt/XJzx8R9cu1vmIX3nGAmG0bmuV291PHv09vO7k8duGM/pW1ZV",
-	"3WnybzREG4PTBeYVmUktSb0/XZrLIBQ9Ki1DVQk7dKxIsiKAg3S0P3qNxNvP77jTwFdtdnd72yKYEfJO",
-	"U9Etu/H6tq8V7dVOkNCY23cjCQeYgLef39mWcKbNUaVdXNNy/AaugVVNTNdUQBOVtZSCi4WKQdVXYelq",
-	"Qc31Fest8xyypYant6VQ38TAPqORgHOuXDdLLlA++iqHtSyiKllXiV6UB9DtdV0VbkP0dQGNLsdRY1X0",
-	"6TMdHykMK7YD3SRyrC76D5xQ3fqNW23S7E53rroXKGMEzGj/iy+nv3y9/OpilDkMS8iqSJkA08IVGBc6",
-	"JvpUdUHcq4MXh7vAOb4KueykYfTaMvpGJ5Y9N90kahyr4C75T93DsWFOq5Bl3enYxzatSYVwTkHrV5ou",
-	"r+0k+7JRA+f6GU0nmpTq7h717WDMq3arANFokerf+nh5g7TUKMQN7MfqWFKZkdg0K7Ns+eAoRh9rgwqa",
-	"6KgppW727fYgWzZYSicBLRDMxOLPTiXArMQ4NTp0J8xrpRRmZmGvX54azahFL2/UpM8XKDl7be4VvSGE",
-	"cppPBo7wpF6/hsNSqXfOXu6f+NawBYkELnj0+uXp45BojtR1xtd53G9eTl4MOO83+irb0IH/1c5GQuxx",
-	"l96UYXK2hdPKxA5Ls/eYnDVPytzU+BOvU35Mahn6pvvUg0PJdao7kKv39EFCUlXvjsFxr3XaOminlfAN",
-	"ib9As+LAKdkN6GR4u89mcqHdt4Q1JvNblXT9jEm39hBLoK+QfkjCrnaSNIWeQmbYyFazGSv2EB20rtAV",
-	"p3WxeVjK5TO4JagotmzrqU5551ghpzhH8RRKy/SQoFj+Capag0enh6dHj21Gp3bQCG2bFCtiTj7J6LC1",
-	"CYLdpCgMJu+GTFonU9XFu6ptV/rg1K3q0J29O8ik6ysUIhX6bu2VFixBF+DI1M4CXTwLTqtyooJJLS2X",
-	"rChRDZq0ZTQGR5NTrnr/ZpTM40zVcZo2Uc2umwATLhBUcTGG5mUGW/5F0w2K5lphVuKFr83Tq5sDb4ij",
-	"t25zDBx+GJSJCU7YQzdVUHXbKcn9nbym2+Pu7dsWQ9qnS1wmpQvAMNo8WKb/3F4DGz7iR0cTX4H1+bq+",
-	"VH8LuvmgHXa/maeVDmpbtkjcoaUwgkbuzeloOgYT7ytuKVFdcsqEbTGmyVC/kUGBGDjHUAEprfPjKsdn",
-	"m9YaXZ1u1IXQ6h0VwIAqoFe6afgtPaqCjUkKG/1YH4FHWJV76f6ZCyem/00F32zpkEJDr/AIopF810kV",
-	"EzcYBp1SUt3fvF1QKqWHKBnhbrMjFcBr9TxSRuQFDatBQPn/1YVQXVTgFc3eKCUEy3MDh6lqUdw+x1WP",
-	"KA9GbY3vFh1oPY2wQujZMPArwhmDA53nVJ9TBKBzuDbplil08NWRCjXG95fmuguqh9DfSsN90u4B59jd",
-	"ppwdmFCoNEB8i/2R7rJFGXit7st6PAZawHG3KLwqlZoBShBIKeLkJwHQN8w7Zc/NWu/BxmOb2+8/kM7+",
-	"ckLIOpHczm4DKMFa3gMiMnINPZazH54ZZJ6HpBWe2ciyEUMO0+sI7Hjd226UMhod4gJnqXJPdSKipA1l",
-	"sOoc0P6ro+5yTEfuyYsu10cS1Y2TTc3ivSMg41JXJ1WXNA+hHSqKrSoJM0w8BwQLLGWI1vg0OdBWU6hm",
-	"qs8JIqmqjZOLMsVL5vJVlPq1jkoTqBJaPRkS1Q3cjbNO6wTWlm0671JgMj27hI9tAnejNNbsRxgKMnrt",
-	"UHTYwTWEzDbUcUoI/kBB1O9H1u4sYm+Cq4tI76VDuU9JOzw9WpeqhucKVFP0iiSV+NskvWsVQbdKHytT",
-	"CyrRIwkEtToI/TiB09dQMiR+4BwnKtLyEGnFiJ91qcSt1hsshNyPAqTCEUm17pbXIPe7JZg+Zbcnclot",
-	"K2+UtjobZF5RCDnwvLOyyCEzQ1eqm9rDEUR5Y4ObUBrP+XXSWVsTbDQncQrlfxTVneT81mjO6cMZijK5",
-	"bQP6Ce7kw8m90/y8rggPiO7MWWxIblvDnBMeyckZf6wm+AMJ6LDXP9GiIc9BIQH3g/XCnh7DXS5/LwvC",
-	"r+W8r+rgujQj1nNlh6O56+U3tBIlqjoQtb3J0UGndLmxXIVWc/jBuQp/+6t/hGgYllTQi/om+LD13f7r",
-	"sjN3rNLPVLRmt5WOk9EL40YHqiVTVoU2pGzQVc3cuR2UtjLfvA5qBZyjThKo7yVx7qLe/xI+mfqVrcbn",
-	"l1GL3BmDSxXl011pTSukVuuwIlPNC0zhKJaf/rtEbFmX83ltbSMHea7e35aLpSqdm1GWB/ZgO9KFetCF",
-	"Vur3WAostKNfXWBmpzfdoJm9DhqhmW+iAW/g1Dsb74ZWXT0MLXjdPmhyMYP5zs1eENbmVHW5bdVvzWv2",
-	"8kjVTdp2mXpLjzugVmWqtcH/6fhAJxhpJqGv4w2N4fQXDkP/mhoNt1jfDHAkIt12L0fQCnS3E4Yt+PJz",
-	"1aWOeAFNzWdm8tlxFbw2taIEoVS9MUUAmgYUrRrvDpiYzmIeQJob+tqQvU+2d0MFsRX4mwx8pAsqFJP9",
-	"PnpP+28jNq9u2QGr9w2K3ef4sJF9DmA2FLNbCcyyKUzOOuXtG9X2iFe3RcuXdepEYxEcwJlApmGHJ0TH",
-	"4Ehv0gzjS9jKu51UyRVuzuAq4fvcrOm1uWXreuVwe6WV92W6DJXvuHeVBckkRb000iZ6m6VxtYlx+i+b",
-	"UbDG5CdCe8IMxFRrh3OKU/D85PgVgELA5Ix3zMjlty39e63pdUKOf0NEnZ6DxvNxBP67i9NTCaBNNq1n",
-	"NY0s2aYT2+/Xm1uxEZAjzqFO7muqtxBnustkYGLFX9ab74VqRYFSw5uchxtN/i939LUWIoWvdvBS5ie/",
-	"wikttYyz++ueXgvSa5RAulUCcOSC4XK1u6Jtcf5FhVRDHtTSwqZid4spqTeq6z1zpOomw04QK4q6JuLG",
-	"MyJx519yiLr0OqcpMn1epktHZGX4DAGd56dUKY5Iqjqtq5zyo8OTUzfnUuFczQ75UNl0JLdzVeH0dai3",
-	"5Vt8cXERSyDEJcuMWjxcg292jA11x7uSXFzZ+l3T+f7GvHHYBB6v2r8exjhwZsmm9q/CAVfOU4n7/WvQ",
-	"J1bOpkX9/qbaQ+fV5Y2WV9LKM70fnY44zWv9wSPV6rOOkhGgLNFgCytF+o9X77HZOFdt+OsA47WTKSom",
-	"oy81Vt7cFkVFVRao7ocU1qcu/5ZoP1CigUeVrHk8VLo5Btnqfj2Vt9Pp12ObQpst+MHounjAD0Lri9qk",
-	"oW98BXnJBVjAcwkKdI5V4Ut165mqkasbBFXVGF0C71bbsQwppfIzpQQFC5QV9haGZe0xkcp91b2lcWyX",
-	"d7uf0T2PGgTaDvX5LtbvPSTX0UczfiS5btJj2/w2COca48r3s3lRAy5+F2QNpbsWbTP4Vp/LvQ0lNxF5",
-	"CNXQsieg/JKk3oWbXpnudAkwsbhN5oC5d3XyMbBs1gQx/ctBzZWgYVFxWIobxHrnqtMOdIjlUm2OUa19",
-	"eRvU3nJ74Qnt3uIdykQKBZbl+T+AJhLRd7+Y3V5H6xWs69tia9wvi8HluceBm169zijDCnTxzLzot3DU",
-	"vcktmkSASqy6wNwWdXIgLY9+Z3fjbtsbJKCOW3S7cna0ushst8raBKspS8Ekclq4eyl/ttj91ntHrBYe",
-	"x+7eGjW74YOuD7lud6BTOOUR39cyXXtr4mZlumVxFSOnLK7DyJEEqAwd1Z0LcyXSbOSzg+BuQUVrXxh7",
-	"xfz0SpFzyfJWqSpg1dje9g/drLFkso5Z4xDHVcwan0p+lFlzyzSzkVnj9DIyYGlTVKD/yl00a8ri4Zk1",
-	"ZdHnQ/NSmjURrej/0GgV1kxlqfJodaaLZwNoGeKbBSphtm7JcU7PTGaNHp6SumEH5rwMNV051gPeZPcH",
-	"d4oe8jj2tiYoQN8S1SRPJ9HaSpQKWD+CBoJY5x6glzNuDus+5ovbw2gAPGzeqKcr5YUhMDmnQm8sdD5p",
-	"4NKOgxkgtGrvNqXpUnJ9Gz+JdCK4F57QPv4K26tMcpdEu2TFTeJ+4LKVPidx4A4V8AjPlMJYb3/l1h9f",
-	"2VNcZw4evgskArZ28FuVmiceQN+6oJX/W32nRT852Fjh6ts1gu17Q+3WHL8QzRCPqrTTyLscnQsoyvBd",
-	"GZ90svGNMUg1fpdV7mouD6+JqGgFfMNNROU/t5yGgH2qtWovGGhbKCiAXsNBqWDDtHZsePpjtqwrQP22",
-	"hiChZIYteukvLQdR9YPYXj7FznUar/pgXjLd8zClgNM2ojnXAVcId/1MtePi4dWNzsyltSa1s+rnJqht",
-	"6BgAtwvsO+ReVXTlINMDvxnBOe42VayybBXh6d4fWqPsc71WF4sa5bOTTzfa5TR6GQVIyniGlB5bh4EN",
-	"IerQh5py/Va9VS/pm2xl0HHjcgARPqKLRrjbM2qrcoZPx++dXormaO4Oib10lmWxFqVjMKlPVxV1eeeu",
-	"Shmqu+c7zv3htvXVwFK8s9kMoSkJNUFyRNLYhWC8ouvICZIKDwl5vN3GIp1trT75V5R5T01Bm9PzgIMG",
-	"foaiiKGrtm+aCHvv917PbduixzYju3NUGeC1D6GviMTtIPS7iCifwZ4S7bWvxq5KC9oSr8N4bt9jcDNI",
-	"71wV3nV5wXnrgmyJ5h9eTaqLsNVlNuq6hrsUKZcr1Jab7z4qi/RBXrvwARI4X3VDe0fnd4X0qyPoz2vd",
-	"zVrVVaDcD8VNm7iuQ4sBvQvoxgTnOK1rESvrylwNrjp09iloNxwxD13L3qGg1U0ivfB3aEM/uotBP/lU",
-	"zV+1gH64t+Y0HVKushWIgDdFhX1lSx3tahMINpFBu+HdbJQhunDIBApZUNoWckKKjdkN8MLql3ft/U3r",
-	"X8E79q+ocvmbvYvUpU+hMoPuY0hD43UL1F0EU0XCYdojak4EZMKWY6ogBp1Zj5wOWlVxX+8md58UKoKq",
-	"o99OSom67ttIK0sjVZVMT/br2p4E/3b90R1OzLA96CTx2BqBGuTelf0P1djWSUqZcolpfFPhNLn/iyqK",
-	"7QKiX6vaLPFjPaTvu2pXHaSG6Np4q22RAPbeVETPn+p6kz48iBGcnBHdBub2REJ4j30Xqjor9tumpem9",
-	"t2C6KdCEBK9IfTW99V3Fq1VxXjG+6TLgAou6W8JGoKVmWFgg3teIChYFowVT6ZAp4gITcw9x4aWhDgu1",
-	"q02sXXisP/unqq+/jAa+fros0OBPjqsGOuaT9erynSvC/6KFiV6/aRWT9h1LBn0dKghkT50jxg28+oPn",
-	"5sWOmuTw/cWhqPhvZsIr8tJw8brpgeZXrztbbLiwFvW26Kx/H3V2xs54d/xktKo42E46pDz4twBoG1lN",
-	"+hDuoQnwGonqNuvz6ujbFzErWcDOLXdqOE8WlAvQiCxPjg7AifpkFI1Kljktt77zcprSHGJyOZYnOv4u",
-	"9VVKLsdEjjRmJdk631Ecx6zkeyjW20CGCpXdoiFTihXZ5EWdHXIOGaZlq2O0DoZz8EgHYup6CLfnbaRb",
-	"W0SVPheBD68mj51ufs0K1e8dukHMUKYEV3DlwY6NvJ4W5MppmCMioirdpbrn3s+Cqe91tn1/rLwNrU6n",
-	"GNfDh9enk7JMQl/UkLmqFt6UcrmzmpTBKHieNs3HXfmqVfgnZSNSkb6Az+RnOGuyU2hHMq+XpizdADAU",
-	"AYSnXjh3mPOoSUVmPmXTKSXQlqM7kxryCnQYqmSGcTd6TiRnNdLWM+Hyqs0CTLSxWE3jiZX2ZKcLxJE7",
-	"KGRIpddhyb1SHRu0GYRaLGfKbtFdCrQfhy9omaXyNVNGn+psdtPJ4OTFO2dBdaX95dfL/x8AAP//gBDf",
-	"lF74AAA=",
+	"H4sIAAAAAAAC/+y9e3vbNrIw/lXw6OzvafJbyU6ctLv1+8d7tImbuLnYazvteU6bs4FISEJNEVwAtKPm",
+	"+Lu/z+BGgAQpSpYvcbt/dGORBAaDmcFgrl8GCVsULCe5FIP9L4M5wSnh6p8nJKWcJPItS7CkLIffUiIS",
+	"Tgv95+DDyVskGeLmRSTZYDjg5N8l5SQd7EtekuFAJHOywPDxlPEFloP9QcnpYDiQy4IM9gdCcprPBldX",
+	"V8NBgTleEFkD4Iz9syR82Zz/DPMZkQjAmDKO5Jw4WAbDAYVX/q2+HA5yvIDJuBuyE9J1piGf8aLIYPC5",
+	"lIXY391dLEe4KHYStthNsEzmI/s2DDdchYbh4JTO8sP8mLMLmhKu4Sk4SbCsYK1BOCcIVojYVIEnWEJx",
+	"hgo7hEFGgeW8woX3tB0TJC8Xg/1fBriANQ4HMyrn5QT+wdhM/ZLR/JykFFaWUpEwng6GA1EwSaeAeHlJ",
+	"ZTLXX2YYvpxQOSmTcwLIu2T8nInBcIB/LznB6lPJ8QUGPOGETBg7h9donrJLkdELYoaUhA8+xpB3RmHo",
+	"NoKhZt4YbUj7sDdd2A8qErggnE6XBwtMs/3P5n+DdjDPlgXxQF2xycvCbbCGdQe9dN8MUc5QxvIZ4agU",
+	"JG1bJEDSsaTGHIOhowECy/pJrXAw1H+9YPmU8sWLOc5nalw6y2l+jIW4ZDzNiIC9LcyfJ0So8UpB+GF+",
+	"QSUZfPSRF44fEQ8aaiUbxlISIZVc+sHw05cIU+DqNQT/TxYkl8hwYLWyAifnCmWyWAAx5ilnNB2dk6X3",
+	"l8BTIpe5WsKUpmxU7k0HQ8cZOctJhCSHg3GZUnlwQXIFI05TCuDg7JizgnBJiRjsT3EmGht+SpKSU7lE",
+	"nGTkAucSERgFcQJcRlIljTDsNgcse8N9GSScAFWMI3j5eU5ytcF6tDkuCpKr1TvJlGJJRpIuyCCyHmKX",
+	"8hdOpoP9wX/sVkfIrtmi3WrRQFLwGU2boBy+tMSmB/WpYS959u3ku+mzUfJ88v3o+d/Js9H3f/s7HqXP",
+	"0yfTp+nzPbL3PJCmJU1j4NJinKYcKLE5/THC+pkFI8ko4ETOsUSS09mMcJK2Afjk2c6TnadPn+38LTbv",
+	"gkicYonbNz3G5GP3JqK5XhvQLp6wUlZwDNXW01xInCdE/Q6cN6I5WhA5Z6kP6JeB+c1w2Mjy4+DKgc0m",
+	"v5FEqh84TshhZK/O4AGqdgykJBGduGrgBEh1PDPkU9MlBOEIw7P+e9EUq5Xo/mWgyKHiA0u4HyOLrlFr",
+	"q1jE8J6b3koPLfSc9BtNMc0UP1lEjxIlH1MrNb2/F1M8IjmeZO6vlAr7pzACAATRCKepGVSOzKrMlKzU",
+	"p9aUEzEfSXZO8hEnpSBG1o5SkhF4vVs6iRMiCpYLslJMhaJGoUP9i0qyEP0lg0d/mHO8bOygGTm+Y3JO",
+	"ckm1anrwWZJcUJaLjbmtUj0tZdN8hrD3AmcJEQJ+nSx9AsV5inAFDuODNeB9ocY4KmVRyjWBf4cLoEli",
+	"x0JMj4KmnC08ABtnAy6KmDAeF0Vm4EM0BWCnlPDG+NXqJoxlBOewvISTFOBdufsvOFFD+8vTq4dh5guc",
+	"vFC0fUoSTiJi4vW78Qsk1MM+kF11bwXjYyEACJZvSPzBxr804j4E+R9YkO+elzxDJE8YnNvBR0gdEhHO",
+	"1LsHY/54evS+z7iGIGFApL6JjAoCA8uSk16AWuyg6rMWsf4a52nWa1B4G83168NBXmYZyDtL490yvYaT",
+	"YQT//hI/rtx+KXEyX0RPJKU/BjuF3dtowVKcUbn0lcgMSzix1anDhBi5H+JyNwTDqqmb0mE1wpFe6gpG",
+	"/HDy9kBviN4hgClKzOsO0iTbdUcoyklGkzdkea2Px9mMcSrni/jO6vfQOVkibN/0xJ6vWdJcfve8onua",
+	"SzIj3ChLuSgYl6IP+XhvD6uzskEZnUdikwEaG7+S6E9JZowR15B1Ied0H/jxz6qVnRChMG+2vHFdkXOi",
+	"zS4hPhelkEirQggb4TeCkUbcDOjvcuJOHiRYyRMSPch4CErXujyoT/Q67LJAwql7LK0sZ510XHs/GG7l",
+	"EXZmyWo9GtSKdYJzNCHWYmBFWSkmg+EgnyaD4WCixLRYYC5HCVbWnflywpV2DazAc5xFJdwLll+QJVxQ",
+	"jjmZEk7yxJwPU1xmcrCvr8zDFdf2xA2DimqcClYzCM2dRc79gwB4BacifjHXmsYRn+Gc/q4mU1YJtwVE",
+	"yHVVYdDum9ug7EH2WqOOP8kQzZUFxL9R/sbm+Y5YUDn/z3zOhNyhzJdDevTIQjjLSEQCncDP1ZyXNMvQ",
+	"HF8QRPX9n3kr9+H4ZbAgi4k2sG0opiyoGrKYRGoifzOUb3bNflEKyRbIfmz3poaRBtDaiFbH83vP8tqG",
+	"08E4UdaUBc3fknwm54P9pzHtLCtnkbtxTv9dkqG2QHNK8jRb+ir6qrmxmRt/tnN/90xdJIF7B/uD//kF",
+	"j35/Mvr+418f/d/9kfvj8f//l5WXbIURA3f7Nh+PzzZkqM8F5UTEzFgH8EiLiBROAIOE4/FZb0tWX9rx",
+	"TCmaAgaL5ajAxpaZjiZL/RMuilGS0Yhdpc4eblkrcLaRLthtadMIWtvOVlHLr79Ofnky+h6Pph+//P3q",
+	"118nI/fn86vWf/tfPd2Dz2I7UhAuYI3jBO7aZ2DKaK7lHq8gZoKKrall242CstVL6aZXnPYrqbNenBBR",
+	"ZlKsoQB22D5aTMQAYqW6fSNCHb15FOLLw3QVQLF7A/dQ3V+bbWyUY/3udSB4CXFScCJILkmqbUpUIEMP",
+	"vSjLOHP0mr0lNKhrOPg8mrGR+bHgTLKEZTtdFOd9MqILq15WrkM1guaq+WDf+AWVz3PGRpdkAmSV77p/",
+	"uC+uAkpXou5PQr//hB4xUHylpN4guduh9GNrX16XxLNZG4aXhWQzjos5TdpsGBGTxbJYufUV1Np5V9sQ",
+	"sxcAWfc51jDxruf/rAZCFU5iVt8QY/w8ov7kKRAyEYga51I1NhUII2cx0D7fPnbkGpaim3SBM5rWmUH4",
+	"RkNloFCe5tgV9YBzxnsLx5r7WOI8xTylv5MUERgI8YrmQ5ypxxEdW30FAsSS1RL8H8rfWJAEDBYIe24D",
+	"PUy1OnPVH8E1cATmmtGEjGg+wlnGLkk60tdDFTiifF4jkqcFo7n0fzP+be05wxknOF3CINrBFf6s4i+o",
+	"dWZPaJqSfIRzli8XrBSexWIkCL8gfGQhprnaqlHNQ1o9ME6hwXCQsQRnZJQzadfhu/okYyMxZ1z6P9J8",
+	"NKeTYgSXjQlWcFeRSLWRFK7Cn8CgXRa+Z7DM7UoteuD/9GfBajXw+q5SLcXzFHq/u4APh3rwR0omCxXe",
+	"oP410r4X/yv9XL0KIhRgmLIyV1IbvrB7gxOp43fsl3CgwN8MBKfxWxLtGq18qPphwdmUZmQ0JRBP1Xyo",
+	"Apkam6khS3AOMAmSpyOxALTMGTsfcQKMXCOV2hXa/3MEd9yK7uwc1JmMYMPnLCejvATrSZNU3W74b0VI",
+	"zX9eHzQkDCC2Bc6XljpFsBi6MFcPBd3H6AVYCDyLyK7X5QLnI2dr0NLDvu3fvQ41zEjxTRVY05gJ9rqM",
+	"2Khen50dI/0QESdt/CmeP3nSPMdqJ5IZvVrQ0Ei02Pl0mKo7mB9f1ylVGyFwOvIths9Dh3ECNt3NbB7V",
+	"tsVE8mHD7KPfRtVnVkSDSBhW0ULG6qdjGDI2U45r8+Onz6M5FiXHI8VRPgifUJJhugh2XZQF6En/CYYl",
+	"0IRWG7Y4wSIWQvrzfOlghZN4QgD6an6SdqygTolESDoz61eyDP3H071nz1dDpyLSOq0mzmpbQXYD0Uo1",
+	"sjZgDUOScMiMEreyKm9OfHdkwWZqopUXLIiM/VAcmZfjRudVWNnoormaQDQyUhuNdzuUEVvrjz+/WVNR",
+	"BE8i+plM0BuyVIFlP/58hi58/1WvS4lzuCpvErqkcq7veVqlrjBycrr37XcxKogcRCenY+vEI581OYRm",
+	"9X+O/xEb6jx2xYb1Hb4MvofIKpqOnkbHkMv4GOYC5K9oHBsgj69nwdIyK0UwAp4kKciqnZ2dlgiLOChl",
+	"40QWdLaSfGD/hgMy0HjSKwVw9UQtVHVK5HUI65SowFtNWOoaAWTmYjVEg8jOyTKiMIzB3wRMV7l2A3d6",
+	"l/gA1ljluVLjxTDwlubnRnXY8FxPW+zZEHilIiutQt4kJOZ8p4HjCX7W3JaW8K5CJxyTJlgtNlbh6T1d",
+	"yKqrSXVE+ckFabtV+90P4xdznGUkn5FjvMwYTtc1A9jPUaG/V2S0KDNJR1OcKGNeYHhrUJK52LQE8MNR",
+	"VgqCLucEvM3ARk6FevfDGCV2/oDNFlN8xmSxjyfJ071nKZk+j8m0Gs4MIDE8Hb3pfTRZhfToTVQJNcdj",
+	"lT2zJp3y4MPtp7c0l+5futajDf/TQI/ESPuwEZtuFBvv3/zQJRaoCrnt51vsdsK1umq3FfV+fU/1elEG",
+	"vmq4MryAXebrRRfcsGO8h/3Z93OHUeXtIQ7xyJI1Kbz6EOTUb4zmCOf1FfV2mjv6rswWyLy+JcoO7CHb",
+	"p2t/4Yd3y2L+BO9vmd3uJKhHoaG2ARbO4YqYhjgvbJpyUBFZ/7yDFm5cuWhvqlUL23Q5Pk43W9DKZYRT",
+	"xBZyDGbG98rKeCSLzVRcJosmxR7lWpYga+pEnCSEXmhP4Om706iWWkHTHFGBirRF1D95Ar76K1ynvv3u",
+	"b3//fqWE9ycbqkVEEWQji5u+xKPKfLFZzPZKj1wslvIqiP7VaZAtgcg6cpKTtDMjsvdFqpl9GTmycWvM",
+	"cW+3c/UV+OSdHr6B15t8TrIyJdXWxa6WKKNCpZ9FtvqleZVx47cWlU8bbgvRSGMTZIs5QTmTCCcJKSS4",
+	"VuAsV25IWBLvi/ZOsGJbQIK8qE1CF2CUOc1jdPUafoaFzElWoFlJU+KZB+eclbO5+oF8LginJmx304Wq",
+	"2WJrLMqJeVG52FsYICUCuL3hilVGcDknVDtHifJR1XzrXlWCnvDH3P4R0HmxOsY8A1PJMeZyeZBLKtV3",
+	"IE1ZKWMUDI+GoAssaJZRQRKWp2KoybAiOLghgeagrrkMXWIqXWkDeAN+NDdhEo0iUNK2R1S7hbkmcHlh",
+	"PJYDn60je9k3vKOHbL7JMI9uxtwkenNd+bZpJkosC6BnGmc0BSFC5duLOKFp34P59TZFlsvz9dJxBzZG",
+	"bJSSC5qQKisiZpKJQGgUrA01B/AA9zzLrn2E3fqpdc2D/uEfebw4bAkgPDlWduQwwEnMWZmlwOAiYQVJ",
+	"dZ2e5t3vXpwq28ybChLnHFFd40ypMe3NHil8RnSaygvwv4vNbmQ9PduXcyZgk5I56EhqVu32F0p5LQCY",
+	"9E7dmic6aEk5GjbJGqpZUfRXunqVGhisalhF6Gs3jCmuMqMXZIW5bTOTFEytIqKwJEMEWdDwS5IRbFId",
+	"tSa6bSvWinRrV0aixVVldsGgSHmeJEMzkhOuszBzchmg8StJ1AhW3TByraLHm6LBQdM184ffnPheNG5J",
+	"18+YGqNSeRd8f4I9zcyESM2IiJpyiC7nNJkjQaS2z6qzuL9vZozmKuiuwBnW2ha856bUk/TNx6NtRBtN",
+	"WQ6SclURt5JjLfCbKoYfKY24N4oX9hsM4WxPfmm1mJZ8SoRY30GiCwcFuhwSeiTIHZaY5lohOSe5vu4b",
+	"J5WrrtQMb+nKPoOoBe+E8CbOZ2h8fIi8WMyKu8jyx/nkVUKP6I+HH34/fPqeHorD/OTb5MXhd4fnxX/9",
+	"9OLH71tiPzxoDrSN/TDvTIdUxlZz5ARHGs2R0d182L6HAMsexQ66hc9ZIHS4FUU1EO5v5qG/uugp3pQC",
+	"3Je393dlfW01zSghjw1ayHBYPzlraIwJIcPmGwaDaI42u2D5vIuZRSVVOkMLzWvRCAVdkXNsI+s3U8VT",
+	"KooML62rsKKWH9k8R6cQNBnbPp1uEL2VXTKoJMZxospWmRcDqZOHad97QSTs3nZSoqeUC6lXpZYyGA4y",
+	"7H7R64pmRLegWcWaHruakddUrTwBDXcdENI6Vh1OAi/4vlcYLLDJN8IOYMoKbiP+1QHSNqUHaTXbqeRP",
+	"QO0R4vI/IM3k++f/+/+FG/7tk2DHn61SHyyAbrqPfbdpoxQl+5li5jD1pn6kK1vDAi8RzZUnB2HH/Yw3",
+	"4rTC3VxMV1YyioWpXQ23KDweQujgZlHatx5yqBH+boq37sdGoR9bkFyCD1vLA8bbnNnd8YeBIvA/iyl+",
+	"pFjrf08X4vH+To8aHC5vq81z7RByxm4WI1tYPIC4zVUfnR0rdG41HWKMvKynryEBIsSFrrF83zGyIcXV",
+	"cKJzANfFzEZhPNs7LDaul/PA6qf0LZ1isOaVHf+T5yNIOV2IP0O71kPVRoLgPuibtcVsuOmbKnx3tcUd",
+	"mwtv3eStsvCX1Xm5vLPL3oqNsbN7C9kO2/W4Tf5snKD3WmRfrQT/OppV5bHvHy0TLUDlYaO7o0iAnxXN",
+	"RLaAu8AdXy22jSiOSukhshEAE3gt4gVQ4EbLSqkLokMAQsLynCQSLr0qake01IDt72Vz8Q0l5ySX1ibR",
+	"n3Y+FNu1d3Eyo0ISbtx9JklAzq9h9TrwzV1uxW50nCSszOUWaOQaFoZWkWoR2w/uO7SoVevZIBaMpCc2",
+	"XSTI+LBhlmvlWhmughGDAW3EZmOAfpZtD4nP9sLyo7/+Wnx5ewX/fa/+e3qFhjvfjD7+9S9/IIv48PYz",
+	"LzXdbVc1ahVA/RWkByAF1tGBe8F0c8rXh+KrUL5u5b78obgrTa5RlhBs9zQ5b0nkNU8c7djY5LB+3ZZx",
+	"t0JpA9PtKxNtdF2HkBe5cXYEJaKILIugJdZUu30GzfJJeEY+8Ky1XeU/T0xFO3hRDSMSnKuplJzCuX+9",
+	"YxzhogjoF06DffX1bpHP/s9ExR4P6U//ODq5fPLm1awljkMyWbS1tTFrhIewgwqqBc5LnJmV94Ns/I8X",
+	"Lw9+ePX68Mc3Slqszm22yArAi21uI9a6vdPLyHZ6mdAc86VtcOMYfLKU0YzXD6JHNa5I8I8pDqcbMWnR",
+	"uSK8R9ILAu6QaN3GsXqsXIoAomMxo/2sjKDEF1hi3kWBdrRvhIO9oInprhM79u2pr4cWu/Dx071nO78V",
+	"sxgiOwocnNEFERIvCl3uwkLi8BaWOvCb3e09Gz15Onr67dnTvf1nz/e//e6/e2eM1xTKEKKX+qGibMZt",
+	"dCxnWQPzayuiUfOGeQeZSLm+YRi3HRFQNcOkJO3uilL6MMyxQBNCcuSVwnTQBBTr3Xhp2lZbIRb9uGmJ",
+	"q9sJd6LCxel0oY0KVSYkR65eZqvuZSRPE21tFxFrSHNJt/pF9CjD+ayEUwfk4+Nbuph0d77AQjULllXF",
+	"ruYGb36jua698cnet99+++Tp3rMVjoS1GMWfsJtfWne+pTjDW5NzpR4Dauks1wGRMbT+4lIg1Z5cqzZD",
+	"deiE1Ul80RvKx7qIGer6Dj77OAr3iC2O9q4yKLDNL4lmM/o72VCl1pY714a827DpWzJVhYwJQdAoUwch",
+	"95Xt9+UG03WxHVeuNzZFC5rTRblAz1BlBdn+zVbocBLTVLbJcCoKPmhHa0ps1XtJ+8VvC79n9MdVemsA",
+	"Qpd7XyUhw1Pdonoz4svJ5cE9I5Fmka86ihzQnWg5JXnqp9A9IL/5ahStIJtNMkk6NdAVKR6+/jFENJck",
+	"h1sUyzN9ITRj963JdDYnfq/NIN/PzNKaVXIrSSrhaUTT9p34bHMr17gaHlwQvpRzuNZ7pY2RkIwTYRpq",
+	"Y1MjWWk++votECcpNoXB691GXKvkrXQ79uv6rlX5p8LJoT9AbIabKC1UBXRuAnIV3dkc1/e8bTK2n5QX",
+	"G99ayd6Q5SbDn1afx0bfONGi6uBvsFqDtI6Y+rYOA9JskFU3W4UktGaLCROBfTknnKjLVAoqiJBcmacq",
+	"OEzj9mqha5Zj1F4ELIIht1WxLkBAj8JvGxZov5yzVoxsVi89gpA1W+L3LyweYeA1S3VCvQVkaRxlND9v",
+	"XI3WJww3HuBCj7klsoDB+lCDH9zeNpZ7x1d/dDmAriE/9Evep3l9kjWpwPuyNnU3JZzUAiXWoAZjZPVS",
+	"OGysZ5iyyKbXo48gR2+jQq59qmmGs2y3oGY9y3AlQbY05QJTdsuQFU1yMiszvAEJVVgyn3ZTjn+Srkc4",
+	"P5MJCJI88Hg5p7PtLtZOMcaBFWMrr99Uhf5VjrXuzat9vXLvenj8esHVsl3V4tu2590UXz840pS1AK5O",
+	"iftrnYrdK1w0lpiVj6aacAcduCCn03en6rFBA8LOwBca65Xo8QyCO+iDIIgsCrlEGpswg+mIBCPueFYM",
+	"6WcXDAe6u9Dgo89R5pWmj4alkYX5V3HtpVSxa83e5+ARQkr+KNchFRoJBFGpTJqSgc3Lj0xTo0nm7wjj",
+	"SLdbcrXOtblmqJ1D5j3ngw2GxgY8L3ZBX6B2AoHytMUPOewbUG5zrJTPa3cxxbstCF1R5J0TWfKcpI2x",
+	"dpAphZD2xI5Gjp/zpR66Ldhp5DRp29O6aV2KQtr4dN1wnYZB6/hemRRXV+jnRBBdbMpCN7S8ndqmfrb4",
+	"u6q1WbELScMdKRzuBJG//tprM3yMrd4TQeSfNrQGSjZOQ7hX6QSxZV0nWGgFL4Jgvbl8gAeT1TPsxaFt",
+	"xeKCAjh+vZr6bnj1btTRpySO3yrJHYiwxVa3seqCW0JYFMcvlxOri6Opa5ymntZ8XwPTtMDAGcrXi1Bb",
+	"L9IsjpGtNcsHy3mlw+fkMluCukjS+iICPYcA8Y7I37+fjJ7upc9G+Pm3342e73333dPnT//2/MmTJ+sp",
+	"/ABE3qH0w6Fnbuu9DD/teLxGYQK5qkaSZKbhU5+AZM9Cewp0qOcYg02xLXDutTLta7ujVUBBg08bWVqe",
+	"aRL0ZFulCbQ1CiPNCdYmEL0dg6ojInxY9T01MOOCGjPwPwjmhMOlNBITp57Va1kodwPgyDd1tIBt4YQH",
+	"BWdSp6/YPrkKfMWzKkBBzVbBOJeyCCE8yMiFdpv3g1RV3TCEJBAxX6OC8AVVlhthwNbVeHJBFXKd8BNV",
+	"3Q53EXPUVZHzggCqiUCiTOYIC3Xg5LIGzQ76Qd0oJaaZQIIQZCPkUpaIHav/7Kq6rGIXPt61II88kFej",
+	"DCgRIghN5IHEifS0M9f+0tO4DNG8h1/QqX4+GA5KnnmBfO79q2ZZo0XByRwQeEGa1cw4JCvZoFA8gxuZ",
+	"1nWVkARmHFpTmhjqOMhwCH1zgZVnNCFGShqY3x2eobfm1zrErCC5YCVPyA7js13zsdh9d3im1XaZVcsO",
+	"C+pCLbTBcHBBuM5oHTzdebLzROueJMcFHewPnqmfdIFUxeu7O5cky0bnObvMd3+7PBc7vxkz+CzG/SdE",
+	"ckou9A2t0W3uETSte+yHEns941ypMi2eas3odtAZRIhZNkPUvD9ZIl32WfGjUsqVbPH4WLGkYwCwMw1e",
+	"Efnjz2+E17deLXbvyRNLYEYJ8Xpp79qF6xO5R2u7UyI15TbPEX/dNEc//vzGtuMzvRuc9rMlcMK+5RGo",
+	"xqZZOGKJMlDArVrFwerqM7b/mpF++mgoFwvMlxqfwZJiPSsj6xwOJJ4JFT6yFJIsBh9h2F0l33d1DNZI",
+	"F73dVQVv1dWDiSjdLdiFNa/4xXJNBV1dO1axqFAOjqUqo8uJYBmo0XiGjdE+J5+lrmiBzYmpAhNFSVJt",
+	"iaIS4akkxhYBeFFhe2pqPWdKCpJDmADkOgqaKm0BeHEHvWRE3YaVF5xOm5V982+kgdmZQXTxyti52qDr",
+	"Ro1iU+GRCPkPli63Rk2ttZAjhPXBK2ccrLaqgu2qGVcakuQlubpB9vS6+7WwqKEcA+wlqaoufy3saQ7z",
+	"wf4vNZ3tl49XH332Vdtp+OMbEa067fGqIsGAVf0ezK0sqq4tMItqxj/K1PXVupsUf+ZLx6HmQEdC4ulU",
+	"dUMQRLO3h2OEjTYDX8EBUauumWDOKRE9+2mrUzpnNdeRY/6hERwOZN3FHshXVbhPgWNViEvov4YRWvtl",
+	"r+ZxBdWn8Yez1/86fHd8cHJ69H58dnj0/l8H78f/eHvw8lNTCNR6nt+QCGjprN4mAMJO3UPtexfNHul6",
+	"Gy7ny1uVBrUKmJFFnJaKsqZlli2txxLiK8K9FrYMyEOTEN5uGznRLRB0I/KVsiB3cW/6gK4SLnPrFTI0",
+	"4fdHhEAAuHpAnrVdFvxmHUrC9A8K3UowJOiTQg9Jc8PQSyNeJMKenZ37abPw8zlZahFjQsnztArZ1zXp",
+	"dcgNJ40wc7PGTQ70qk/6TbFxoz19hLQ6ms3bdk3I2K/NW9TP27g9Po60lW853V24TsXLab0PpzBBgg+M",
+	"lc2+dXGxuaS7ThjtnPyqKmZvE3Wrup+Kq/VY7oYcXvtDajfGEDvQTV7PVvcSiexL1fhaWHuzWd1XKvN9",
+	"S1mdTsxmWJVKa2gIZ5zgdImMhKO53lVdcemHw5dHe8jbPne9s5PGyWvXWCTbzwvTpKaiMYd3sABUHexr",
+	"fnl4bkmoQW3a1hqjue2L2a6iRJF9dTE1XtMgbCsLuRWFBBhctu+Z9mStnGBOdGrUg+MYva01LqiTo+YU",
+	"bSwZ6c5errXhsiZSWhloTnAm57+3muEMJMYL32K9pKIyC+PMAPbq4MzYJhv88lpN+mJOkvNXRA7u7HJ+",
+	"WsGv8bBUh7e3lq/PgKZxixJALnr06uDsccw4NlQ+kW1u9+uD8cse+/0apo1v+B9tbwBjj9ssl3AL2aWp",
+	"c8LFT7O3cFep7RRJrfXFJf6bAhPksyQcdqwWr23f0xuJc1d03LtnRP1DjY0GeEyl7xs6/rwZOk49uwBF",
+	"Mm6d9RIjdt2Aa3Bb3h+roU40kEsbSv+ADrvKTVk/9BQx41rNCpu3bjfxG9EkV5pWNfLjpxzEA0omi13b",
+	"Mav1vPNuIVBPYzTBgqQIwmbgT+SKTT2CsMnH1rSmXaRS302KFeGwIcvoAEITn3uTR2G0hE/sTuvVq/Hp",
+	"znUbSx+cuuU23Vu7R0y6zJ4ipEbO3WrfZfBJi7BWEaZoQVwQFZvp0gaW/ik31iKaI4KTuTGOLDwJrU27",
+	"Ryevxu8P/1tZdk87TLuviDyqJZrdnKzzJ+pjTvlGhFh7cASniknIttVWlBf+DmrbCo8ImD39j4wvo0lw",
+	"E5KwBdCTFEhVALHU5yTuJ3aZE/5J0d3GZKYBOwo7Xd6EXtCcqEM9gLTkIRJZOQutr5FenrerFPgTt/CH",
+	"D1xgdnzQF+LKzF/bnDZOaQjr3S9h58+r3co+K9o17MDGWTWEkQz9xmheB6jiHm3IVzJ7Bx1Bmr/mLxFn",
+	"MOU5MHZwmEloxtW/ENOnW9TNyqrRT2U/MyNjGfortsi8h25uFdnD8YJIlSr+S7/OuDYgT3VNdpFV4cY0",
+	"OG7oEeiqnsMfb0u6VJjo6+1we2ncA/o8V24jVcdmRqT16t4LAeTtdYso8ijxDyOIQnkg2ZoiqcCylwcE",
+	"zvFjmzWrW6SgM1eVvOBg5VvAVTZRfUm1ZX0HHY/PdFdvKPBuAiNMd1QlF0DKTExvMJoLSbBKBDKpoXV7",
+	"j2mCyhZ6W5V5QqxtE9C8oxvT3Bxvem11IhQRR2Viwt8tJWgBSqpuq75ycKt86K2pw3oZDSSIk82DNRo4",
+	"zTe+xY+Ox6EBNLQLwNlJ813sVxVcGWcQFhW0QTxAO6yUxlABa6s8LmIHjYOvhOXEhOUXhEvbWVezoX4j",
+	"wxISUKgOCEyrKmsudLXJa7Vmpjfqgmq0TI1QgEsZKf1irg07nMONKS02uEcROk69+vrMzaem7aPDb7b0",
+	"vfShXSpgiFoJt1auGAdZGF5B+CBsxqsHD6eHLHku/B6fKkWk0epTOSEuWdyMhlQEN+Rtp21cEPTeuFFO",
+	"iHb5aAtf86RC1Ro1wFHTYniLDtiO/q8x8qw5iBzj7KDDqU2dNvs0RNjbXFu60eVue+qII42dr5fn2vuy",
+	"9OG/lY6fcbNJlee3MV1xbOwrGLBDj88j3VyWcfSKsVlGHu8gfcCJIHTOFtyeIpYTlDIiIKicfKai9ey5",
+	"We9PtN/u5v6fO+SzP9whZJ2QfkPjHpxgq0KsDugBEDocL83oHtZakCIoAKFTNPzqBEhIUuygMcroggJ3",
+	"VdnPWEqyAEsNGPR19x5UwOeq2EHs9KNTm2tkjjVPiLYEGvlNkG+U0cI+yxHKAAmvl6ad8w2Mqry2jk25",
+	"x5FGsDYfPG9jXEaevXZ9fWxpAj0CSgfnV9V6oy93yhtgz17O121z0xm7eXbyu3Sv5idYZlWL508+ur98",
+	"dLY+70A4gqsJ0+Z8oJJiSTSbGnZoCtl6KjUUflb9DwAokzkgCpJESmQpPd3F4gca3tCoglUohtbYraWp",
+	"HpqR2sD9NtXQNuK+UR6r94Rvtcr7yRwsMFOYZajtBAzeoZrYHSWkgxVyaUqwV41CvkoLfNcV6ujseF2u",
+	"6h8J7qZYX2Pc6hF0q/yxMnDcHT1VApXfJvTuDpyupv6x4wfPaKKTuh4grwRqXH8u8Tsy9D6E/I8irCJI",
+	"nmrdbVGhPOyIZZoR396R43dKv3neasy2pUPIw+e9PYs8NjN8Bcz2gA6iRW2Bm3CaWIht8llTEwzZLax9",
+	"ejdcd7oQt8Zzp53VI47rPWrbGc5ehr8mzc/f7IfEd2YvNmS3nrbDgOVgxrvVBO+QgbrtfQ0eCgwUgLg7",
+	"1gtjq2mnUuWQC2Lcw9KyX6s6uC7PyPUcTfFYi/WijxphTK7eilre+Piw9XS5sUgiN/7akUR/epPu4mjo",
+	"F/LTSfogz64f59Da/l5H8QaPF6WQaI4vSNia8hYDI5QQv5XAiGCmdQMjWpH6Z3zEQ4mPaN3ivuxrPPu7",
+	"X+y/rlpzstz1SoVC7DViXTN2qYHCSHXNzVzcwA6yNdJFULamnpYYNLku8Iy0MqDXkyeMoY/tS/XKbu3z",
+	"q2FDTnGOl4pEjO9ZB5k3ujsXmWpRYer+qpD8f5eEL6uYfDMANJd1RVUV6XgtbhdkYDqjrdHcdjgQcgnf",
+	"q1j+yBps0/BYm/AYpGEb3AigLS3FIzN77cN7zRw0OYzNHPQd98q3P9sLuhb88uuvxZe3V/Df9+q/p1do",
+	"uPPN6ONf/9IH7rE6DqoeDsi19o1B7R7GAF63VfXVcA2p09XJ+toNqSNyyk1XZXj5u4oeqbKyJIcLTGo6",
+	"wjxuwZodIUKwH04OdfSuFhJIspYxeNVqIY59W5p3sRxBE5iELXYTLJP5yH5Z72LHaR/6OJwiQeRQd0Zf",
+	"EGz1cb+vhq3GE6YKqgp62JTEzUyxgaoilymlmxOSVr1jdDuLRgnsFpyY5s8BQqJJPd6B/uzJXizn1qG/",
+	"LsAHQ1MBXH39liWuH2JM3ppXd+2A7n1DYl9z8JU5+zzEbHjM7iY4yyY4OW89b1+rzrT6xLQv67jEGhDC",
+	"RFjpkEP/EN1Bx3qRpMqbdg8r51TiIhf9gPxVh+8LA5MuQLPtc7gJqTOeTpZuMZ7+4W1JnE1S0skjwy9t",
+	"IZDXm5im/7LhemtMfiq1IdtgTOVJXTCaohenJz8gLCVOzkXLjAK+7UwCXDm9jnaV7moedJN8RHZmO0P0",
+	"X22SngGCNlm0ntVc6PimE9vv15tbiRG0IEJgfUGsq7eYZiRtmVjJl/Xme6kq9ZPUyCbv4UaT/8sffS1A",
+	"4PDV/hnGw8wS3QYadsCur316fZBu8QTSleTDEsNKylVXwOY19g96SNXOg+q0sHlO7ccU6I1JVqZkQTqq",
+	"I9ijqG0iYQybQDv/giGqungLlhLTBmOy9I6sjJ4TpIPolSolSJ7q6u2QsHV8dHrmX9gVzVXiUPQ9m46Z",
+	"uP7h1Dsl+vPo8vJyBEgYlTwzanF/Db7WjijaA/Fa52JeZhlYSuyEjYum5vP9jWVjvwkCWbW/HcHYc2YQ",
+	"U/vXkYAr53HH/f4W9ImVs+mjfn9T7aExnu3SXutYBLc8/ZLfMMT0V67Q9YhBmQa3PNgfyoWMdvhRrP94",
+	"9RprvZr0gj/2uLy2CkUlZGgOUg9W0dSJhy7FQreLietTV3+eaHd4oqFH7qx53Pd08y5kq4spO2unV0zZ",
+	"JNfaJYSxJJXlOYwhSckFTYj2Y5Qi8F8UnFxQlVXq92j2Jqws+m0H3q3Wyu2TpxwGOkqG5iQrTC+mqdee",
+	"H5R7V1q3tm1X97vY9FfuM4jUhO6yXaxfGBrg6OKZMBCkqqBsmznXGGeLYSFfZ2XpGl7CXtcaS/fNWW7o",
+	"rdqXrzYSpE7IfbiGlR3xIAemepFtPR7UwJgsEc0tbeezsAEO1MEyYtbEICBQT3WqcpYZUmi5Gx2V8gap",
+	"/qiUHYQOb4wAVBsiWGlfwQJNvxlduFGy9iXeo0DCWFwI7P8DKNs0/BJWijk12xFUgxEipP2y6F374qTq",
+	"6tLImG/J/m85DfSLYYc73enckskQMaCqSypsRIBAcPPoNnZ/KG6rBkZtplWhHlpd5Gap3hWs4iyFk6HX",
+	"ED6I2LWVZG69MNPqw+PEX1st4CO+0dUmV7WEdPAHbPHXGuNRFtepgVEWvWOzVvIh7orPquWmKpMUFa4S",
+	"gIoFNkeeHTTBEDpvAxnLXOreUuEsVLjQLg3Fp/i6jF76qY2DbytYqzbTFjjYR8f9Y+TuY/GnBk3YaPuW",
+	"9IqHEHFvOfZaUVltBN7Ov0ZTbXAQm/pd3YJklBZe+mTs+DBUtWmai7d4EbsdplRzvFeoWDMmvynQOEmI",
+	"isbWuLhnJ2awACs0H05pgJCdtMlUs1tPfrqGta8stmHty5mx+KkeIlSou50NAWo5t27BVlFNsqU8S2fR",
+	"8E+3W2WWiHnvhSlS+tDte/b0Wce+5zHHdex7IZfclX3vlnlmI/ueVzHXoKXJUZEqn/fRvlcWD8++13Wk",
+	"DAdBap5mohVVBmsFqesxnS4fTJF7aAzTZ0jwk74vVYkNF+zchJjq4VlepT2YNvkNdjnRA95kjUF/ig72",
+	"OAmWJhkin1Ubf+I1IPaRdRc8EKU6fwOD3EezWV+jqmU3o4bwuJ1PPQ2aQ3QYF5iKRZANWgayVUQa2Wok",
+	"ElZUTZt1K4hGmwhWSsTJyGfTfNa79zzjsxFNP+mm7sGvJnNjpFI/TEd607O6nAhAXy7dXgt0TkihxhYk",
+	"I4kkaa1/DFxz0CewRH3SYQAEc2MOkfSC1N7esNOEz3O30CymbbqN2N21rfY3WDKNnz6hF3fO+FrYuoDV",
+	"gDO+PmXykspk3kahK9ozaNGwhsVCnXxU6pyrZtIDGH1zZiFFE5YCtl2M0VDnOgchPDoOxh2ELlnaP73b",
+	"1MibPBa9GfoEUgAqHK9ohKJHdKruktXyVy798bWjKarsmqM3kWSZpunPpa/IB9A4IeoJM/RbrbHtpKzi",
+	"6TISC9Q7JnyBYW3ZEul32vrbxRrauWjaIbLQo3OyFEOdtTisuY/1VcMXsqaj0pwWflcSnFeNSeoZSCHT",
+	"vFQgf9AJfndkaT7zHAvQREejMX2w/TpeVmQSdqlt9lsc9miuGO17G+sz4fnsNXHZhD7tkLBbICSWpYi2",
+	"S7xpOlHjt/lbvKU8wO6bshGMG+++Cf/cxWVK5YhcwAL6deCMEokaBulhQB2aI+z6j4ihV2zAlT/U+p4Y",
+	"2moM8Id6LEyACFCVzh5ISC519HBDJR5/eHl49q+3R6+6m3PCro8BxAO90BXt1t7hz3RRLjzfiVmZZCZg",
+	"oC0bmC5omH3paGvviUoFhnEH+0+fPFGpwOYvd5DSXJIZ4bHUnPcRWMQ5LVogYdOpIC2g+HM/icz98Qb5",
+	"0tuFnr1LfdJ6kNzqSW/7HZpTIRlfdjCu18Koy0yrGiJFGi1JhnDQIgmMtTitokUCW2S2rFg4bMSEEpZP",
+	"qT0X9JdW5VQOQ9P8XhB+oR356oNZyfWVPmVIsJgy4VbnTorta+EwtDdThyZeKz2iO/PbfFmLDnWjdxiv",
+	"o9tH9j1yzqsD0SOmB95x0NvuJles8pIoxtP1kPWJ1RVHo4BTJKFebT87ayXEazE0EZYyljBlKKti6w0j",
+	"qkd6yvWbC7ru6TdZ3tWNr6fr6ixMLkPshA4SVyPiw8lbr/uT2Zr7w2IHHliWaqFG1bjaXRVYFey7qg8x",
+	"x0IXtmrZ94fbiFAjS8nOeoHY+kmoGVKQPB35GBytqMQMdf3V9bYZRugXW24t9a/SWCtzTPDUVAny6sAK",
+	"VKPPWGg2yVM/VupWmDA66WYhAA1+bAqye8eVTRAfRK1lkqeRpXUx0eeCcdl6A3zJLnNwAIMezpdyDtMb",
+	"LwmcaUhIpqxFLkc2YrXCAoy6kD6asqRcEKh4c0oSTrwro9My51jMiRjqViBCvzWscgA9I1bdrsWJMotq",
+	"E0XM63mglnobBgg904r7jamD9LDULL1yJGvLbKe/xRR3FIw0zh+kcpysK6izhY91vzTpsMXaX+k+76b4",
+	"BoXuuynukK6K3Jshh0DnYCcxS4ffBZHg4rxP6S8AoTb5hR6xskjx12+FbRL5O5zjWScZtvdKtkTfXVJb",
+	"6yjtpelD5bhe5lN50arqqkF3MkdNxMaWmMqYXsMsF/mrwhV1RbKCE0Fy02LZ40XG/XsOjK7vyHEtxzCC",
+	"7bh3Q9T67oexC+brCGB6YYObHxyBWhVXmVhhldVtdHOi9Z1GI5pfUKn+2dN6XOio2SEqc9UavhYhgbwB",
+	"g0tPn5vzhuESxj7shy4cesu6SXEan7KnYdSg0kfZg6Pgt1RIt9A2QlkRhtBJtbtfqj8O06tdnCSk6Krg",
+	"rp4jHMH+mvSqROxvjObNeBfXI0QNbgvPbkzgGuY4sa1yhRy+hGPEwWK/US6HAst55XHw8dhZcK4quFnS",
+	"NBJE8PFO3cfefoIHWdPDQ7eKWqrO23isD4utTi58UWkIVoYFpd2rJIlJnYO0BhOxYiLmYh9c7KwdFXEi",
+	"iLTtgjvMnTecfeRPscLcGXhKq8S+2ILuuk9CNy/Z9RqF40ExUGC3rMcD+abLSK5f/eJrX9lVW7vaoYDr",
+	"xKCoIEjU7WNZjjkUoqeU8ix4yR612Q3y4mp+RfOCyJu2ZgaTbcmAGS72PnKX3gXnVPgag83Vr01U92OY",
+	"7i5w3UyTr5XZ3ryE1zLbV3CKybVtWUNH5nqdkW6uh1WDjdboAWe0xCr+7E9WuR1WqdKQ+3PMyqDsUyJN",
+	"KoabrMoGb3KCZYNW8v7UFmR9h5S9MmfvOKi50Fw1SAgfRX9qXzdH+UrXsItqaWVYp3uXzYrTjkvJqcTG",
+	"U2FAUXbT1OR2w/663E0/sLqmNDnVq8pg9dLC51i6e409I1zJ145SbmtHcIzT9NQA+YYsB/c4udqWoICT",
+	"2Ba8rFDuY/rBBjnoQgOZCkXS9MamZv2XLhPVR0S3YXaz5O31iL6R0R1SraW2telWy+II9d5U6k041XYT",
+	"twOM5TQ5z3VPo9s7HOJr7Chc50MctvBM0wecP6HxdF3uq/gt6v8wk2ijjXCCb7KMhB4N29uTD+t6H+M2",
+	"0N9ZLAvb4aWryxouCs4KTrEkKCVC0lx/XBZBjbV+OXJqUWtX1def/VOFrF8Ne75+tixI709OXHco88l6",
+	"TSfsq3/cqts/BWFteb1PpCVnjysiFREuCBcGX92uQfNiS8H92tSCcFPntuHI+8lMeE3ZGu/MYBr8ha0Z",
+	"vCU2nQl2WWzavY4qrfLpzt7Os8Gqyvd20j6173+KoLZWqUBvwld4I4b0CYNFi2tfTC+FJAsgRfhIJSDE",
+	"XFzv50xIVIvwHx8folP1yWA4KHnm9ZP7IspJyhaY5lc7sKM7X0B/ZfnVTg4j7fAy3714qiSOgeRLLOa+",
+	"RgyOlP2KuCaDaqj/URY6ve4Cc8pKUR9BB1wI9EgHxFY1Av1+7EPdt2Xo9DuVgPXYa1VZL7/+pUVXGHGS",
+	"qYMsCnm0m7CopkULFTyzILkcOjOc1hXVWeenEcJhCFzgYHTnbww6XTaoGj4On86mNrGDw9oZ7Keh+bOa",
+	"agDD6H7aPEkf8lVQhDtVy4kzeTIeTHYKHVAlKtDUzTeCDMUA8annBGdyjpI5Sc7FsM5FZj51x1NKoe21",
+	"4E1q2Ks57YE7M4yjyseuDw3c/UzagushghPr0zfT+B9HJhunC5qb+mIXxB9d3bqN1FQy5LUKWEUYvjAx",
+	"pUMXeUoXhSZZaUHxYFCfRCY/ChKoV2y2l2A9DOJcFLmpQgvuVtWstRC0Kq4gC72xTQjP5kQEWKniYyXJ",
+	"Ux3HZSsjaK0lU9c83aHEADdnZZbCa6aFRqoLeOl30OnLNx6uqi4bVx+v/t8AkndmejZpAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -13,6 +13,7 @@ import (
 )
 
 const (
+	AdminSecretScopes        = "AdminSecret.Scopes"
 	BearerAuthScopes         = "BearerAuth.Scopes"
 	BearerAuthElevatedScopes = "BearerAuthElevated.Scopes"
 )
@@ -28,6 +29,21 @@ const (
 	AttestationFormatTpm              AttestationFormat = "tpm"
 )
 
+// Defines values for AuditEventType.
+const (
+	EmailChanged      AuditEventType = "email-changed"
+	MfaDisabled       AuditEventType = "mfa-disabled"
+	MfaEnabled        AuditEventType = "mfa-enabled"
+	PasswordChanged   AuditEventType = "password-changed"
+	PatCreated        AuditEventType = "pat-created"
+	RefreshTokenReuse AuditEventType = "refresh-token-reuse"
+	SecurityKeyAdded  AuditEventType = "security-key-added"
+	Signin            AuditEventType = "signin"
+	SigninFailed      AuditEventType = "signin-failed"
+	Signout           AuditEventType = "signout"
+	UserDeleted       AuditEventType = "user-deleted"
+)
+
 // Defines values for AuthenticatorAttachment.
 const (
 	CrossPlatform AuthenticatorAttachment = "cross-platform"
@@ -67,10 +83,16 @@ const (
 	EmailAlreadyInUse               ErrorResponseError = "email-already-in-use"
 	EmailAlreadyVerified            ErrorResponseError = "email-already-verified"
 	ForbiddenAnonymous              ErrorResponseError = "forbidden-anonymous"
+	ForbiddenImpersonation          ErrorResponseError = "forbidden-impersonation"
+	ForbiddenOrganization           ErrorResponseError = "forbidden-organization"
+	HookRejected                    ErrorResponseError = "hook-rejected"
 	InternalServerError             ErrorResponseError = "internal-server-error"
 	InvalidEmailPassword            ErrorResponseError = "invalid-email-password"
+	InvalidInvitation               ErrorResponseError = "invalid-invitation"
 	InvalidOtp                      ErrorResponseError = "invalid-otp"
 	InvalidPat                      ErrorResponseError = "invalid-pat"
+	InvalidPhoneNumber              ErrorResponseError = "invalid-phone-number"
+	InvalidPhoneNumberPassword      ErrorResponseError = "invalid-phone-number-password"
 	InvalidRefreshToken             ErrorResponseError = "invalid-refresh-token"
 	InvalidRequest                  ErrorResponseError = "invalid-request"
 	InvalidState                    ErrorResponseError = "invalid-state"
@@ -82,11 +104,15 @@ const (
 	OauthProfileFetchFailed         ErrorResponseError = "oauth-profile-fetch-failed"
 	OauthProviderError              ErrorResponseError = "oauth-provider-error"
 	OauthTokenEchangeFailed         ErrorResponseError = "oauth-token-echange-failed"
+	OrganizationSlugInUse           ErrorResponseError = "organization-slug-in-use"
 	PasswordInHibpDatabase          ErrorResponseError = "password-in-hibp-database"
 	PasswordTooShort                ErrorResponseError = "password-too-short"
+	PhoneNumberAlreadyInUse         ErrorResponseError = "phone-number-already-in-use"
+	PhoneNumberNotAllowed           ErrorResponseError = "phone-number-not-allowed"
 	RedirectToNotAllowed            ErrorResponseError = "redirectTo-not-allowed"
 	RoleNotAllowed                  ErrorResponseError = "role-not-allowed"
 	SignupDisabled                  ErrorResponseError = "signup-disabled"
+	TooManyRequests                 ErrorResponseError = "too-many-requests"
 	TotpAlreadyActive               ErrorResponseError = "totp-already-active"
 	UnverifiedUser                  ErrorResponseError = "unverified-user"
 	UserNotAnonymous                ErrorResponseError = "user-not-anonymous"
@@ -125,7 +151,9 @@ const (
 
 // Defines values for UserMfaRequestActiveMfaType.
 const (
+	Email UserMfaRequestActiveMfaType = "email"
 	Empty UserMfaRequestActiveMfaType = ""
+	Sms   UserMfaRequestActiveMfaType = "sms"
 	Totp  UserMfaRequestActiveMfaType = "totp"
 )
 
@@ -161,6 +189,7 @@ const (
 	TicketTypeQueryEmailVerify        TicketTypeQuery = "emailVerify"
 	TicketTypeQueryPasswordReset      TicketTypeQuery = "passwordReset"
 	TicketTypeQuerySigninPasswordless TicketTypeQuery = "signinPasswordless"
+	TicketTypeQueryUserInvite         TicketTypeQuery = "userInvite"
 )
 
 // Defines values for SignInProviderParamsProvider.
@@ -226,11 +255,44 @@ const (
 	VerifyTicketParamsTypeEmailVerify        VerifyTicketParamsType = "emailVerify"
 	VerifyTicketParamsTypePasswordReset      VerifyTicketParamsType = "passwordReset"
 	VerifyTicketParamsTypeSigninPasswordless VerifyTicketParamsType = "signinPasswordless"
+	VerifyTicketParamsTypeUserInvite         VerifyTicketParamsType = "userInvite"
 )
 
 // AttestationFormat The attestation statement format
 type AttestationFormat string
 
+// AuditEvent Security relevant event recorded for a user
+type AuditEvent struct {
+	// CreatedAt When the event happened
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Event Type of audit event
+	Event AuditEventType `json:"event"`
+
+	// Id ID of the event
+	Id openapi_types.UUID `json:"id"`
+
+	// IpAddress IP address of the client that triggered the event
+	IpAddress *string `json:"ipAddress,omitempty"`
+
+	// Metadata Additional information about the event, for instance the sign-in method
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// TraceId Trace ID of the request that triggered the event
+	TraceId *string `json:"traceId,omitempty"`
+
+	// UserAgent User agent of the client that triggered the event
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// AuditEventType Type of audit event
+type AuditEventType string
+
+// AuditEventsResponse defines model for AuditEventsResponse.
+type AuditEventsResponse struct {
+	Events []AuditEvent `json:"events"`
+}
+
 // AuthenticationExtensions Additional parameters requesting additional processing by the client and authenticator
 type AuthenticationExtensions map[string]interface{}
 
@@ -307,6 +369,27 @@ type AuthenticatorTransport string
 // ConveyancePreference The attestation conveyance preference
 type ConveyancePreference string
 
+// CreateOrganizationInvitationRequest defines model for CreateOrganizationInvitationRequest.
+type CreateOrganizationInvitationRequest struct {
+	// Email Email of the user to invite
+	Email openapi_types.Email `json:"email"`
+
+	// Roles Roles the user will have in the organization
+	Roles []string `json:"roles"`
+}
+
+// CreateOrganizationRequest defines model for CreateOrganizationRequest.
+type CreateOrganizationRequest struct {
+	// Metadata Custom metadata of the organization
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// Name Name of the organization
+	Name string `json:"name"`
+
+	// Slug Unique, URL friendly identifier of the organization
+	Slug string `json:"slug"`
+}
+
 // CreatePATRequest defines model for CreatePATRequest.
 type CreatePATRequest struct {
 	// ExpiresAt Expiration date of the PAT
@@ -365,6 +448,31 @@ type ErrorResponseError string
 // IdTokenProvider defines model for IdTokenProvider.
 type IdTokenProvider string
 
+// ImpersonateUserRequest defines model for ImpersonateUserRequest.
+type ImpersonateUserRequest struct {
+	// Impersonator Identifier of the person impersonating the user, recorded in the audit log and in the `x-hasura-auth-impersonator` claim
+	Impersonator string `json:"impersonator"`
+
+	// Reason Why the user is being impersonated, recorded in the audit log
+	Reason string `json:"reason"`
+
+	// UserId ID of the user to impersonate
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// InviteUserRequest defines model for InviteUserRequest.
+type InviteUserRequest struct {
+	// Email Email of the user to invite
+	Email   openapi_types.Email `json:"email"`
+	Options *SignUpOptions      `json:"options,omitempty"`
+}
+
+// InviteUserResponse defines model for InviteUserResponse.
+type InviteUserResponse struct {
+	// UserId ID of the invited user
+	UserId openapi_types.UUID `json:"userId"`
+}
+
 // JWK JSON Web Key for JWT verification
 type JWK struct {
 	// Alg Algorithm used with this key
@@ -416,6 +524,61 @@ type OptionsRedirectTo struct {
 	RedirectTo *string `json:"redirectTo,omitempty"`
 }
 
+// Organization Organization the user is a member of
+type Organization struct {
+	// CreatedAt When the organization was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id ID of the organization
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the organization
+	Name string `json:"name"`
+
+	// Roles Roles of the user in the organization
+	Roles []string `json:"roles"`
+
+	// Slug Unique, URL friendly identifier of the organization
+	Slug string `json:"slug"`
+}
+
+// OrganizationInvitation Invitation to join an organization
+type OrganizationInvitation struct {
+	// ExpiresAt When the invitation expires
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id ID of the invitation
+	Id openapi_types.UUID `json:"id"`
+
+	// OrganizationId ID of the organization
+	OrganizationId openapi_types.UUID `json:"organizationId"`
+
+	// OrganizationName Name of the organization
+	OrganizationName *string `json:"organizationName,omitempty"`
+
+	// Roles Roles the user will have in the organization
+	Roles []string `json:"roles"`
+}
+
+// OrganizationInvitationsResponse defines model for OrganizationInvitationsResponse.
+type OrganizationInvitationsResponse struct {
+	Invitations []OrganizationInvitation `json:"invitations"`
+}
+
+// OrganizationsResponse defines model for OrganizationsResponse.
+type OrganizationsResponse struct {
+	Organizations []Organization `json:"organizations"`
+}
+
+// PhoneNumberOtpRequest defines model for PhoneNumberOtpRequest.
+type PhoneNumberOtpRequest struct {
+	// Otp One-time password received by SMS
+	Otp string `json:"otp"`
+
+	// PhoneNumber Phone number of the user
+	PhoneNumber string `json:"phoneNumber"`
+}
+
 // PublicKeyCredentialCreationOptions defines model for PublicKeyCredentialCreationOptions.
 type PublicKeyCredentialCreationOptions = protocol.PublicKeyCredentialCreationOptions
 
@@ -437,6 +600,21 @@ type PublicKeyCredentialHints string
 // PublicKeyCredentialRequestOptions defines model for PublicKeyCredentialRequestOptions.
 type PublicKeyCredentialRequestOptions = protocol.PublicKeyCredentialRequestOptions
 
+// PurgeCustomClaimsRequest defines model for PurgeCustomClaimsRequest.
+type PurgeCustomClaimsRequest struct {
+	// UserId ID of the user whose cached custom claims are purged
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// RefreshTokenOrganizationRequest Request to refresh an access token for a given organization
+type RefreshTokenOrganizationRequest struct {
+	// OrganizationId ID of the organization to activate, null to clear the active organization
+	OrganizationId *openapi_types.UUID `json:"organizationId"`
+
+	// RefreshToken Refresh token used to generate a new access token
+	RefreshToken string `json:"refreshToken"`
+}
+
 // RefreshTokenRequest Request to refresh an access token
 type RefreshTokenRequest struct {
 	// RefreshToken Refresh token used to generate a new access token
@@ -517,6 +695,15 @@ type SignInIdTokenRequest struct {
 	Provider IdTokenProvider `json:"provider"`
 }
 
+// SignInMfaOtpRequest defines model for SignInMfaOtpRequest.
+type SignInMfaOtpRequest struct {
+	// Otp One time password sent by email or SMS
+	Otp string `json:"otp"`
+
+	// Ticket Ticket
+	Ticket string `json:"ticket"`
+}
+
 // SignInMfaTotpRequest defines model for SignInMfaTotpRequest.
 type SignInMfaTotpRequest struct {
 	// Otp One time password
@@ -587,6 +774,15 @@ type SignInPasswordlessSmsRequest struct {
 	PhoneNumber string `json:"phoneNumber"`
 }
 
+// SignInPhonePasswordRequest Request to authenticate using phone number and password
+type SignInPhonePasswordRequest struct {
+	// Password User's password
+	Password string `json:"password"`
+
+	// PhoneNumber User's phone number
+	PhoneNumber string `json:"phoneNumber"`
+}
+
 // SignInWebauthnRequest defines model for SignInWebauthnRequest.
 type SignInWebauthnRequest struct {
 	// Email A valid email
@@ -633,6 +829,17 @@ type SignUpOptions struct {
 	RedirectTo *string                 `json:"redirectTo,omitempty"`
 }
 
+// SignUpPhonePasswordRequest Request to register a new user with phone number and password
+type SignUpPhonePasswordRequest struct {
+	Options *SignUpOptions `json:"options,omitempty"`
+
+	// Password Password for the new user account
+	Password string `json:"password"`
+
+	// PhoneNumber Phone number for the new user account
+	PhoneNumber string `json:"phoneNumber"`
+}
+
 // SignUpWebauthnRequest defines model for SignUpWebauthnRequest.
 type SignUpWebauthnRequest struct {
 	// Email A valid email
@@ -752,16 +959,92 @@ type UserEntity struct {
 	Name string `json:"name"`
 }
 
+// UserExport Everything hasura-auth stores about a user, with secrets redacted
+type UserExport struct {
+	AuditEvents    []AuditEvent              `json:"auditEvents"`
+	Impersonations []UserExportImpersonation `json:"impersonations"`
+	Organizations  []Organization            `json:"organizations"`
+	Providers      []UserExportProvider      `json:"providers"`
+	RefreshTokens  []UserExportRefreshToken  `json:"refreshTokens"`
+	SecurityKeys   []UserExportSecurityKey   `json:"securityKeys"`
+
+	// User User profile and account information
+	User User `json:"user"`
+}
+
+// UserExportImpersonation Session where an administrator impersonated the user
+type UserExportImpersonation struct {
+	// CreatedAt When the user was impersonated
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id ID of the impersonation
+	Id openapi_types.UUID `json:"id"`
+
+	// Impersonator Identifier of the person who impersonated the user
+	Impersonator string `json:"impersonator"`
+
+	// Reason Why the user was impersonated
+	Reason string `json:"reason"`
+}
+
+// UserExportProvider OAuth provider linked to the user
+type UserExportProvider struct {
+	// CreatedAt When the provider was linked
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id ID of the link
+	Id openapi_types.UUID `json:"id"`
+
+	// Provider ID of the provider
+	Provider string `json:"provider"`
+
+	// ProviderUserId ID of the user in the provider
+	ProviderUserId string `json:"providerUserId"`
+}
+
+// UserExportRefreshToken Active session or personal access token of the user
+type UserExportRefreshToken struct {
+	// CreatedAt When the refresh token was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When the refresh token expires
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id ID of the refresh token
+	Id openapi_types.UUID `json:"id"`
+
+	// Type Type of the refresh token
+	Type string `json:"type"`
+}
+
+// UserExportSecurityKey WebAuthn security key registered by the user
+type UserExportSecurityKey struct {
+	// CredentialId Credential ID of the security key
+	CredentialId string `json:"credentialId"`
+
+	// Id ID of the security key
+	Id openapi_types.UUID `json:"id"`
+
+	// Nickname Nickname of the security key
+	Nickname *string `json:"nickname,omitempty"`
+}
+
 // UserMfaRequest Request to activate or deactivate multi-factor authentication
 type UserMfaRequest struct {
-	// ActiveMfaType Type of MFA to activate. Use empty string to disable MFA.
+	// ActiveMfaType Type of MFA to activate. Email and SMS MFA require a verified email address or phone number. Use empty string to disable MFA.
 	ActiveMfaType *UserMfaRequestActiveMfaType `json:"activeMfaType,omitempty"`
 
-	// Code Verification code from the authenticator app when activating MFA
+	// Code Verification code from the authenticator app. When TOTP is active it has to be the current code to deactivate or change the MFA method, when activating TOTP it has to be a code for the new secret.
 	Code string `json:"code"`
+
+	// Otp One-time password sent by /user/mfa/otp
+	Otp *string `json:"otp,omitempty"`
+
+	// Ticket Ticket returned by /user/mfa/otp. Required to deactivate or change the MFA method when email or SMS MFA is active.
+	Ticket *string `json:"ticket,omitempty"`
 }
 
-// UserMfaRequestActiveMfaType Type of MFA to activate. Use empty string to disable MFA.
+// UserMfaRequestActiveMfaType Type of MFA to activate. Email and SMS MFA require a verified email address or phone number. Use empty string to disable MFA.
 type UserMfaRequestActiveMfaType string
 
 // UserPasswordRequest defines model for UserPasswordRequest.
@@ -780,6 +1063,24 @@ type UserPasswordResetRequest struct {
 	Options *OptionsRedirectTo  `json:"options,omitempty"`
 }
 
+// UserPasswordResetSmsRequest defines model for UserPasswordResetSmsRequest.
+type UserPasswordResetSmsRequest struct {
+	// PhoneNumber Phone number of the user
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+// UserPasswordResetSmsVerifyRequest defines model for UserPasswordResetSmsVerifyRequest.
+type UserPasswordResetSmsVerifyRequest struct {
+	// NewPassword A new password
+	NewPassword string `json:"newPassword"`
+
+	// Otp One-time password received by SMS
+	Otp string `json:"otp"`
+
+	// PhoneNumber Phone number of the user
+	PhoneNumber string `json:"phoneNumber"`
+}
+
 // UserVerificationRequirement A requirement for user verification for the operation
 type UserVerificationRequirement string
 
@@ -903,6 +1204,15 @@ type SignInProviderCallbackPostFormdataBody struct {
 // SignInProviderCallbackPostParamsProvider defines parameters for SignInProviderCallbackPost.
 type SignInProviderCallbackPostParamsProvider string
 
+// GetUserAuditEventsParams defines parameters for GetUserAuditEvents.
+type GetUserAuditEventsParams struct {
+	// Limit Maximum number of events to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of events to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // VerifyTicketParams defines parameters for VerifyTicket.
 type VerifyTicketParams struct {
 	// Ticket Ticket
@@ -918,12 +1228,27 @@ type VerifyTicketParams struct {
 // VerifyTicketParamsType defines parameters for VerifyTicket.
 type VerifyTicketParamsType string
 
+// PurgeCustomClaimsJSONRequestBody defines body for PurgeCustomClaims for application/json ContentType.
+type PurgeCustomClaimsJSONRequestBody = PurgeCustomClaimsRequest
+
+// ImpersonateUserJSONRequestBody defines body for ImpersonateUser for application/json ContentType.
+type ImpersonateUserJSONRequestBody = ImpersonateUserRequest
+
+// InviteUserJSONRequestBody defines body for InviteUser for application/json ContentType.
+type InviteUserJSONRequestBody = InviteUserRequest
+
 // VerifyElevateWebauthnJSONRequestBody defines body for VerifyElevateWebauthn for application/json ContentType.
 type VerifyElevateWebauthnJSONRequestBody = SignInWebauthnVerifyRequest
 
 // LinkIdTokenJSONRequestBody defines body for LinkIdToken for application/json ContentType.
 type LinkIdTokenJSONRequestBody = LinkIdTokenRequest
 
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = CreateOrganizationRequest
+
+// CreateOrganizationInvitationJSONRequestBody defines body for CreateOrganizationInvitation for application/json ContentType.
+type CreateOrganizationInvitationJSONRequestBody = CreateOrganizationInvitationRequest
+
 // CreatePATJSONRequestBody defines body for CreatePAT for application/json ContentType.
 type CreatePATJSONRequestBody = CreatePATRequest
 
@@ -936,6 +1261,9 @@ type SignInEmailPasswordJSONRequestBody = SignInEmailPasswordRequest
 // SignInIdTokenJSONRequestBody defines body for SignInIdToken for application/json ContentType.
 type SignInIdTokenJSONRequestBody = SignInIdTokenRequest
 
+// VerifySignInMfaOtpJSONRequestBody defines body for VerifySignInMfaOtp for application/json ContentType.
+type VerifySignInMfaOtpJSONRequestBody = SignInMfaOtpRequest
+
 // VerifySignInMfaTotpJSONRequestBody defines body for VerifySignInMfaTotp for application/json ContentType.
 type VerifySignInMfaTotpJSONRequestBody = SignInMfaTotpRequest
 
@@ -957,6 +1285,9 @@ type VerifySignInPasswordlessSmsJSONRequestBody = SignInPasswordlessSmsOtpReques
 // SignInPATJSONRequestBody defines body for SignInPAT for application/json ContentType.
 type SignInPATJSONRequestBody = SignInPATRequest
 
+// SignInPhonePasswordJSONRequestBody defines body for SignInPhonePassword for application/json ContentType.
+type SignInPhonePasswordJSONRequestBody = SignInPhonePasswordRequest
+
 // SignInProviderCallbackPostFormdataRequestBody defines body for SignInProviderCallbackPost for application/x-www-form-urlencoded ContentType.
 type SignInProviderCallbackPostFormdataRequestBody SignInProviderCallbackPostFormdataBody
 
@@ -972,6 +1303,12 @@ type SignOutJSONRequestBody = SignOutRequest
 // SignUpEmailPasswordJSONRequestBody defines body for SignUpEmailPassword for application/json ContentType.
 type SignUpEmailPasswordJSONRequestBody = SignUpEmailPasswordRequest
 
+// SignUpPhonePasswordJSONRequestBody defines body for SignUpPhonePassword for application/json ContentType.
+type SignUpPhonePasswordJSONRequestBody = SignUpPhonePasswordRequest
+
+// VerifySignUpPhonePasswordJSONRequestBody defines body for VerifySignUpPhonePassword for application/json ContentType.
+type VerifySignUpPhonePasswordJSONRequestBody = PhoneNumberOtpRequest
+
 // SignUpWebauthnJSONRequestBody defines body for SignUpWebauthn for application/json ContentType.
 type SignUpWebauthnJSONRequestBody = SignUpWebauthnRequest
 
@@ -981,6 +1318,9 @@ type VerifySignUpWebauthnJSONRequestBody = SignUpWebauthnVerifyRequest
 // RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
 type RefreshTokenJSONRequestBody = RefreshTokenRequest
 
+// RefreshTokenOrganizationJSONRequestBody defines body for RefreshTokenOrganization for application/json ContentType.
+type RefreshTokenOrganizationJSONRequestBody = RefreshTokenOrganizationRequest
+
 // VerifyTokenJSONRequestBody defines body for VerifyToken for application/json ContentType.
 type VerifyTokenJSONRequestBody = VerifyTokenRequest
 
@@ -1002,6 +1342,12 @@ type ChangeUserPasswordJSONRequestBody = UserPasswordRequest
 // SendPasswordResetEmailJSONRequestBody defines body for SendPasswordResetEmail for application/json ContentType.
 type SendPasswordResetEmailJSONRequestBody = UserPasswordResetRequest
 
+// SendPasswordResetSmsJSONRequestBody defines body for SendPasswordResetSms for application/json ContentType.
+type SendPasswordResetSmsJSONRequestBody = UserPasswordResetSmsRequest
+
+// VerifyPasswordResetSmsJSONRequestBody defines body for VerifyPasswordResetSms for application/json ContentType.
+type VerifyPasswordResetSmsJSONRequestBody = UserPasswordResetSmsVerifyRequest
+
 // VerifyAddSecurityKeyJSONRequestBody defines body for VerifyAddSecurityKey for application/json ContentType.
 type VerifyAddSecurityKeyJSONRequestBody = VerifyAddSecurityKeyRequest
 
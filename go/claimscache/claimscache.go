@@ -0,0 +1,82 @@
+package claimscache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+type Store interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration) error
+	Delete(key string) error
+}
+
+// ErrFallbackClaims is wrapped by claimers that couldn't resolve every source
+// and returned fallback claims alongside the error. Those claims can be used
+// to issue a token but aren't cached.
+var ErrFallbackClaims = errors.New("using fallback custom claims")
+
+type CustomClaimer interface {
+	GetClaims(ctx context.Context, userID string) (map[string]any, error)
+}
+
+// Cache wraps a CustomClaimer and keeps the claims of each user for ttl so
+// refreshing tokens doesn't resolve them every time. Errors talking to the
+// store are logged and the claims resolved with the wrapped claimer.
+type Cache struct {
+	claimer CustomClaimer
+	store   Store
+	ttl     time.Duration
+	logger  *slog.Logger
+}
+
+func New(claimer CustomClaimer, store Store, ttl time.Duration, logger *slog.Logger) *Cache {
+	return &Cache{
+		claimer: claimer,
+		store:   store,
+		ttl:     ttl,
+		logger:  logger,
+	}
+}
+
+func (c *Cache) GetClaims(ctx context.Context, userID string) (map[string]any, error) {
+	if b, ok := c.store.Get(userID); ok {
+		var claims map[string]any
+		if err := json.Unmarshal(b, &claims); err == nil {
+			return claims, nil
+		}
+	}
+
+	claims, err := c.claimer.GetClaims(ctx, userID)
+	if errors.Is(err, ErrFallbackClaims) {
+		return claims, err //nolint:wrapcheck
+	}
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal claims: %w", err)
+	}
+
+	if err := c.store.Set(userID, b, c.ttl); err != nil {
+		c.logger.Warn("error caching custom claims", slog.String("error", err.Error()))
+	}
+
+	return claims, nil
+}
+
+// Invalidate removes the cached claims of the user so they are resolved again
+// next time a token is issued.
+func (c *Cache) Invalidate(_ context.Context, userID string) error {
+	if err := c.store.Delete(userID); err != nil {
+		return fmt.Errorf("failed to invalidate custom claims: %w", err)
+	}
+
+	return nil
+}
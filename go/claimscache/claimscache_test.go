@@ -0,0 +1,118 @@
+package claimscache_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/claimscache"
+)
+
+var errClaimer = errors.New("claimer failed")
+
+type fakeClaimer struct {
+	calls  int
+	claims map[string]any
+	err    error
+}
+
+func (f *fakeClaimer) GetClaims(_ context.Context, _ string) (map[string]any, error) {
+	f.calls++
+	return f.claims, f.err
+}
+
+func TestCache(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		claims        map[string]any
+		err           error
+		invalidate    bool
+		ttl           time.Duration
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "cached",
+			claims:        map[string]any{"org": "a", "roles": []any{"user"}},
+			err:           nil,
+			invalidate:    false,
+			ttl:           time.Hour,
+			expectedCalls: 1,
+			expectedErr:   nil,
+		},
+		{
+			name:          "invalidated",
+			claims:        map[string]any{"org": "a", "roles": []any{"user"}},
+			err:           nil,
+			invalidate:    true,
+			ttl:           time.Hour,
+			expectedCalls: 2, //nolint:mnd
+			expectedErr:   nil,
+		},
+		{
+			name:          "expired",
+			claims:        map[string]any{"org": "a", "roles": []any{"user"}},
+			err:           nil,
+			invalidate:    false,
+			ttl:           -time.Second,
+			expectedCalls: 2, //nolint:mnd
+			expectedErr:   nil,
+		},
+		{
+			name:          "errors are not cached",
+			claims:        nil,
+			err:           errClaimer,
+			invalidate:    false,
+			ttl:           time.Hour,
+			expectedCalls: 2, //nolint:mnd
+			expectedErr:   errClaimer,
+		},
+		{
+			name:          "fallback claims are not cached",
+			claims:        map[string]any{"org": "default"},
+			err:           fmt.Errorf("%w: %w", claimscache.ErrFallbackClaims, errClaimer),
+			invalidate:    false,
+			ttl:           time.Hour,
+			expectedCalls: 2, //nolint:mnd
+			expectedErr:   claimscache.ErrFallbackClaims,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			claimer := &fakeClaimer{calls: 0, claims: tc.claims, err: tc.err}
+			cache := claimscache.New(
+				claimer, claimscache.NewInMemoryStore(), tc.ttl, slog.Default(),
+			)
+
+			for i := range 2 {
+				if i == 1 && tc.invalidate {
+					if err := cache.Invalidate(t.Context(), "user-id"); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+				}
+
+				got, err := cache.GetClaims(t.Context(), "user-id")
+				if !errors.Is(err, tc.expectedErr) {
+					t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+				}
+
+				if diff := cmp.Diff(tc.claims, got); diff != "" {
+					t.Errorf("unexpected claims (-want +got):\n%s", diff)
+				}
+			}
+
+			if claimer.calls != tc.expectedCalls {
+				t.Errorf("expected %d calls to the claimer, got %d", tc.expectedCalls, claimer.calls)
+			}
+		})
+	}
+}
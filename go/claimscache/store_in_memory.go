@@ -0,0 +1,85 @@
+package claimscache
+
+import (
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type inMemoryStoreValue struct {
+	v       []byte
+	expires time.Time
+}
+
+// InMemoryStore keeps entries in the memory of the process so invalidations
+// only apply to the instance handling the request.
+type InMemoryStore struct {
+	data      map[string]inMemoryStoreValue
+	lastSweep time.Time
+	now       func() time.Time
+	mx        sync.Mutex
+}
+
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		data:      make(map[string]inMemoryStoreValue),
+		lastSweep: time.Now(),
+		now:       time.Now,
+		mx:        sync.Mutex{},
+	}
+}
+
+func (i *InMemoryStore) deleteExpired(now time.Time) {
+	if now.Sub(i.lastSweep) < sweepInterval {
+		return
+	}
+
+	for k, v := range i.data {
+		if now.After(v.expires) {
+			delete(i.data, k)
+		}
+	}
+	i.lastSweep = now
+}
+
+func (i *InMemoryStore) Get(key string) ([]byte, bool) {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	v, ok := i.data[key]
+	if !ok {
+		return nil, false
+	}
+
+	if i.now().After(v.expires) {
+		delete(i.data, key)
+		return nil, false
+	}
+
+	return v.v, true
+}
+
+func (i *InMemoryStore) Set(key string, value []byte, ttl time.Duration) error {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	now := i.now()
+	i.deleteExpired(now)
+
+	i.data[key] = inMemoryStoreValue{
+		v:       value,
+		expires: now.Add(ttl),
+	}
+
+	return nil
+}
+
+func (i *InMemoryStore) Delete(key string) error {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	delete(i.data, key)
+
+	return nil
+}
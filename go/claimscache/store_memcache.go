@@ -0,0 +1,56 @@
+package claimscache
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+// MemcacheStore shares entries, and their invalidation, between instances.
+type MemcacheStore struct {
+	client *memcache.Client
+	prefix string
+}
+
+func NewMemcacheStore(client *memcache.Client, prefix string) *MemcacheStore {
+	return &MemcacheStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (m *MemcacheStore) key(key string) string {
+	return m.prefix + key
+}
+
+func (m *MemcacheStore) Get(key string) ([]byte, bool) {
+	item, err := m.client.Get(m.key(key))
+	if err != nil {
+		return nil, false
+	}
+
+	return item.Value, true
+}
+
+func (m *MemcacheStore) Set(key string, value []byte, ttl time.Duration) error {
+	if err := m.client.Set(&memcache.Item{ //nolint:exhaustruct
+		Key:        m.key(key),
+		Value:      value,
+		Expiration: int32(ttl.Seconds()),
+	}); err != nil {
+		return fmt.Errorf("error setting key: %w", err)
+	}
+
+	return nil
+}
+
+func (m *MemcacheStore) Delete(key string) error {
+	if err := m.client.Delete(m.key(key)); err != nil &&
+		!errors.Is(err, memcache.ErrCacheMiss) {
+		return fmt.Errorf("error deleting key: %w", err)
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"slices"
 
 	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/webhooks"
 	"github.com/urfave/cli/v2"
 )
 
@@ -37,6 +39,17 @@ func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen
 	}
 	allowedRoles = slices.DeleteFunc(allowedRoles, func(s string) bool { return s == "" })
 
+	webhookSubscriptions, err := webhooks.ParseSubscriptions(
+		cCtx.String(flagWebhookSubscriptions),
+	)
+	if err != nil {
+		return controller.Config{}, fmt.Errorf("problem parsing webhook subscriptions: %w", err)
+	}
+
+	if err := checkHookSecret(cCtx); err != nil {
+		return controller.Config{}, err
+	}
+
 	defaultLocale := cCtx.String(flagDefaultLocale)
 	allowedLocales := cCtx.StringSlice(flagAllowedLocales)
 	allowedLocales = slices.DeleteFunc(allowedLocales, func(s string) bool { return s == "" })
@@ -53,6 +66,19 @@ func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen
 	allowedEmails = slices.DeleteFunc(allowedEmails, func(s string) bool { return s == "" })
 	blockedEmails := cCtx.StringSlice(flagBlockedEmails)
 	blockedEmails = slices.DeleteFunc(blockedEmails, func(s string) bool { return s == "" })
+	allowedPhoneCountryCodes := cCtx.StringSlice(flagAllowedPhoneCountryCodes)
+	allowedPhoneCountryCodes = slices.DeleteFunc(
+		allowedPhoneCountryCodes, func(s string) bool { return s == "" },
+	)
+	blockedPhoneCountryCodes := cCtx.StringSlice(flagBlockedPhoneCountryCodes)
+	blockedPhoneCountryCodes = slices.DeleteFunc(
+		blockedPhoneCountryCodes, func(s string) bool { return s == "" },
+	)
+
+	securityNotifications, err := getSecurityNotifications(cCtx)
+	if err != nil {
+		return controller.Config{}, err
+	}
 
 	webauhtnRPID := cCtx.String(flagWebauthnRPID)
 	if webauhtnRPID == "" {
@@ -112,5 +138,62 @@ func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen
 		SMSTwilioMessagingServiceID: cCtx.String(flagSMSTwilioMessagingServiceID),
 		MfaEnabled:                  cCtx.Bool(flagMfaEnabled),
 		ServerPrefix:                cCtx.String(flagAPIPrefix),
+		ImpersonationEnabled:        cCtx.Bool(flagImpersonationEnabled),
+		AuditLogEnabled:             cCtx.Bool(flagAuditLogEnabled),
+		WebhookSubscriptions:        webhookSubscriptions,
+		HookBeforeSignUpURL:         cCtx.String(flagHookBeforeSignUpURL),
+		HookBeforeTokenURL:          cCtx.String(flagHookBeforeTokenURL),
+		HookSecret:                  cCtx.String(flagHookSecret),
+		HookTimeout:                 cCtx.Duration(flagHookTimeout),
+		HookFailOpen:                cCtx.Bool(flagHookFailOpen),
+		OrganizationsEnabled:        cCtx.Bool(flagOrganizationsEnabled),
+		PhonePasswordEnabled:        cCtx.Bool(flagPhonePasswordEnabled),
+		AllowedPhoneCountryCodes:    allowedPhoneCountryCodes,
+		BlockedPhoneCountryCodes:    blockedPhoneCountryCodes,
+		SecurityNotifications:       securityNotifications,
 	}, nil
 }
+
+// checkHookSecret makes sure hooks are only enabled with a secret to sign their
+// requests, like webhook subscriptions.
+func checkHookSecret(cCtx *cli.Context) error {
+	if cCtx.String(flagHookSecret) != "" {
+		return nil
+	}
+
+	for _, flag := range []string{
+		flagHookBeforeSignUpURL, flagHookBeforeTokenURL, flagCustomClaimsWebhookURL,
+	} {
+		if cCtx.String(flag) != "" {
+			return fmt.Errorf( //nolint:goerr113
+				"%s is set but %s is missing", flag, flagHookSecret,
+			)
+		}
+	}
+
+	return nil
+}
+
+func getSecurityNotifications(cCtx *cli.Context) ([]string, error) {
+	enabled := cCtx.StringSlice(flagSecurityNotifications)
+	enabled = slices.DeleteFunc(enabled, func(s string) bool { return s == "" })
+
+	for _, name := range enabled {
+		if !slices.Contains(
+			notifications.SecurityNotifications(), notifications.TemplateName(name),
+		) {
+			return nil, fmt.Errorf( //nolint:goerr113
+				"unknown security notification %q", name,
+			)
+		}
+
+		if name == string(notifications.TemplateNameSigninNewDevice) &&
+			!cCtx.Bool(flagAuditLogEnabled) {
+			return nil, fmt.Errorf( //nolint:goerr113
+				"security notification %q requires the audit log to be enabled", name,
+			)
+		}
+	}
+
+	return enabled, nil
+}
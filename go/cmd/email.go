@@ -7,11 +7,22 @@ import (
 	"net/smtp"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/metrics"
 	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/mailgun"
+	"github.com/nhost/hasura-auth/go/notifications/outbox"
 	"github.com/nhost/hasura-auth/go/notifications/postmark"
+	"github.com/nhost/hasura-auth/go/notifications/resend"
+	"github.com/nhost/hasura-auth/go/notifications/sendgrid"
+	"github.com/nhost/hasura-auth/go/notifications/ses"
 	"github.com/nhost/hasura-auth/go/notifications/sms"
 	"github.com/nhost/hasura-auth/go/sql"
 	"github.com/urfave/cli/v2"
@@ -41,6 +52,11 @@ func getSMTPEmailer(
 		return nil, errors.New("unsupported auth method") //nolint:goerr113
 	}
 
+	dkim, err := getDKIMSigner(cCtx)
+	if err != nil {
+		return nil, err
+	}
+
 	return notifications.NewEmail(
 		cCtx.String(flagSMTPHost),
 		uint16(cCtx.Uint(flagSMTPPort)), //nolint:gosec
@@ -49,9 +65,37 @@ func getSMTPEmailer(
 		cCtx.String(flagSMTPSender),
 		headers,
 		templates,
+		dkim,
 	), nil
 }
 
+func getDKIMSigner(cCtx *cli.Context) (*notifications.DKIMSigner, error) {
+	domain := cCtx.String(flagSMTPDKIMDomain)
+	selector := cCtx.String(flagSMTPDKIMSelector)
+	privateKey := cCtx.String(flagSMTPDKIMPrivateKey)
+	if domain == "" && selector == "" && privateKey == "" {
+		return nil, nil //nolint:nilnil // DKIM disabled
+	}
+
+	if privateKey == "" {
+		return nil, errors.New("DKIM is enabled but the private key is missing") //nolint:err113
+	}
+
+	signer, err := notifications.NewDKIMSigner(
+		domain,
+		selector,
+		strings.ReplaceAll(privateKey, `\n`, "\n"),
+		slices.DeleteFunc(
+			cCtx.StringSlice(flagSMTPDKIMHeaders), func(s string) bool { return s == "" },
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating DKIM signer: %w", err)
+	}
+
+	return signer, nil
+}
+
 func getTemplates(cCtx *cli.Context, logger *slog.Logger) (*notifications.Templates, error) {
 	var templatesPath string
 	for _, p := range []string{
@@ -68,24 +112,88 @@ func getTemplates(cCtx *cli.Context, logger *slog.Logger) (*notifications.Templa
 		return nil, errors.New("templates path not found") //nolint:goerr113
 	}
 
-	templates, err := notifications.NewTemplatesFromFilesystem(
+	templates, err := notifications.NewTemplatesFromFilesystemWithEngine(
 		templatesPath,
 		cCtx.String(flagDefaultLocale),
+		notifications.TemplateEngine(GetEnumValue(cCtx, flagEmailTemplatesEngine)),
 		logger.With(slog.String("component", "mailer")),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("problem creating templates: %w", err)
 	}
 
+	securityNotifications := make([]notifications.TemplateName, 0)
+	for _, name := range cCtx.StringSlice(flagSecurityNotifications) {
+		if name != "" {
+			securityNotifications = append(securityNotifications, notifications.TemplateName(name))
+		}
+	}
+
+	if err := templates.CheckLocales(
+		cCtx.StringSlice(flagAllowedLocales), securityNotifications...,
+	); err != nil {
+		return nil, fmt.Errorf("problem checking templates: %w", err)
+	}
+
 	return templates, nil
 }
 
-func getEmailer( //nolint:ireturn
+func parseTags(tags []string) (map[string]string, error) {
+	m := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		if tag == "" {
+			continue
+		}
+
+		k, v, ok := strings.Cut(tag, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid tag %q, expected `key=value`", tag) //nolint:err113
+		}
+		m[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return m, nil
+}
+
+func getSESEmailer(
+	cCtx *cli.Context,
+	tags map[string]string,
+	templates *notifications.Templates,
+) (*ses.SES, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(
+		cCtx.Context, awsconfig.WithRegion(cCtx.String(flagEmailSESRegion)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem loading AWS configuration: %w", err)
+	}
+
+	client := sesv2.NewFromConfig(awsCfg, func(o *sesv2.Options) {
+		if endpoint := cCtx.String(flagEmailSESEndpoint); endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+
+	return ses.New(
+		client,
+		cCtx.String(flagSMTPSender),
+		cCtx.String(flagEmailSESConfigurationSet),
+		tags,
+		templates,
+	), nil
+}
+
+func getEmailer( //nolint:ireturn,cyclop
 	cCtx *cli.Context,
 	logger *slog.Logger,
 ) (controller.Emailer, *notifications.Templates, error) {
-	if cCtx.String(flagSMTPHost) == "postmark" {
-		return postmark.New(cCtx.String(flagSMTPSender), cCtx.String(flagSMTPPassword)), nil, nil
+	provider := GetEnumValue(cCtx, flagEmailProvider)
+	if provider == "postmark" || (provider == "smtp" && cCtx.String(flagSMTPHost) == "postmark") {
+		apiKey := cCtx.String(flagEmailAPIKey)
+		if apiKey == "" {
+			apiKey = cCtx.String(flagSMTPPassword)
+		}
+		return postmark.New(
+			cCtx.String(flagSMTPSender), apiKey, cCtx.String(flagEmailPostmarkMessageStream),
+		), nil, nil
 	}
 
 	templates, err := getTemplates(cCtx, logger)
@@ -93,18 +201,117 @@ func getEmailer( //nolint:ireturn
 		return nil, nil, fmt.Errorf("problem creating templates: %w", err)
 	}
 
-	emailer, err := getSMTPEmailer(cCtx, templates)
+	tags, err := parseTags(cCtx.StringSlice(flagEmailTags))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	from := cCtx.String(flagSMTPSender)
+	apiKey := cCtx.String(flagEmailAPIKey)
+	if provider != "smtp" && provider != "ses" && apiKey == "" {
+		return nil, nil, fmt.Errorf( //nolint:err113
+			"email provider is %s but API key is missing", provider,
+		)
+	}
+
+	var emailer controller.Emailer
+	switch provider {
+	case "ses":
+		emailer, err = getSESEmailer(cCtx, tags, templates)
+	case "sendgrid":
+		emailer = sendgrid.New(sendgrid.URL, apiKey, from, tags, templates)
+	case "mailgun":
+		domain := cCtx.String(flagEmailMailgunDomain)
+		if domain == "" {
+			return nil, nil, errors.New( //nolint:err113
+				"email provider is mailgun but domain is missing",
+			)
+		}
+		emailer = mailgun.New(
+			cCtx.String(flagEmailMailgunURL), domain, apiKey, from, tags, templates,
+		)
+	case "resend":
+		emailer = resend.New(resend.URL, apiKey, from, tags, templates)
+	default:
+		emailer, err = getSMTPEmailer(cCtx, templates)
+	}
+
 	return emailer, templates, err
 }
 
-func getSMS( //nolint:ireturn
+func getSMSBackend( //nolint:ireturn
 	cCtx *cli.Context,
-	templates *notifications.Templates,
-	db *sql.Queries,
 	logger *slog.Logger,
-) (controller.SMSer, error) {
-	if !cCtx.Bool(flagSMSPasswordlessEnabled) {
-		return nil, nil //nolint:nilnil // SMS disabled, return nil client
+) (sms.GenericSMSProvider, error) {
+	sender := cCtx.String(flagSMSSender)
+
+	switch provider := GetEnumValue(cCtx, flagSMSProvider); provider {
+	case "sns":
+		awsCfg, err := awsconfig.LoadDefaultConfig(
+			cCtx.Context, awsconfig.WithRegion(cCtx.String(flagSMSSNSRegion)),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("problem loading AWS configuration: %w", err)
+		}
+
+		client := awssns.NewFromConfig(awsCfg, func(o *awssns.Options) {
+			if endpoint := cCtx.String(flagSMSSNSEndpoint); endpoint != "" {
+				o.BaseEndpoint = aws.String(endpoint)
+			}
+		})
+
+		return sms.NewSNS(client, sender), nil
+	case "vonage":
+		apiKey := cCtx.String(flagSMSVonageAPIKey)
+		apiSecret := cCtx.String(flagSMSVonageAPISecret)
+		if apiKey == "" || apiSecret == "" || sender == "" {
+			return nil, errors.New( //nolint:err113
+				"SMS provider is vonage but API key, API secret or sender are missing",
+			)
+		}
+
+		return sms.NewVonage(sms.VonageURL, apiKey, apiSecret, sender), nil
+	case "messagebird":
+		accessKey := cCtx.String(flagSMSMessageBirdAccessKey)
+		if accessKey == "" || sender == "" {
+			return nil, errors.New( //nolint:err113
+				"SMS provider is messagebird but access key or sender are missing",
+			)
+		}
+
+		return sms.NewMessageBird(sms.MessageBirdURL, accessKey, sender), nil
+	case "webhook":
+		url := cCtx.String(flagSMSWebhookURL)
+		if url == "" {
+			return nil, errors.New("SMS provider is webhook but URL is missing") //nolint:err113
+		}
+
+		headers := slices.DeleteFunc(
+			cCtx.StringSlice(flagSMSWebhookHeaders), func(s string) bool { return s == "" },
+		)
+
+		backend, err := sms.NewWebhook(url, headers, cCtx.String(flagSMSWebhookBodyTemplate))
+		if err != nil {
+			return nil, fmt.Errorf("problem creating webhook SMS provider: %w", err)
+		}
+
+		return backend, nil
+	case "dev":
+		logger.Warn("using dev SMS provider, SMS will be logged instead of sent")
+		return sms.NewDev(logger.With(slog.String("component", "sms"))), nil
+	default:
+		return nil, fmt.Errorf("unsupported SMS provider: %s", provider) //nolint:err113
+	}
+}
+
+func getTwilioSMS( //nolint:ireturn
+	cCtx *cli.Context,
+	db *sql.Queries,
+) (sms.GenericSMSProvider, controller.SMSer, error) {
+	// with only MFA enabled Twilio is optional, users just can't pick SMS MFA
+	if !cCtx.Bool(flagSMSPasswordlessEnabled) && !cCtx.Bool(flagPhonePasswordEnabled) &&
+		cCtx.String(flagSMSTwilioAccountSid) == "" {
+		return nil, nil, nil // SMS disabled
 	}
 
 	accountSid := cCtx.String(flagSMSTwilioAccountSid)
@@ -112,31 +319,71 @@ func getSMS( //nolint:ireturn
 	messagingServiceID := cCtx.String(flagSMSTwilioMessagingServiceID)
 
 	if accountSid == "" || authToken == "" || messagingServiceID == "" {
-		return nil, errors.New("SMS is enabled but Twilio credentials are missing") //nolint:err113
+		return nil, nil, errors.New( //nolint:err113
+			"SMS is enabled but Twilio credentials are missing",
+		)
 	}
 
 	if strings.HasPrefix(accountSid, "VA") {
 		// If accountSid starts with "VA", it's a verification service
-		return sms.NewTwilioVerificationService(
+		return nil, sms.NewTwilioVerificationService(
 			accountSid, authToken, messagingServiceID, db,
 		), nil
 	}
 
+	return sms.NewTwilioSMSProvider(accountSid, authToken, messagingServiceID), nil, nil
+}
+
+func getSMS( //nolint:ireturn
+	cCtx *cli.Context,
+	templates *notifications.Templates,
+	db *sql.Queries,
+	ob *outbox.Outbox,
+	logger *slog.Logger,
+) (controller.SMSer, error) {
+	// SMS MFA is available whenever MFA is enabled
+	if !cCtx.Bool(flagSMSPasswordlessEnabled) && !cCtx.Bool(flagPhonePasswordEnabled) &&
+		!cCtx.Bool(flagMfaEnabled) {
+		return nil, nil //nolint:nilnil // SMS disabled, return nil client
+	}
+
+	var backend sms.GenericSMSProvider
+	var err error
+	if GetEnumValue(cCtx, flagSMSProvider) == "twilio" {
+		var verification controller.SMSer
+		backend, verification, err = getTwilioSMS(cCtx, db)
+		if err != nil || verification != nil {
+			return verification, err
+		}
+		if backend == nil {
+			return nil, nil //nolint:nilnil // SMS disabled, return nil client
+		}
+	} else {
+		backend, err = getSMSBackend(cCtx, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	backend = metrics.NewSMSProvider(backend)
+
+	if ob != nil {
+		// codes sent through Twilio Verify are kept by Twilio so they can't be queued
+		backend = ob.SMSProvider(backend)
+	}
+
 	if templates == nil {
-		var err error
 		templates, err = getTemplates(cCtx, logger)
 		if err != nil {
 			return nil, fmt.Errorf("problem creating templates: %w", err)
 		}
 	}
 
-	return sms.NewTwilioSMS(
-		templates,
+	return sms.NewSMS(
+		backend,
 		controller.GenerateOTP,
 		controller.HashOTP,
-		accountSid,
-		authToken,
-		messagingServiceID,
+		templates,
 		db,
 	), nil
 }
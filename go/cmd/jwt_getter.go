@@ -3,14 +3,44 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/nhost/hasura-auth/go/claimscache"
 	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/hooks"
 	"github.com/urfave/cli/v2"
 )
 
-func getJWTGetter(cCtx *cli.Context, db controller.DBClient) (*controller.JWTGetter, error) {
+func getCustomClaimsCache(
+	cCtx *cli.Context, claimer controller.CustomClaimer, logger *slog.Logger,
+) *claimscache.Cache {
+	var store claimscache.Store
+	if cCtx.String(flagCustomClaimsCacheMemcacheServer) != "" {
+		store = claimscache.NewMemcacheStore(
+			memcache.New(cCtx.String(flagCustomClaimsCacheMemcacheServer)),
+			cCtx.String(flagCustomClaimsCacheMemcachePrefix),
+		)
+	} else {
+		store = claimscache.NewInMemoryStore()
+	}
+
+	return claimscache.New(
+		claimer,
+		store,
+		cCtx.Duration(flagCustomClaimsCacheTTL),
+		logger.With(slog.String("component", "custom-claims-cache")),
+	)
+}
+
+func getJWTGetter(
+	cCtx *cli.Context,
+	db controller.DBClient,
+	pool controller.PostgresCustomClaimsDB,
+	logger *slog.Logger,
+) (*controller.JWTGetter, error) {
 	var rawClaims map[string]string
 	var defaults map[string]any
 
@@ -28,7 +58,13 @@ func getJWTGetter(cCtx *cli.Context, db controller.DBClient) (*controller.JWTGet
 
 	var customClaimer controller.CustomClaimer
 	var err error
-	if len(rawClaims) > 0 {
+	switch {
+	case len(rawClaims) > 0 && GetEnumValue(cCtx, flagCustomClaimsSource) == "postgres":
+		customClaimer, err = controller.NewPostgresCustomClaims(rawClaims, pool, defaults)
+		if err != nil {
+			return nil, fmt.Errorf("error creating custom claimer: %w", err)
+		}
+	case len(rawClaims) > 0:
 		customClaimer, err = controller.NewCustomClaims(
 			rawClaims,
 			&http.Client{}, //nolint:exhaustruct
@@ -41,12 +77,47 @@ func getJWTGetter(cCtx *cli.Context, db controller.DBClient) (*controller.JWTGet
 		}
 	}
 
+	if cCtx.String(flagCustomClaimsWebhookURL) != "" {
+		webhook := controller.NewWebhookCustomClaims(
+			hooks.NewClient(
+				cCtx.String(flagCustomClaimsWebhookURL),
+				cCtx.String(flagHookSecret),
+				cCtx.Duration(flagHookTimeout),
+				false,
+			),
+			defaults,
+		)
+
+		if customClaimer == nil {
+			customClaimer = webhook
+		} else {
+			customClaimer = controller.NewMultiCustomClaims(customClaimer, webhook)
+		}
+	}
+
+	if customClaimer != nil && cCtx.Duration(flagCustomClaimsCacheTTL) > 0 {
+		customClaimer = getCustomClaimsCache(cCtx, customClaimer, logger)
+	}
+
+	var opts []controller.JWTGetterOption
+	if cCtx.String(flagHookBeforeTokenURL) != "" {
+		opts = append(opts, controller.JWTGetterWithBeforeTokenHook(
+			hooks.NewClient(
+				cCtx.String(flagHookBeforeTokenURL),
+				cCtx.String(flagHookSecret),
+				cCtx.Duration(flagHookTimeout),
+				cCtx.Bool(flagHookFailOpen),
+			),
+		))
+	}
+
 	jwtGetter, err := controller.NewJWTGetter(
 		[]byte(cCtx.String(flagHasuraGraphqlJWTSecret)),
 		time.Duration(cCtx.Int(flagAccessTokensExpiresIn))*time.Second,
 		customClaimer,
 		cCtx.String(flagRequireElevatedClaim),
 		db,
+		opts...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating jwt getter: %w", err)
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) AcceptOrganizationInvitation( //nolint:ireturn
+	ctx context.Context, request api.AcceptOrganizationInvitationRequestObject,
+) (api.AcceptOrganizationInvitationResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).With(
+		slog.String("invitationId", request.InvitationId.String()),
+	)
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	// invitations are addressed to an email so we need to be sure the user owns it
+	if !user.Email.Valid || !user.EmailVerified {
+		logger.Warn("user's email is not verified")
+		return ctrl.sendError(ErrUnverifiedUser), nil
+	}
+
+	if _, err := ctrl.wf.db.AcceptOrganizationInvitation(
+		ctx, sql.AcceptOrganizationInvitationParams{
+			ID:     request.InvitationId,
+			Email:  strings.ToLower(user.Email.String),
+			UserID: user.ID,
+		},
+	); errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("invitation not found, expired or already accepted")
+		return ctrl.sendError(ErrInvalidInvitation), nil
+	} else if err != nil {
+		logger.Error("error accepting organization invitation", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	ctrl.wf.InvalidateCustomClaims(ctx, user.ID, logger)
+
+	return api.AcceptOrganizationInvitation200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,164 @@
+package controller_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAcceptOrganizationInvitation(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	orgID := uuid.MustParse("5b1c1b6a-7e0a-4c8e-8a57-0c1f0f5b6d2e")
+	invitationID := uuid.MustParse("c0e3b7a4-2f8e-4d3b-9a51-6e2f8c1d4b7a")
+
+	jwtTokenFn := func() *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     []any{"user", "me"},
+					"x-hasura-default-role":      "user",
+					"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+					"x-hasura-user-is-anonymous": "false",
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	configEnabled := func() *controller.Config {
+		c := getConfig()
+		c.OrganizationsEnabled = true
+		return c
+	}
+
+	cases := []testRequest[api.AcceptOrganizationInvitationRequestObject, api.AcceptOrganizationInvitationResponseObject]{ //nolint:lll
+		{
+			name:   "simple",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().AcceptOrganizationInvitation(
+					gomock.Any(),
+					sql.AcceptOrganizationInvitationParams{
+						ID:     invitationID,
+						Email:  "jane@acme.com",
+						UserID: userID,
+					},
+				).Return(orgID, nil)
+
+				return mock
+			},
+			request: api.AcceptOrganizationInvitationRequestObject{
+				InvitationId: invitationID,
+			},
+			expectedResponse:  api.AcceptOrganizationInvitation200JSONResponse(api.OK),
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "invitation expired or not found",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().AcceptOrganizationInvitation(
+					gomock.Any(),
+					sql.AcceptOrganizationInvitationParams{
+						ID:     invitationID,
+						Email:  "jane@acme.com",
+						UserID: userID,
+					},
+				).Return(uuid.UUID{}, pgx.ErrNoRows)
+
+				return mock
+			},
+			request: api.AcceptOrganizationInvitationRequestObject{
+				InvitationId: invitationID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-invitation",
+				Message: "Invalid or expired invitation",
+				Status:  400,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "email not verified",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.EmailVerified = false
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.AcceptOrganizationInvitationRequestObject{
+				InvitationId: invitationID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unverified-user",
+				Message: "User is not verified.",
+				Status:  401,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			ctx := jwtGetter.ToContext(t.Context(), tc.jwtTokenFn())
+
+			assertRequest(ctx, t, c.AcceptOrganizationInvitation, tc.request, tc.expectedResponse)
+		})
+	}
+}
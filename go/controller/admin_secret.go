@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+)
+
+const AdminSecretSecurityScheme = "AdminSecret"
+
+var ErrInvalidAdminSecret = errors.New("invalid admin secret")
+
+// AdminSecretAuthenticationFunc authenticates operations protected by the AdminSecret
+// security scheme by comparing the x-hasura-admin-secret header with adminSecret.
+// Any other security scheme is delegated to next.
+func AdminSecretAuthenticationFunc(
+	adminSecret string, next openapi3filter.AuthenticationFunc,
+) openapi3filter.AuthenticationFunc {
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+		if input.SecuritySchemeName != AdminSecretSecurityScheme {
+			return next(ctx, input)
+		}
+
+		if adminSecret == "" {
+			return ErrInvalidAdminSecret
+		}
+
+		header := input.RequestValidationInput.Request.Header.Get(
+			input.SecurityScheme.Name,
+		)
+		if subtle.ConstantTimeCompare([]byte(header), []byte(adminSecret)) != 1 {
+			return ErrInvalidAdminSecret
+		}
+
+		return nil
+	}
+}
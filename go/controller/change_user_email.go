@@ -19,6 +19,10 @@ func (ctrl *Controller) ChangeUserEmail( //nolint:ireturn
 	}
 	request.Body.Options = options
 
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
 	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
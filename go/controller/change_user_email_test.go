@@ -303,6 +303,28 @@ func TestChangeUserEmail(t *testing.T) { //nolint:maintidx
 			expectedJWT:       nil,
 			getControllerOpts: []getControllerOptsFunc{},
 		},
+
+		{
+			name:   "impersonated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			jwtTokenFn: impersonated(jwtTokenFn),
+			request: api.ChangeUserEmailRequestObject{
+				Body: &api.UserEmailChangeRequest{
+					NewEmail: "newEmail@acme.com",
+					Options:  nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-impersonation",
+				Message: "Forbidden, action not allowed while impersonating a user.",
+				Status:  403,
+			},
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
 	}
 
 	for _, tc := range cases {
@@ -18,6 +18,10 @@ func (ctrl *Controller) ChangeUserMfa( //nolint:ireturn
 		return ctrl.sendError(ErrDisabledEndpoint), nil
 	}
 
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
 	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
 	if apiErr != nil {
 		return ctrl.sendError(apiErr), nil
@@ -17,6 +17,10 @@ func (ctrl *Controller) postUserPasswordAuthenticated( //nolint:ireturn
 ) (api.ChangeUserPasswordResponseObject, error) {
 	logger.Debug("authenticated request")
 
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
 	userID, err := ctrl.wf.jwtGetter.GetUserID(jwtToken)
 	if err != nil {
 		logger.Error("error getting user id from jwt token", logError(err))
@@ -11,6 +11,7 @@ import (
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 	"github.com/nhost/hasura-auth/go/testhelpers"
 	"go.uber.org/mock/gomock"
@@ -89,6 +90,79 @@ func TestChangeUserPassword(t *testing.T) { //nolint:maintidx
 			getControllerOpts: []getControllerOptsFunc{},
 		},
 
+		{
+			name: "ticket - security notification",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.SecurityNotifications = []string{"password-changed"}
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(),
+					sql.Text("passwordReset:ticket"),
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:    userID,
+					Email: sql.Text("user@acme.local"),
+				}, nil)
+
+				mock.EXPECT().UpdateUserChangePassword(
+					gomock.Any(),
+					gomock.Any(),
+				).Return(userID, nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:          userID,
+					Email:       sql.Text("user@acme.local"),
+					DisplayName: "Jane Doe",
+					Locale:      "fr",
+				}, nil)
+
+				return mock
+			},
+			jwtTokenFn: nil,
+			request: api.ChangeUserPasswordRequestObject{
+				Body: &api.ChangeUserPasswordJSONRequestBody{
+					NewPassword: "password",
+					Ticket:      ptr("passwordReset:ticket"),
+				},
+			},
+			expectedResponse: api.ChangeUserPassword200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withEmailer(func(ctrl *gomock.Controller) *mock.MockEmailer {
+					mock := mock.NewMockEmailer(ctrl)
+
+					mock.EXPECT().SendEmail(
+						gomock.Any(),
+						"user@acme.local",
+						"fr",
+						notifications.TemplateNamePasswordChanged,
+						notifications.TemplateData{
+							Link:        "",
+							DisplayName: "Jane Doe",
+							Email:       "user@acme.local",
+							NewEmail:    "",
+							Ticket:      "",
+							RedirectTo:  "",
+							Locale:      "fr",
+							ServerURL:   "https://local.auth.nhost.run",
+							ClientURL:   "http://localhost:3000",
+							IPAddress:   "",
+							UserAgent:   "",
+						},
+					).Return(nil)
+
+					return mock
+				}),
+			},
+		},
+
 		{
 			name:   "ticket - user not found",
 			config: getConfig,
@@ -412,6 +486,28 @@ func TestChangeUserPassword(t *testing.T) { //nolint:maintidx
 			expectedJWT:       nil,
 			getControllerOpts: []getControllerOptsFunc{},
 		},
+
+		{
+			name:   "auth header - impersonated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			jwtTokenFn: impersonated(jwtTokenFn),
+			request: api.ChangeUserPasswordRequestObject{
+				Body: &api.ChangeUserPasswordJSONRequestBody{
+					NewPassword: "password123456",
+					Ticket:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-impersonation",
+				Message: "Forbidden, action not allowed while impersonating a user.",
+				Status:  403,
+			},
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
 	}
 
 	for _, tc := range cases {
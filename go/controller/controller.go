@@ -50,6 +50,8 @@ type DBClientGetUser interface {
 	GetUser(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
 	GetUserByEmail(ctx context.Context, email pgtype.Text) (sql.AuthUser, error)
 	GetUserByPhoneNumber(ctx context.Context, phoneNumber pgtype.Text) (sql.AuthUser, error)
+	GetUsersByPhoneNumberLegacy(ctx context.Context, digits string) ([]sql.AuthUser, error)
+	UpdateUserPhoneNumber(ctx context.Context, arg sql.UpdateUserPhoneNumberParams) error
 	GetUserByRefreshTokenHash(
 		ctx context.Context, arg sql.GetUserByRefreshTokenHashParams,
 	) (sql.AuthUser, error)
@@ -89,10 +91,14 @@ type DBClientUpdateUser interface { //nolint:interfacebloat
 	) (uuid.UUID, error)
 	UpdateUserConfirmChangeEmail(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
 	UpdateUserVerifyEmail(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
+	UpdateUserVerifyPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
 	UpdateUserTotpSecret(ctx context.Context, arg sql.UpdateUserTotpSecretParams) error
 	UpdateUserActiveMFAType(ctx context.Context, arg sql.UpdateUserActiveMFATypeParams) error
 	InsertSecurityKey(ctx context.Context, arg sql.InsertSecurityKeyParams) (uuid.UUID, error)
 	UpdateUserOTPHash(ctx context.Context, arg sql.UpdateUserOTPHashParams) (uuid.UUID, error)
+	IncrementUserOTPHashAttempts(
+		ctx context.Context, arg sql.IncrementUserOTPHashAttemptsParams,
+	) (int32, error)
 }
 
 type DBClientUserProvider interface {
@@ -113,23 +119,77 @@ type DBClientUserProvider interface {
 	) (sql.AuthUserProvider, error)
 }
 
+type DBClientOrganization interface {
+	InsertOrganizationWithOwner(
+		ctx context.Context, arg sql.InsertOrganizationWithOwnerParams,
+	) (sql.InsertOrganizationWithOwnerRow, error)
+	GetUserOrganizations(
+		ctx context.Context, userID uuid.UUID,
+	) ([]sql.GetUserOrganizationsRow, error)
+	GetOrganizationMember(
+		ctx context.Context, arg sql.GetOrganizationMemberParams,
+	) (sql.AuthOrganizationMember, error)
+	InsertOrganizationInvitation(
+		ctx context.Context, arg sql.InsertOrganizationInvitationParams,
+	) (sql.AuthOrganizationInvitation, error)
+	GetUserOrganizationInvitations(
+		ctx context.Context, email string,
+	) ([]sql.GetUserOrganizationInvitationsRow, error)
+	AcceptOrganizationInvitation(
+		ctx context.Context, arg sql.AcceptOrganizationInvitationParams,
+	) (uuid.UUID, error)
+}
+
+type DBClientMfaChallenge interface {
+	InsertMfaChallenge(ctx context.Context, arg sql.InsertMfaChallengeParams) error
+	CountActiveMfaChallenges(ctx context.Context, userID uuid.UUID) (int64, error)
+	GetMfaChallengeByTicket(
+		ctx context.Context, arg sql.GetMfaChallengeByTicketParams,
+	) (sql.AuthMfaChallenge, error)
+	DeleteMfaChallenge(ctx context.Context, id uuid.UUID) error
+}
+
 type DBClient interface { //nolint:interfacebloat
 	DBClientGetUser
 	DBClientInsertUser
 	DBClientUpdateUser
 	DBClientUserProvider
+	DBClientOrganization
+	DBClientMfaChallenge
 
 	CountSecurityKeysUser(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetSecurityKeys(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserSecurityKey, error)
 	DeleteRefreshTokens(ctx context.Context, userID uuid.UUID) error
 	DeleteRefreshToken(ctx context.Context, refreshTokenHash pgtype.Text) error
 	DeleteUserRoles(ctx context.Context, userID uuid.UUID) error
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+	GetUserProviders(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserProvider, error)
+	GetUserRefreshTokens(
+		ctx context.Context, userID uuid.UUID,
+	) ([]sql.GetUserRefreshTokensRow, error)
 	GetUserRoles(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserRole, error)
 	InsertRefreshtoken(ctx context.Context, arg sql.InsertRefreshtokenParams) (uuid.UUID, error)
+	InsertUserImpersonation(
+		ctx context.Context, arg sql.InsertUserImpersonationParams,
+	) (uuid.UUID, error)
+	InsertAuditEvent(ctx context.Context, arg sql.InsertAuditEventParams) error
+	InsertWebhookOutbox(ctx context.Context, arg sql.InsertWebhookOutboxParams) error
+	GetUserAuditEvents(
+		ctx context.Context, arg sql.GetUserAuditEventsParams,
+	) ([]sql.AuthAuditEvent, error)
+	GetUserImpersonations(
+		ctx context.Context, userID uuid.UUID,
+	) ([]sql.AuthUserImpersonation, error)
+	GetUserSignInHistory(
+		ctx context.Context, arg sql.GetUserSignInHistoryParams,
+	) (sql.GetUserSignInHistoryRow, error)
 	RefreshTokenAndGetUserRoles(
 		ctx context.Context,
 		arg sql.RefreshTokenAndGetUserRolesParams,
 	) ([]sql.RefreshTokenAndGetUserRolesRow, error)
+	UpdateRefreshTokenOrganization(
+		ctx context.Context, arg sql.UpdateRefreshTokenOrganizationParams,
+	) error
 }
 
 type Controller struct {
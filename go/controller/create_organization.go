@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) CreateOrganization( //nolint:ireturn
+	ctx context.Context, request api.CreateOrganizationRequestObject,
+) (api.CreateOrganizationResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).With(slog.String("slug", request.Body.Slug))
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	userID, apiErr := ctrl.wf.GetJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	var metadata []byte
+	if request.Body.Metadata != nil {
+		var err error
+		metadata, err = json.Marshal(request.Body.Metadata)
+		if err != nil {
+			logger.Error("error marshalling organization metadata", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+	}
+
+	roles := []string{organizationOwnerRole}
+	org, err := ctrl.wf.db.InsertOrganizationWithOwner(
+		ctx, sql.InsertOrganizationWithOwnerParams{
+			Name:     request.Body.Name,
+			Slug:     request.Body.Slug,
+			Metadata: metadata,
+			UserID:   userID,
+			Roles:    roles,
+		},
+	)
+	if sqlIsDuplcateError(err, "organizations_slug_key") {
+		logger.Warn("organization slug already in use")
+		return ctrl.sendError(ErrOrganizationSlugInUse), nil
+	}
+	if err != nil {
+		logger.Error("error inserting organization", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	ctrl.wf.InvalidateCustomClaims(ctx, userID, logger)
+
+	return api.CreateOrganization200JSONResponse{
+		Id:        org.ID,
+		Name:      request.Body.Name,
+		Slug:      request.Body.Slug,
+		CreatedAt: org.CreatedAt.Time,
+		Roles:     roles,
+	}, nil
+}
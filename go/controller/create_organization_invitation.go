@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) CreateOrganizationInvitation( //nolint:ireturn
+	ctx context.Context, request api.CreateOrganizationInvitationRequestObject,
+) (api.CreateOrganizationInvitationResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).With(
+		slog.String("organizationId", request.OrganizationId.String()),
+	)
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	userID, apiErr := ctrl.wf.GetJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	member, apiErr := ctrl.wf.GetOrganizationMember(
+		ctx, request.OrganizationId, userID, logger,
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if !slices.Contains(member.Roles, organizationOwnerRole) {
+		logger.Warn("only organization owners can invite users")
+		return ctrl.sendError(ErrForbiddenOrganization), nil
+	}
+
+	invitation, err := ctrl.wf.db.InsertOrganizationInvitation(
+		ctx, sql.InsertOrganizationInvitationParams{
+			OrganizationID: request.OrganizationId,
+			Email:          strings.ToLower(string(request.Body.Email)),
+			Roles:          request.Body.Roles,
+			InvitedBy:      pgtype.UUID{Bytes: userID, Valid: true},
+			ExpiresAt:      sql.TimestampTz(time.Now().Add(organizationInvitationExpiresIn)),
+		},
+	)
+	if err != nil {
+		logger.Error("error inserting organization invitation", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	return api.CreateOrganizationInvitation200JSONResponse{
+		Id:               invitation.ID,
+		OrganizationId:   invitation.OrganizationID,
+		OrganizationName: nil,
+		Roles:            invitation.Roles,
+		ExpiresAt:        invitation.ExpiresAt.Time,
+	}, nil
+}
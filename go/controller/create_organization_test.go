@@ -0,0 +1,173 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateOrganization(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	orgID := uuid.MustParse("5b1c1b6a-7e0a-4c8e-8a57-0c1f0f5b6d2e")
+	createdAt := time.Now()
+
+	jwtTokenFn := func() *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     []any{"user", "me"},
+					"x-hasura-default-role":      "user",
+					"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+					"x-hasura-user-is-anonymous": "false",
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	configEnabled := func() *controller.Config {
+		c := getConfig()
+		c.OrganizationsEnabled = true
+		return c
+	}
+
+	cases := []testRequest[api.CreateOrganizationRequestObject, api.CreateOrganizationResponseObject]{
+		{
+			name:   "simple",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertOrganizationWithOwner(
+					gomock.Any(),
+					sql.InsertOrganizationWithOwnerParams{
+						Name:     "Acme",
+						Slug:     "acme",
+						Metadata: []byte(`{"plan":"pro"}`),
+						UserID:   userID,
+						Roles:    []string{"owner"},
+					},
+				).Return(sql.InsertOrganizationWithOwnerRow{
+					ID:        orgID,
+					CreatedAt: sql.TimestampTz(createdAt),
+				}, nil)
+
+				return mock
+			},
+			request: api.CreateOrganizationRequestObject{
+				Body: &api.CreateOrganizationRequest{
+					Name:     "Acme",
+					Slug:     "acme",
+					Metadata: ptr(map[string]any{"plan": "pro"}),
+				},
+			},
+			expectedResponse: api.CreateOrganization200JSONResponse{
+				Id:        orgID,
+				Name:      "Acme",
+				Slug:      "acme",
+				CreatedAt: createdAt,
+				Roles:     []string{"owner"},
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "slug in use",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertOrganizationWithOwner(
+					gomock.Any(),
+					sql.InsertOrganizationWithOwnerParams{
+						Name:     "Acme",
+						Slug:     "acme",
+						Metadata: nil,
+						UserID:   userID,
+						Roles:    []string{"owner"},
+					},
+				).Return(
+					sql.InsertOrganizationWithOwnerRow{}, //nolint:exhaustruct
+					errors.New(`ERROR: duplicate key value violates unique constraint "organizations_slug_key" (SQLSTATE 23505)`), //nolint:goerr113,lll
+				)
+
+				return mock
+			},
+			request: api.CreateOrganizationRequestObject{
+				Body: &api.CreateOrganizationRequest{
+					Name:     "Acme",
+					Slug:     "acme",
+					Metadata: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "organization-slug-in-use",
+				Message: "Organization slug already in use",
+				Status:  409,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "organizations disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.CreateOrganizationRequestObject{
+				Body: &api.CreateOrganizationRequest{
+					Name:     "Acme",
+					Slug:     "acme",
+					Metadata: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			ctx := jwtGetter.ToContext(t.Context(), tc.jwtTokenFn())
+
+			assertRequest(ctx, t, c.CreateOrganization, tc.request, tc.expectedResponse)
+		})
+	}
+}
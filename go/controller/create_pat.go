@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 )
 
@@ -13,6 +14,10 @@ func (ctrl *Controller) CreatePAT( //nolint:ireturn
 	ctx context.Context, request api.CreatePATRequestObject,
 ) (api.CreatePATResponseObject, error) {
 	logger := middleware.LoggerFromContext(ctx)
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
 	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
@@ -32,6 +37,11 @@ func (ctrl *Controller) CreatePAT( //nolint:ireturn
 		return ctrl.respondWithError(apiErr), nil
 	}
 
+	ctrl.wf.RecordAuditEvent(
+		ctx, user.ID, api.PatCreated, map[string]any{"patId": refreshTokenID.String()}, logger,
+	)
+	ctrl.wf.SendSecurityNotification(ctx, user, notifications.TemplateNamePATCreated, logger)
+
 	return api.CreatePAT200JSONResponse{
 		Id:                  refreshTokenID.String(),
 		PersonalAccessToken: pat.String(),
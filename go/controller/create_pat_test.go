@@ -196,6 +196,26 @@ func TestCreatePAT(t *testing.T) {
 				Status:  401,
 			},
 		},
+
+		{
+			name:   "impersonated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			jwtTokenFn: impersonated(jwtTokenFn),
+			request: api.CreatePATRequestObject{
+				Body: &api.CreatePATRequest{
+					ExpiresAt: time.Now().Add(time.Hour),
+					Metadata:  nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-impersonation",
+				Message: "Forbidden, action not allowed while impersonating a user.",
+				Status:  403,
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -187,6 +187,7 @@ func (j jsonPath) IsArrary() bool {
 }
 
 type CustomClaims struct {
+	claims             map[string]any
 	graphqlQuery       string
 	jsonPaths          map[string]jsonPath
 	httpclient         *http.Client
@@ -258,6 +259,7 @@ func NewCustomClaims(
 	)
 
 	return &CustomClaims{
+		claims:             claims,
 		graphqlQuery:       query,
 		jsonPaths:          jsonPaths,
 		httpclient:         httpclient,
@@ -0,0 +1,418 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrCustomClaimsUnknownField = errors.New("unknown field in custom claims")
+	ErrCustomClaimsMetadata     = errors.New("error reading hasura metadata")
+)
+
+const (
+	hasuraMetadataQuery = `SELECT metadata FROM hdb_catalog.hdb_metadata WHERE id = 1`
+
+	// foreignKeyQuery returns the table and column referenced by the
+	// foreign key defined on the given column.
+	foreignKeyQuery = `SELECT rn.nspname, rc.relname, ra.attname
+FROM pg_catalog.pg_constraint c
+JOIN pg_catalog.pg_class cl ON cl.oid = c.conrelid
+JOIN pg_catalog.pg_namespace n ON n.oid = cl.relnamespace
+JOIN pg_catalog.pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = ANY(c.conkey)
+JOIN pg_catalog.pg_class rc ON rc.oid = c.confrelid
+JOIN pg_catalog.pg_namespace rn ON rn.oid = rc.relnamespace
+JOIN pg_catalog.pg_attribute ra ON ra.attrelid = c.confrelid
+	AND ra.attnum = c.confkey[array_position(c.conkey, a.attnum)]
+WHERE c.contype = 'f' AND n.nspname = $1 AND cl.relname = $2 AND a.attname = $3
+LIMIT 1`
+
+	hasuraSourceName = "default"
+)
+
+type PostgresCustomClaimsDB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type hasuraTableName struct {
+	Schema string `json:"schema"`
+	Name   string `json:"name"`
+}
+
+func (t *hasuraTableName) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		t.Schema = "public"
+		t.Name = name
+		return nil
+	}
+
+	type alias hasuraTableName
+	var a alias
+	if err := json.Unmarshal(b, &a); err != nil {
+		return err //nolint:wrapcheck
+	}
+	if a.Schema == "" {
+		a.Schema = "public"
+	}
+	*t = hasuraTableName(a)
+
+	return nil
+}
+
+func (t hasuraTableName) sanitize() string {
+	return pgx.Identifier{t.Schema, t.Name}.Sanitize()
+}
+
+type hasuraRelationship struct {
+	Name  string `json:"name"`
+	Using struct {
+		ForeignKeyConstraintOn json.RawMessage `json:"foreign_key_constraint_on"` //nolint:tagliatelle
+		ManualConfiguration    *struct {
+			RemoteTable   hasuraTableName   `json:"remote_table"`   //nolint:tagliatelle
+			ColumnMapping map[string]string `json:"column_mapping"` //nolint:tagliatelle
+		} `json:"manual_configuration"` //nolint:tagliatelle
+	} `json:"using"`
+}
+
+type hasuraTable struct {
+	Table         hasuraTableName `json:"table"`
+	Configuration struct {
+		CustomColumnNames map[string]string `json:"custom_column_names"` //nolint:tagliatelle
+		ColumnConfig      map[string]struct {
+			CustomName string `json:"custom_name"` //nolint:tagliatelle
+		} `json:"column_config"` //nolint:tagliatelle
+	} `json:"configuration"`
+	ObjectRelationships []hasuraRelationship `json:"object_relationships"` //nolint:tagliatelle
+	ArrayRelationships  []hasuraRelationship `json:"array_relationships"`  //nolint:tagliatelle
+}
+
+// column returns the name of the column exposed as field, custom column
+// names are honored.
+func (t *hasuraTable) column(field string) string {
+	for column, config := range t.Configuration.ColumnConfig {
+		if config.CustomName == field {
+			return column
+		}
+	}
+	for column, name := range t.Configuration.CustomColumnNames {
+		if name == field {
+			return column
+		}
+	}
+	return field
+}
+
+type hasuraMetadata struct {
+	Sources []struct {
+		Name   string        `json:"name"`
+		Tables []hasuraTable `json:"tables"`
+	} `json:"sources"`
+}
+
+// PostgresCustomClaims resolves the same claims as CustomClaims but, instead
+// of sending a GraphQL query to Hasura, the query is compiled into SQL and run
+// directly against the database. Relationships and custom column names are
+// read from the Hasura metadata stored in hdb_catalog so the claims must be
+// configured exactly as with CustomClaims.
+//
+// The query is compiled the first time claims are requested and reused
+// afterwards.
+type PostgresCustomClaims struct {
+	customClaims *CustomClaims
+	db           PostgresCustomClaimsDB
+	mu           sync.Mutex
+	query        string
+}
+
+func NewPostgresCustomClaims(
+	rawClaims map[string]string,
+	db PostgresCustomClaimsDB,
+	defaults map[string]any,
+) (*PostgresCustomClaims, error) {
+	customClaims, err := NewCustomClaims(rawClaims, nil, "", defaults)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgresCustomClaims{
+		customClaims: customClaims,
+		db:           db,
+		mu:           sync.Mutex{},
+		query:        "",
+	}, nil
+}
+
+// SQLQuery returns the SQL query used to resolve the claims of a user.
+func (c *PostgresCustomClaims) SQLQuery(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.query != "" {
+		return c.query, nil
+	}
+
+	var b []byte
+	if err := c.db.QueryRow(ctx, hasuraMetadataQuery).Scan(&b); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCustomClaimsMetadata, err)
+	}
+
+	var metadata hasuraMetadata
+	if err := json.Unmarshal(b, &metadata); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCustomClaimsMetadata, err)
+	}
+
+	compiler := &claimsCompiler{
+		db:     c.db,
+		tables: make(map[hasuraTableName]*hasuraTable),
+		alias:  0,
+	}
+	for _, source := range metadata.Sources {
+		if source.Name != hasuraSourceName {
+			continue
+		}
+		for i := range source.Tables {
+			compiler.tables[source.Tables[i].Table] = &source.Tables[i]
+		}
+	}
+
+	users, ok := compiler.tables[hasuraTableName{Schema: "auth", Name: "users"}]
+	if !ok {
+		return "", fmt.Errorf("%w: auth.users is not tracked", ErrCustomClaimsMetadata)
+	}
+
+	alias := compiler.nextAlias()
+	object, err := compiler.object(ctx, users, alias, c.customClaims.claims)
+	if err != nil {
+		return "", err
+	}
+
+	c.query = fmt.Sprintf(
+		"SELECT %s FROM %s %s WHERE %s.id = $1", object, users.Table.sanitize(), alias, alias,
+	)
+
+	return c.query, nil
+}
+
+func (c *PostgresCustomClaims) GetClaims(ctx context.Context, userID string) (map[string]any, error) {
+	query, err := c.SQLQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var b []byte
+	if err := c.db.QueryRow(ctx, query, userID).Scan(&b); err != nil {
+		return nil, fmt.Errorf("failed to get claims: %w", err)
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode claims: %w", err)
+	}
+
+	return c.customClaims.ExtractClaims(data)
+}
+
+type claimsCompiler struct {
+	db     PostgresCustomClaimsDB
+	tables map[hasuraTableName]*hasuraTable
+	alias  int
+}
+
+func (c *claimsCompiler) nextAlias() string {
+	alias := "t" + strconv.Itoa(c.alias)
+	c.alias++
+	return alias
+}
+
+// object returns a json_build_object expression with the given fields of the
+// row aliased as alias.
+func (c *claimsCompiler) object(
+	ctx context.Context, table *hasuraTable, alias string, fields map[string]any,
+) (string, error) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	args := make([]string, 0, len(keys))
+	for _, field := range keys {
+		value, err := c.field(ctx, table, alias, field, fields[field])
+		if err != nil {
+			return "", err
+		}
+		args = append(args, quoteLiteral(field), value)
+	}
+
+	return "json_build_object(" + strings.Join(args, ", ") + ")", nil
+}
+
+func (c *claimsCompiler) field(
+	ctx context.Context, table *hasuraTable, alias string, field string, value any,
+) (string, error) {
+	for _, isArray := range []bool{false, true} {
+		relationships := table.ObjectRelationships
+		if isArray {
+			relationships = table.ArrayRelationships
+		}
+
+		for _, rel := range relationships {
+			if rel.Name != field {
+				continue
+			}
+
+			fields, ok := value.(map[string]any)
+			if !ok {
+				return "", fmt.Errorf(
+					"%w: relationship %s.%s requires a selection",
+					ErrCustomClaimsUnknownField, table.Table.Name, field,
+				)
+			}
+
+			return c.relationship(ctx, table, alias, rel, isArray, fields)
+		}
+	}
+
+	if value != nil {
+		return "", fmt.Errorf(
+			"%w: %s.%s is not a relationship", ErrCustomClaimsUnknownField, table.Table.Name, field,
+		)
+	}
+
+	return alias + "." + pgx.Identifier{table.column(field)}.Sanitize(), nil
+}
+
+func (c *claimsCompiler) relationship(
+	ctx context.Context,
+	table *hasuraTable,
+	alias string,
+	rel hasuraRelationship,
+	isArray bool,
+	fields map[string]any,
+) (string, error) {
+	remoteName, mapping, err := c.columnMapping(ctx, table.Table, rel)
+	if err != nil {
+		return "", err
+	}
+
+	remote, ok := c.tables[remoteName]
+	if !ok {
+		return "", fmt.Errorf(
+			"%w: %s.%s is not tracked", ErrCustomClaimsMetadata, remoteName.Schema, remoteName.Name,
+		)
+	}
+
+	remoteAlias := c.nextAlias()
+	object, err := c.object(ctx, remote, remoteAlias, fields)
+	if err != nil {
+		return "", err
+	}
+
+	locals := make([]string, 0, len(mapping))
+	for local := range mapping {
+		locals = append(locals, local)
+	}
+	sort.Strings(locals)
+
+	conditions := make([]string, len(locals))
+	for i, local := range locals {
+		conditions[i] = fmt.Sprintf(
+			"%s.%s = %s.%s",
+			remoteAlias, pgx.Identifier{mapping[local]}.Sanitize(),
+			alias, pgx.Identifier{local}.Sanitize(),
+		)
+	}
+
+	if isArray {
+		return fmt.Sprintf(
+			"(SELECT coalesce(json_agg(%s), '[]') FROM %s %s WHERE %s)",
+			object, remote.Table.sanitize(), remoteAlias, strings.Join(conditions, " AND "),
+		), nil
+	}
+
+	return fmt.Sprintf(
+		"(SELECT %s FROM %s %s WHERE %s LIMIT 1)",
+		object, remote.Table.sanitize(), remoteAlias, strings.Join(conditions, " AND "),
+	), nil
+}
+
+// columnMapping returns the remote table of the relationship and the mapping
+// between local and remote columns used to join them.
+func (c *claimsCompiler) columnMapping(
+	ctx context.Context, table hasuraTableName, rel hasuraRelationship,
+) (hasuraTableName, map[string]string, error) {
+	if rel.Using.ManualConfiguration != nil {
+		return rel.Using.ManualConfiguration.RemoteTable,
+			rel.Using.ManualConfiguration.ColumnMapping,
+			nil
+	}
+
+	var fkOn struct {
+		Table   *hasuraTableName `json:"table"`
+		Column  string           `json:"column"`
+		Columns []string         `json:"columns"`
+	}
+	var column string
+	if err := json.Unmarshal(rel.Using.ForeignKeyConstraintOn, &column); err == nil {
+		fkOn.Column = column
+	} else if err := json.Unmarshal(rel.Using.ForeignKeyConstraintOn, &fkOn); err != nil {
+		return hasuraTableName{}, nil, fmt.Errorf(
+			"%w: relationship %s: %w", ErrCustomClaimsMetadata, rel.Name, err,
+		)
+	}
+
+	columns := fkOn.Columns
+	if fkOn.Column != "" {
+		columns = append(columns, fkOn.Column)
+	}
+	if len(columns) == 0 {
+		return hasuraTableName{}, nil, fmt.Errorf(
+			"%w: relationship %s has no foreign key", ErrCustomClaimsMetadata, rel.Name,
+		)
+	}
+
+	// the foreign key is defined on the remote table and references the
+	// local one
+	fkTable := table
+	if fkOn.Table != nil {
+		fkTable = *fkOn.Table
+	}
+
+	var referenced hasuraTableName
+	mapping := make(map[string]string, len(columns))
+	for _, column := range columns {
+		var refColumn string
+		if err := c.db.QueryRow(
+			ctx, foreignKeyQuery, fkTable.Schema, fkTable.Name, column,
+		).Scan(&referenced.Schema, &referenced.Name, &refColumn); err != nil {
+			return hasuraTableName{}, nil, fmt.Errorf(
+				"%w: foreign key of relationship %s: %w", ErrCustomClaimsMetadata, rel.Name, err,
+			)
+		}
+
+		if fkOn.Table != nil {
+			mapping[refColumn] = column
+		} else {
+			mapping[column] = refColumn
+		}
+	}
+
+	if fkOn.Table != nil {
+		return fkTable, mapping, nil
+	}
+
+	return referenced, mapping, nil
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,200 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/controller"
+)
+
+const testHasuraMetadata = `{
+  "version": 3,
+  "sources": [
+    {
+      "name": "default",
+      "kind": "postgres",
+      "tables": [
+        {
+          "table": {"schema": "auth", "name": "users"},
+          "configuration": {
+            "custom_name": "users",
+            "custom_column_names": {"display_name": "displayName", "default_role": "defaultRole"}
+          },
+          "object_relationships": [
+            {"name": "defaultRoleByRole", "using": {"foreign_key_constraint_on": "default_role"}},
+            {
+              "name": "profile",
+              "using": {
+                "manual_configuration": {
+                  "remote_table": {"schema": "public", "name": "profiles"},
+                  "column_mapping": {"id": "user_id"}
+                }
+              }
+            }
+          ],
+          "array_relationships": [
+            {
+              "name": "roles",
+              "using": {
+                "foreign_key_constraint_on": {
+                  "column": "user_id",
+                  "table": {"schema": "auth", "name": "user_roles"}
+                }
+              }
+            }
+          ]
+        },
+        {"table": {"schema": "auth", "name": "roles"}},
+        {"table": {"schema": "auth", "name": "user_roles"}},
+        {
+          "table": "profiles",
+          "configuration": {"column_config": {"organisation_id": {"custom_name": "organisationId"}}}
+        }
+      ]
+    }
+  ]
+}`
+
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	for i, d := range dest {
+		switch d := d.(type) {
+		case *[]byte:
+			*d = r.values[i].([]byte) //nolint:forcetypeassert
+		case *string:
+			*d = r.values[i].(string) //nolint:forcetypeassert
+		}
+	}
+
+	return nil
+}
+
+type fakeClaimsDB struct {
+	data    string
+	queries []string
+}
+
+func (db *fakeClaimsDB) QueryRow(_ context.Context, sql string, args ...any) pgx.Row { //nolint:ireturn
+	switch {
+	case strings.Contains(sql, "hdb_catalog.hdb_metadata"):
+		return fakeRow{values: []any{[]byte(testHasuraMetadata)}, err: nil}
+	case strings.Contains(sql, "pg_catalog.pg_constraint"):
+		switch args[0].(string) + "." + args[1].(string) + "." + args[2].(string) {
+		case "auth.users.default_role":
+			return fakeRow{values: []any{"auth", "roles", "role"}, err: nil}
+		case "auth.user_roles.user_id":
+			return fakeRow{values: []any{"auth", "users", "id"}, err: nil}
+		}
+		return fakeRow{values: nil, err: pgx.ErrNoRows}
+	default:
+		db.queries = append(db.queries, sql)
+		return fakeRow{values: []any{[]byte(db.data)}, err: nil}
+	}
+}
+
+func TestPostgresCustomClaims(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		claims         map[string]string
+		data           string
+		expectedSQL    string
+		expectedClaims map[string]any
+		expectedErr    error
+	}{
+		{
+			name: "relationships",
+			claims: map[string]string{
+				"display-name":    "displayName",
+				"default-role":    "defaultRoleByRole.role",
+				"allowed-roles[]": "roles[].role",
+				"admin-role":      "roles[?(@.role == 'admin')].role",
+				"organisation-id": "profile.organisationId",
+				"metadata":        "metadata.m1",
+			},
+			data: `{
+				"defaultRoleByRole": {"role": "user"},
+				"displayName": "Jane",
+				"metadata": {"m1": 1},
+				"profile": {"organisationId": "org-1"},
+				"roles": [{"role": "user"}, {"role": "admin"}]
+			}`,
+			expectedSQL: `SELECT json_build_object(` +
+				`'defaultRoleByRole', (SELECT json_build_object('role', t1."role") FROM "auth"."roles" t1 WHERE t1."role" = t0."default_role" LIMIT 1), ` + //nolint:lll
+				`'displayName', t0."display_name", ` +
+				`'metadata', t0."metadata", ` +
+				`'profile', (SELECT json_build_object('organisationId', t2."organisation_id") FROM "public"."profiles" t2 WHERE t2."user_id" = t0."id" LIMIT 1), ` + //nolint:lll
+				`'roles', (SELECT coalesce(json_agg(json_build_object('role', t3."role")), '[]') FROM "auth"."user_roles" t3 WHERE t3."user_id" = t0."id")` + //nolint:lll
+				`) FROM "auth"."users" t0 WHERE t0.id = $1`,
+			expectedClaims: map[string]any{
+				"display-name":    "Jane",
+				"default-role":    "user",
+				"allowed-roles[]": []any{"user", "admin"},
+				"admin-role":      []any{"admin"},
+				"organisation-id": "org-1",
+				"metadata":        float64(1),
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "nested field on a column",
+			claims: map[string]string{
+				"name": "displayName.first",
+			},
+			data:           "",
+			expectedSQL:    "",
+			expectedClaims: nil,
+			expectedErr:    controller.ErrCustomClaimsUnknownField,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := &fakeClaimsDB{data: tc.data, queries: nil}
+			c, err := controller.NewPostgresCustomClaims(tc.claims, db, nil)
+			if err != nil {
+				t.Fatalf("failed to create custom claims: %v", err)
+			}
+
+			got, err := c.GetClaims(context.Background(), "user-id")
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff([]string{tc.expectedSQL}, db.queries); diff != "" {
+				t.Errorf("unexpected query (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.expectedClaims, got); diff != "" {
+				t.Errorf("unexpected claims (-want +got):\n%s", diff)
+			}
+
+			// the compiled query is reused
+			if _, err := c.GetClaims(context.Background(), "user-id"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(db.queries) != 2 || db.queries[1] != tc.expectedSQL { //nolint:mnd
+				t.Errorf("expected the same query to be reused, got %v", db.queries)
+			}
+		})
+	}
+}
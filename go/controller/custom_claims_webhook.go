@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+
+	"github.com/nhost/hasura-auth/go/claimscache"
+	"github.com/nhost/hasura-auth/go/hooks"
+)
+
+type CustomClaimsHook interface {
+	CustomClaims(
+		ctx context.Context, req hooks.CustomClaimsRequest, logger *slog.Logger,
+	) (hooks.CustomClaimsResponse, error)
+}
+
+type customClaimsCtxKey struct{}
+
+// customClaimsContext carries what is known about the token being issued to
+// the custom claimers that need more than the user ID.
+type customClaimsContext struct {
+	request hooks.CustomClaimsRequest
+	logger  *slog.Logger
+}
+
+func withCustomClaimsContext(
+	ctx context.Context, req hooks.CustomClaimsRequest, logger *slog.Logger,
+) context.Context {
+	ip, userAgent, _ := auditRequestInfo(ctx)
+	req.IP = ip.String
+	req.UserAgent = userAgent.String
+
+	return context.WithValue(ctx, customClaimsCtxKey{}, customClaimsContext{
+		request: req,
+		logger:  logger,
+	})
+}
+
+func customClaimsContextFrom(ctx context.Context, userID string) customClaimsContext {
+	if c, ok := ctx.Value(customClaimsCtxKey{}).(customClaimsContext); ok {
+		return c
+	}
+
+	return customClaimsContext{
+		request: hooks.CustomClaimsRequest{UserID: userID}, //nolint:exhaustruct
+		logger:  slog.Default(),
+	}
+}
+
+// WebhookCustomClaims gets custom claims from the custom-claims hook, which
+// receives the user ID, their roles and where they are signing in from. If the
+// hook fails the defaults are returned alongside claimscache.ErrFallbackClaims
+// so tokens can still be issued.
+type WebhookCustomClaims struct {
+	hook     CustomClaimsHook
+	defaults map[string]any
+}
+
+func NewWebhookCustomClaims(hook CustomClaimsHook, defaults map[string]any) *WebhookCustomClaims {
+	return &WebhookCustomClaims{
+		hook:     hook,
+		defaults: defaults,
+	}
+}
+
+func (c *WebhookCustomClaims) GetClaims(
+	ctx context.Context, userID string,
+) (map[string]any, error) {
+	cc := customClaimsContextFrom(ctx, userID)
+
+	resp, err := c.hook.CustomClaims(ctx, cc.request, cc.logger)
+	if err != nil {
+		claims := make(map[string]any, len(c.defaults))
+		maps.Copy(claims, c.defaults)
+		return claims, fmt.Errorf("%w: %w", claimscache.ErrFallbackClaims, err)
+	}
+
+	claims := make(map[string]any, len(resp.Claims))
+	maps.Copy(claims, resp.Claims)
+	for name, val := range c.defaults {
+		if claims[name] == nil {
+			claims[name] = val
+		}
+	}
+
+	return claims, nil
+}
+
+// MultiCustomClaims merges the claims of several claimers, later claimers
+// take precedence. Claimers that fail are skipped so one source being down
+// doesn't drop the claims of the others, in that case the merged claims are
+// returned alongside claimscache.ErrFallbackClaims.
+type MultiCustomClaims struct {
+	claimers []CustomClaimer
+}
+
+func NewMultiCustomClaims(claimers ...CustomClaimer) *MultiCustomClaims {
+	return &MultiCustomClaims{
+		claimers: claimers,
+	}
+}
+
+func (c *MultiCustomClaims) GetClaims(
+	ctx context.Context, userID string,
+) (map[string]any, error) {
+	logger := customClaimsContextFrom(ctx, userID).logger
+
+	claims := make(map[string]any)
+	errs := make([]error, 0, len(c.claimers))
+	failed := 0
+	for _, claimer := range c.claimers {
+		got, err := claimer.GetClaims(ctx, userID)
+		if errors.Is(err, claimscache.ErrFallbackClaims) {
+			errs = append(errs, err)
+			maps.Copy(claims, got)
+			continue
+		}
+		if err != nil {
+			logger.Warn("error getting custom claims", slog.String("error", err.Error()))
+			errs = append(errs, err)
+			failed++
+			continue
+		}
+		maps.Copy(claims, got)
+	}
+
+	switch {
+	case len(errs) == 0:
+		return claims, nil
+	case failed == len(c.claimers):
+		return nil, errors.Join(errs...)
+	default:
+		return claims, fmt.Errorf("%w: %w", claimscache.ErrFallbackClaims, errors.Join(errs...))
+	}
+}
@@ -0,0 +1,208 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/claimscache"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/hooks"
+	"github.com/nhost/hasura-auth/go/webhooks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTokenWebhookCustomClaims(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	userID := uuid.MustParse("585e21fc-3664-4d03-8539-69945342a4f4")
+
+	cases := []struct {
+		name           string
+		statusCode     int
+		body           string
+		graphql        bool
+		expectedClaims map[string]any
+	}{
+		{
+			name:       "claims merged",
+			statusCode: http.StatusOK,
+			body:       `{"claims": {"plan": "pro", "seats": null}}`,
+			graphql:    false,
+			expectedClaims: map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "editor"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           userID.String(),
+				"x-hasura-user-is-anonymous": "false",
+				"x-hasura-plan":              "pro",
+				"x-hasura-seats":             "1",
+			},
+		},
+		{
+			name:       "defaults on failure",
+			statusCode: http.StatusInternalServerError,
+			body:       `{}`,
+			graphql:    false,
+			expectedClaims: map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "editor"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           userID.String(),
+				"x-hasura-user-is-anonymous": "false",
+				"x-hasura-plan":              "free",
+				"x-hasura-seats":             "1",
+			},
+		},
+		{
+			name:       "composed with other claimers, defaults on failure",
+			statusCode: http.StatusInternalServerError,
+			body:       `{}`,
+			graphql:    true,
+			expectedClaims: map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "editor"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           userID.String(),
+				"x-hasura-user-is-anonymous": "false",
+				"x-hasura-organization-id":   "acme",
+				"x-hasura-plan":              "free",
+				"x-hasura-seats":             "1",
+			},
+		},
+		{
+			name:       "composed with other claimers",
+			statusCode: http.StatusOK,
+			body:       `{"claims": {"plan": "pro", "seats": 5}}`,
+			graphql:    true,
+			expectedClaims: map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "editor"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           userID.String(),
+				"x-hasura-user-is-anonymous": "false",
+				"x-hasura-organization-id":   "acme",
+				"x-hasura-plan":              "pro",
+				"x-hasura-seats":             "5",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received hooks.CustomClaimsRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get(hooks.HeaderHook) != hooks.HookCustomClaims {
+					t.Errorf("unexpected hook header: %s", r.Header.Get(hooks.HeaderHook))
+				}
+				if r.Header.Get(webhooks.HeaderSignature) == "" {
+					t.Error("expected request to be signed")
+				}
+				if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+					t.Errorf("error decoding request: %v", err)
+				}
+
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			var customClaimer controller.CustomClaimer = controller.NewWebhookCustomClaims(
+				hooks.NewClient(server.URL, "secret", time.Second, false),
+				map[string]any{"plan": "free", "seats": 1},
+			)
+
+			if tc.graphql {
+				ctrl := gomock.NewController(t)
+				graphql := mock.NewMockCustomClaimer(ctrl)
+				graphql.EXPECT().GetClaims(gomock.Any(), userID.String()).Return(
+					map[string]any{"organization-id": "acme", "plan": "free"}, nil,
+				)
+				customClaimer = controller.NewMultiCustomClaims(graphql, customClaimer)
+			}
+
+			jwtGetter, err := controller.NewJWTGetter(jwtSecret, time.Hour, customClaimer, "", nil)
+			if err != nil {
+				t.Fatalf("failed to create jwt getter: %v", err)
+			}
+
+			accessToken, _, err := jwtGetter.GetToken(
+				t.Context(), userID, false, []string{"user", "editor"}, "user", nil, slog.Default(),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expectedRequest := hooks.CustomClaimsRequest{
+				UserID:       userID.String(),
+				IsAnonymous:  false,
+				DefaultRole:  "user",
+				AllowedRoles: []string{"user", "editor"},
+				IP:           "",
+				UserAgent:    "",
+			}
+			if diff := cmp.Diff(expectedRequest, received); diff != "" {
+				t.Errorf("unexpected request (-want +got):\n%s", diff)
+			}
+
+			decodedToken, err := jwtGetter.Validate(accessToken)
+			if err != nil {
+				t.Fatalf("failed to validate token: %v", err)
+			}
+
+			claims, ok := decodedToken.Claims.(jwt.MapClaims)
+			if !ok {
+				t.Fatalf("unexpected claims type %T", decodedToken.Claims)
+			}
+
+			if diff := cmp.Diff(
+				tc.expectedClaims, claims["https://hasura.io/jwt/claims"],
+			); diff != "" {
+				t.Errorf("unexpected claims (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWebhookCustomClaimsFallbackNotCached(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cache := claimscache.New(
+		controller.NewWebhookCustomClaims(
+			hooks.NewClient(server.URL, "secret", time.Second, false),
+			map[string]any{"plan": "free"},
+		),
+		claimscache.NewInMemoryStore(),
+		time.Hour,
+		slog.Default(),
+	)
+
+	for range 2 {
+		claims, err := cache.GetClaims(t.Context(), "user-id")
+		if !errors.Is(err, claimscache.ErrFallbackClaims) {
+			t.Fatalf("expected fallback error, got %v", err)
+		}
+
+		if diff := cmp.Diff(map[string]any{"plan": "free"}, claims); diff != "" {
+			t.Errorf("unexpected claims (-want +got):\n%s", diff)
+		}
+	}
+
+	if got := requests.Load(); got != 2 { //nolint:mnd
+		t.Errorf("expected the hook to be called twice, got %d", got)
+	}
+}
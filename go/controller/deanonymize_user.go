@@ -47,7 +47,7 @@ func (ctrl *Controller) postUserDeanonymizeValidateRequest( //nolint:cyclop
 	}
 
 	options, apiErr := ctrl.wf.ValidateSignUpOptions(
-		request.Body.Options, string(request.Body.Email), logger,
+		ctx, request.Body.Options, string(request.Body.Email), logger,
 	)
 	if apiErr != nil {
 		return uuid.UUID{}, "", nil, apiErr
@@ -76,6 +76,10 @@ func (ctrl *Controller) DeanonymizeUser( //nolint:funlen
 	logger := middleware.LoggerFromContext(ctx).
 		With(slog.String("email", string(request.Body.Email)))
 
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
 	userID, password, options, apiError := ctrl.postUserDeanonymizeValidateRequest(
 		ctx, request, logger,
 	)
@@ -485,6 +485,31 @@ func TestDeanonymizeUser(t *testing.T) { //nolint:maintidx
 			expectedJWT:       nil,
 			getControllerOpts: []getControllerOptsFunc{},
 		},
+
+		{
+			name:   "impersonated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			jwtTokenFn: impersonated(jwtTokenFn),
+			request: api.DeanonymizeUserRequestObject{
+				Body: &api.UserDeanonymizeRequest{
+					Connection:   nil,
+					Email:        "jane@acme.com",
+					Options:      nil,
+					Password:     ptr("password"),
+					SignInMethod: "email-password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-impersonation",
+				Message: "Forbidden, action not allowed while impersonating a user.",
+				Status:  403,
+			},
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
 	}
 
 	for _, tc := range cases {
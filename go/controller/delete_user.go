@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+func (ctrl *Controller) DeleteUser( //nolint:ireturn
+	ctx context.Context, _ api.DeleteUserRequestObject,
+) (api.DeleteUserResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	// recorded before deleting so the IP address and user agent are
+	// anonymized together with the rest of the user's audit events
+	ctrl.wf.RecordAuditEvent(ctx, user.ID, api.UserDeleted, nil, logger)
+
+	// providers, security keys, roles, refresh tokens and organization
+	// memberships are removed by the ON DELETE CASCADE foreign keys while
+	// audit events and impersonations are kept but anonymized
+	if err := ctrl.wf.db.DeleteUser(ctx, user.ID); err != nil {
+		logger.Error("error deleting user", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	ctrl.wf.EmitUserWebhookEvent(ctx, webhooks.EventUserDeleted, user.ID, user.Email.String, logger)
+
+	logger.Info("user deleted")
+
+	return api.DeleteUser200JSONResponse(api.OK), nil
+}
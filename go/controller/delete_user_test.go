@@ -0,0 +1,193 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteUser(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	jwtTokenFn := func() *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     []any{"user", "me"},
+					"x-hasura-default-role":      "user",
+					"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+					"x-hasura-user-is-anonymous": "false",
+					"x-hasura-auth-elevated":     "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	cases := []testRequest[api.DeleteUserRequestObject, api.DeleteUserResponseObject]{
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DeleteUser(gomock.Any(), userID).Return(nil)
+
+				return mock
+			},
+			request:           api.DeleteUserRequestObject{},
+			expectedResponse:  api.DeleteUser200JSONResponse(api.OK),
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "with audit log and webhook subscription",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.AuditLogEnabled = true
+				c.WebhookSubscriptions = []webhooks.Subscription{
+					{
+						URL:    "https://example.com/hooks",
+						Events: []webhooks.Event{webhooks.EventUserDeleted},
+						Secret: "secret",
+					},
+				}
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				// the event is recorded before deleting the user so it is
+				// anonymized along with the rest of the audit events
+				gomock.InOrder(
+					mock.EXPECT().InsertAuditEvent(
+						gomock.Any(),
+						sql.InsertAuditEventParams{
+							UserID:    userID,
+							Event:     "user-deleted",
+							IpAddress: pgtype.Text{}, //nolint:exhaustruct
+							UserAgent: pgtype.Text{}, //nolint:exhaustruct
+							TraceID:   pgtype.Text{}, //nolint:exhaustruct
+							Metadata:  nil,
+						},
+					).Return(nil),
+					mock.EXPECT().DeleteUser(gomock.Any(), userID).Return(nil),
+				)
+
+				mock.EXPECT().InsertWebhookOutbox(
+					gomock.Any(),
+					gomock.Cond(func(arg sql.InsertWebhookOutboxParams) bool {
+						var payload webhooks.Payload
+						if err := json.Unmarshal(arg.Payload, &payload); err != nil {
+							return false
+						}
+
+						return arg.Event == string(webhooks.EventUserDeleted) &&
+							arg.Url == "https://example.com/hooks" &&
+							payload.Data["userId"] == userID.String() &&
+							payload.Data["email"] == "jane@acme.com"
+					}),
+				).Return(nil)
+
+				return mock
+			},
+			request:           api.DeleteUserRequestObject{},
+			expectedResponse:  api.DeleteUser200JSONResponse(api.OK),
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "db error",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DeleteUser(
+					gomock.Any(), userID,
+				).Return(errors.New("database error")) //nolint:goerr113
+
+				return mock
+			},
+			request: api.DeleteUserRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "internal-server-error",
+				Message: "Internal server error",
+				Status:  500,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "impersonated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.DeleteUserRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-impersonation",
+				Message: "Forbidden, action not allowed while impersonating a user.",
+				Status:  403,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        impersonated(jwtTokenFn),
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			ctx := jwtGetter.ToContext(t.Context(), tc.jwtTokenFn())
+
+			assertRequest(ctx, t, c.DeleteUser, tc.request, tc.expectedResponse)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/metrics"
 )
 
 type APIError struct {
@@ -62,6 +63,16 @@ var (
 	ErrOauthProfileFetchFailed         = &APIError{api.OauthProfileFetchFailed}
 	ErrOauthProviderError              = &APIError{api.OauthProviderError}
 	ErrCannotSendSMS                   = &APIError{api.CannotSendSms}
+	ErrHookRejected                    = &APIError{api.HookRejected}
+	ErrForbiddenOrganization           = &APIError{api.ForbiddenOrganization}
+	ErrOrganizationSlugInUse           = &APIError{api.OrganizationSlugInUse}
+	ErrInvalidInvitation               = &APIError{api.InvalidInvitation}
+	ErrPhoneNumberAlreadyInUse         = &APIError{api.PhoneNumberAlreadyInUse}
+	ErrInvalidPhoneNumberPassword      = &APIError{api.InvalidPhoneNumberPassword}
+	ErrInvalidPhoneNumber              = &APIError{api.InvalidPhoneNumber}
+	ErrPhoneNumberNotAllowed           = &APIError{api.PhoneNumberNotAllowed}
+	ErrForbiddenImpersonation          = &APIError{api.ForbiddenImpersonation}
+	ErrTooManyRequests                 = &APIError{api.TooManyRequests}
 )
 
 func logError(err error) slog.Attr {
@@ -71,6 +82,7 @@ func logError(err error) slog.Attr {
 type ErrorResponse api.ErrorResponse
 
 func (response ErrorResponse) visit(w http.ResponseWriter) error {
+	metrics.Error(string(response.Error))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.Status)
 	return json.NewEncoder(w).Encode(response) //nolint:wrapcheck
@@ -88,10 +100,38 @@ func (response ErrorResponse) VisitVerifySignInMfaTotpResponse(w http.ResponseWr
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitVerifySignInMfaOtpResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitSignUpPhonePasswordResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitVerifySignUpPhonePasswordResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitSignInPhonePasswordResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitSendPasswordResetSmsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitVerifyPasswordResetSmsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
 func (response ErrorResponse) VisitVerifyChangeUserMfaResponse(w http.ResponseWriter) error {
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitSendUserMfaOtpResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
 func (response ErrorResponse) VisitChangeUserMfaResponse(w http.ResponseWriter) error {
 	return response.visit(w)
 }
@@ -222,6 +262,62 @@ func (response ErrorResponse) VisitGetUserResponse(w http.ResponseWriter) error
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitGetUserAuditEventsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitExportUserResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitImpersonateUserResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitInviteUserResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPurgeCustomClaimsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetOrganizationsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitCreateOrganizationInvitationResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetUserOrganizationInvitationsResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitAcceptOrganizationInvitationResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitRefreshTokenOrganizationResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
 func (response ErrorResponse) VisitVerifySignInPasswordlessSmsResponse(
 	w http.ResponseWriter,
 ) error {
@@ -245,7 +341,12 @@ func isSensitive(err api.ErrorResponseError) bool {
 		api.DisabledMfaTotp,
 		api.InvalidTotp,
 		api.InvalidOtp,
-		api.NoTotpSecret:
+		api.NoTotpSecret,
+		api.HookRejected,
+		api.ForbiddenOrganization,
+		api.InvalidInvitation,
+		api.PhoneNumberAlreadyInUse,
+		api.InvalidPhoneNumberPassword:
 		return true
 	case
 		api.DefaultRoleMustBeInAllowedRoles,
@@ -263,7 +364,10 @@ func isSensitive(err api.ErrorResponseError) bool {
 		api.OauthTokenEchangeFailed,
 		api.OauthProfileFetchFailed,
 		api.CannotSendSms,
-		api.OauthProviderError:
+		api.OauthProviderError,
+		api.OrganizationSlugInUse,
+		api.InvalidPhoneNumber,
+		api.PhoneNumberNotAllowed:
 		return false
 	}
 	return false
@@ -462,6 +566,66 @@ func (ctrl *Controller) getError(err *APIError) ErrorResponse { //nolint:gocyclo
 			Error:   err.t,
 			Message: "Invalid or expired OTP",
 		}
+	case api.HookRejected:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "The request was rejected",
+		}
+	case api.ForbiddenOrganization:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "User is not allowed to access this organization",
+		}
+	case api.OrganizationSlugInUse:
+		return ErrorResponse{
+			Status:  http.StatusConflict,
+			Error:   err.t,
+			Message: "Organization slug already in use",
+		}
+	case api.InvalidInvitation:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid or expired invitation",
+		}
+	case api.PhoneNumberAlreadyInUse:
+		return ErrorResponse{
+			Status:  http.StatusConflict,
+			Error:   err.t,
+			Message: "Phone number already in use",
+		}
+	case api.InvalidPhoneNumberPassword:
+		return ErrorResponse{
+			Status:  http.StatusUnauthorized,
+			Error:   err.t,
+			Message: "Incorrect phone number or password",
+		}
+	case api.InvalidPhoneNumber:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid phone number",
+		}
+	case api.PhoneNumberNotAllowed:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "Phone number not allowed",
+		}
+	case api.ForbiddenImpersonation:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "Forbidden, action not allowed while impersonating a user.",
+		}
+	case api.TooManyRequests:
+		return ErrorResponse{
+			Status:  http.StatusTooManyRequests,
+			Error:   err.t,
+			Message: "Too many requests, please try again later",
+		}
 	}
 
 	return invalidRequest
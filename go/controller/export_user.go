@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// auditEventsExportPageSize is how many audit events are read at a time while
+// exporting a user so long audit logs don't need a single huge query.
+const auditEventsExportPageSize = 500
+
+// ExportUser returns everything stored about the user. Secrets like password
+// hashes, TOTP secrets, tickets, provider tokens and refresh token hashes are
+// deliberately left out.
+func (ctrl *Controller) ExportUser( //nolint:ireturn,funlen
+	ctx context.Context, _ api.ExportUserRequestObject,
+) (api.ExportUserResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	userRoles, err := ctrl.wf.db.GetUserRoles(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user roles", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+	roles := make([]string, len(userRoles))
+	for i, role := range userRoles {
+		roles[i] = role.Role
+	}
+
+	userProviders, err := ctrl.wf.db.GetUserProviders(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user providers", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+	providers := make([]api.UserExportProvider, len(userProviders))
+	for i, p := range userProviders {
+		providers[i] = api.UserExportProvider{
+			Id:             p.ID,
+			CreatedAt:      p.CreatedAt.Time,
+			Provider:       p.ProviderID,
+			ProviderUserId: p.ProviderUserID,
+		}
+	}
+
+	keys, err := ctrl.wf.db.GetSecurityKeys(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting security keys", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+	securityKeys := make([]api.UserExportSecurityKey, len(keys))
+	for i, k := range keys {
+		securityKeys[i] = api.UserExportSecurityKey{
+			Id:           k.ID,
+			CredentialId: k.CredentialID,
+			Nickname:     sql.ToPointerString(k.Nickname),
+		}
+	}
+
+	tokens, err := ctrl.wf.db.GetUserRefreshTokens(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting refresh tokens", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+	refreshTokens := make([]api.UserExportRefreshToken, len(tokens))
+	for i, t := range tokens {
+		refreshTokens[i] = api.UserExportRefreshToken{
+			Id:        t.ID,
+			CreatedAt: t.CreatedAt.Time,
+			ExpiresAt: t.ExpiresAt.Time,
+			Type:      string(t.Type),
+		}
+	}
+
+	orgs, err := ctrl.wf.db.GetUserOrganizations(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user organizations", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+	organizations := make([]api.Organization, len(orgs))
+	for i, o := range orgs {
+		organizations[i] = api.Organization{
+			Id:        o.ID,
+			Name:      o.Name,
+			Slug:      o.Slug,
+			CreatedAt: o.CreatedAt.Time,
+			Roles:     o.Roles,
+		}
+	}
+
+	events, err := ctrl.getAllUserAuditEvents(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting audit events", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+	auditEvents, err := auditEventsToAPI(events)
+	if err != nil {
+		logger.Error("error unmarshalling audit event metadata", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	userImpersonations, err := ctrl.wf.db.GetUserImpersonations(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user impersonations", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+	impersonations := make([]api.UserExportImpersonation, len(userImpersonations))
+	for i, imp := range userImpersonations {
+		impersonations[i] = api.UserExportImpersonation{
+			Id:           imp.ID,
+			CreatedAt:    imp.CreatedAt.Time,
+			Impersonator: imp.Impersonator,
+			Reason:       imp.Reason,
+		}
+	}
+
+	return api.ExportUser200JSONResponse{
+		User:           *sqlUserToAPIUser(user, roles, logger),
+		Providers:      providers,
+		SecurityKeys:   securityKeys,
+		RefreshTokens:  refreshTokens,
+		Organizations:  organizations,
+		AuditEvents:    auditEvents,
+		Impersonations: impersonations,
+	}, nil
+}
+
+func (ctrl *Controller) getAllUserAuditEvents(
+	ctx context.Context, userID uuid.UUID,
+) ([]sql.AuthAuditEvent, error) {
+	var events []sql.AuthAuditEvent
+	for offset := int32(0); ; offset += auditEventsExportPageSize {
+		page, err := ctrl.wf.db.GetUserAuditEvents(ctx, sql.GetUserAuditEventsParams{
+			UserID: userID,
+			Limit:  auditEventsExportPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting audit events page: %w", err)
+		}
+
+		events = append(events, page...)
+		if len(page) < auditEventsExportPageSize {
+			return events, nil
+		}
+	}
+}
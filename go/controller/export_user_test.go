@@ -0,0 +1,344 @@
+package controller_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportUser(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	providerID := uuid.MustParse("a1f0c2b6-3f3e-4c59-9a4c-1f0b5f2c2b11")
+	keyID := uuid.MustParse("0e2b8c1a-5d1b-4a8e-8c34-7b6f0a9d1e22")
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	organizationID := uuid.MustParse("5b7c9d1e-2f3a-4b5c-8d6e-7f8a9b0c1d33")
+	eventID := uuid.MustParse("8f3b3a2b-0c5e-4a8e-9f39-3b0f1d2e5c6a")
+	impersonationID := uuid.MustParse("2d4f6a8c-1b3d-4e5f-9a7b-c9d1e3f5a744")
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	jwtTokenFn := func() *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     []any{"user", "me"},
+					"x-hasura-default-role":      "user",
+					"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+					"x-hasura-user-is-anonymous": "false",
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	sqlEvents := func(n int) []sql.AuthAuditEvent {
+		events := make([]sql.AuthAuditEvent, n)
+		for i := range events {
+			events[i] = sql.AuthAuditEvent{
+				ID:        eventID,
+				CreatedAt: sql.TimestampTz(createdAt),
+				UserID:    userID,
+				Event:     "signin",
+				IpAddress: pgtype.Text{}, //nolint:exhaustruct
+				UserAgent: pgtype.Text{}, //nolint:exhaustruct
+				TraceID:   pgtype.Text{}, //nolint:exhaustruct
+				Metadata:  nil,
+			}
+		}
+		return events
+	}
+
+	apiEvents := func(n int) []api.AuditEvent {
+		events := make([]api.AuditEvent, n)
+		for i := range events {
+			events[i] = api.AuditEvent{
+				Id:        eventID,
+				CreatedAt: createdAt,
+				Event:     api.Signin,
+				IpAddress: nil,
+				UserAgent: nil,
+				TraceId:   nil,
+				Metadata:  nil,
+			}
+		}
+		return events
+	}
+
+	cases := []testRequest[api.ExportUserRequestObject, api.ExportUserResponseObject]{
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.CreatedAt = sql.TimestampTz(createdAt)
+				user.TotpSecret = sql.Text("secret")
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return([]sql.AuthUserRole{
+					{Role: "user"}, //nolint:exhaustruct
+					{Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().GetUserProviders(gomock.Any(), userID).Return(
+					[]sql.AuthUserProvider{
+						{
+							ID:             providerID,
+							CreatedAt:      sql.TimestampTz(createdAt),
+							UpdatedAt:      sql.TimestampTz(createdAt),
+							UserID:         userID,
+							AccessToken:    "access-token",
+							RefreshToken:   sql.Text("refresh-token"),
+							ProviderID:     "github",
+							ProviderUserID: "1234",
+						},
+					}, nil)
+
+				mock.EXPECT().GetSecurityKeys(gomock.Any(), userID).Return(
+					[]sql.AuthUserSecurityKey{
+						{
+							ID:                  keyID,
+							UserID:              userID,
+							CredentialID:        "credential-id",
+							CredentialPublicKey: []byte("public-key"),
+							Counter:             1,
+							Transports:          "",
+							Nickname:            sql.Text("laptop"),
+						},
+					}, nil)
+
+				mock.EXPECT().GetUserRefreshTokens(gomock.Any(), userID).Return(
+					[]sql.GetUserRefreshTokensRow{
+						{
+							ID:        refreshTokenID,
+							CreatedAt: sql.TimestampTz(createdAt),
+							ExpiresAt: sql.TimestampTz(createdAt.Add(30 * 24 * time.Hour)),
+							Type:      sql.RefreshTokenTypeRegular,
+						},
+					}, nil)
+
+				mock.EXPECT().GetUserOrganizations(gomock.Any(), userID).Return(
+					[]sql.GetUserOrganizationsRow{
+						{
+							ID:        organizationID,
+							Name:      "Acme",
+							Slug:      "acme",
+							CreatedAt: sql.TimestampTz(createdAt),
+							Roles:     []string{"owner"},
+						},
+					}, nil)
+
+				mock.EXPECT().GetUserAuditEvents(
+					gomock.Any(),
+					sql.GetUserAuditEventsParams{
+						UserID: userID,
+						Limit:  500,
+						Offset: 0,
+					},
+				).Return([]sql.AuthAuditEvent{
+					{
+						ID:        eventID,
+						CreatedAt: sql.TimestampTz(createdAt),
+						UserID:    userID,
+						Event:     "signin",
+						IpAddress: pgtype.Text{}, //nolint:exhaustruct
+						UserAgent: pgtype.Text{}, //nolint:exhaustruct
+						TraceID:   pgtype.Text{}, //nolint:exhaustruct
+						Metadata:  nil,
+					},
+				}, nil)
+
+				mock.EXPECT().GetUserImpersonations(gomock.Any(), userID).Return(
+					[]sql.AuthUserImpersonation{
+						{
+							ID:           impersonationID,
+							CreatedAt:    sql.TimestampTz(createdAt),
+							UserID:       userID,
+							Impersonator: "support@acme.com",
+							Reason:       "ticket 1234",
+						},
+					}, nil)
+
+				return mock
+			},
+			request: api.ExportUserRequestObject{},
+			expectedResponse: api.ExportUser200JSONResponse{
+				User: api.User{
+					AvatarUrl:           "",
+					CreatedAt:           createdAt,
+					DefaultRole:         "user",
+					DisplayName:         "Jane Doe",
+					Email:               ptr(types.Email("jane@acme.com")),
+					EmailVerified:       true,
+					Id:                  userID.String(),
+					IsAnonymous:         false,
+					Locale:              "en",
+					Metadata:            map[string]any{},
+					PhoneNumber:         nil,
+					PhoneNumberVerified: false,
+					Roles:               []string{"user", "me"},
+					ActiveMfaType:       nil,
+				},
+				Providers: []api.UserExportProvider{
+					{
+						Id:             providerID,
+						CreatedAt:      createdAt,
+						Provider:       "github",
+						ProviderUserId: "1234",
+					},
+				},
+				SecurityKeys: []api.UserExportSecurityKey{
+					{
+						Id:           keyID,
+						CredentialId: "credential-id",
+						Nickname:     ptr("laptop"),
+					},
+				},
+				RefreshTokens: []api.UserExportRefreshToken{
+					{
+						Id:        refreshTokenID,
+						CreatedAt: createdAt,
+						ExpiresAt: createdAt.Add(30 * 24 * time.Hour),
+						Type:      "regular",
+					},
+				},
+				Organizations: []api.Organization{
+					{
+						Id:        organizationID,
+						Name:      "Acme",
+						Slug:      "acme",
+						CreatedAt: createdAt,
+						Roles:     []string{"owner"},
+					},
+				},
+				AuditEvents: []api.AuditEvent{
+					{
+						Id:        eventID,
+						CreatedAt: createdAt,
+						Event:     api.Signin,
+						IpAddress: nil,
+						UserAgent: nil,
+						TraceId:   nil,
+						Metadata:  nil,
+					},
+				},
+				Impersonations: []api.UserExportImpersonation{
+					{
+						Id:           impersonationID,
+						CreatedAt:    createdAt,
+						Impersonator: "support@acme.com",
+						Reason:       "ticket 1234",
+					},
+				},
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+		{
+			name:   "audit events are paginated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.CreatedAt = sql.TimestampTz(createdAt)
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return([]sql.AuthUserRole{
+					{Role: "user"}, //nolint:exhaustruct
+					{Role: "me"},   //nolint:exhaustruct
+				}, nil)
+				mock.EXPECT().GetUserProviders(gomock.Any(), userID).Return(nil, nil)
+				mock.EXPECT().GetSecurityKeys(gomock.Any(), userID).Return(nil, nil)
+				mock.EXPECT().GetUserRefreshTokens(gomock.Any(), userID).Return(nil, nil)
+				mock.EXPECT().GetUserOrganizations(gomock.Any(), userID).Return(nil, nil)
+
+				mock.EXPECT().GetUserAuditEvents(
+					gomock.Any(),
+					sql.GetUserAuditEventsParams{
+						UserID: userID,
+						Limit:  500,
+						Offset: 0,
+					},
+				).Return(sqlEvents(500), nil)
+				mock.EXPECT().GetUserAuditEvents(
+					gomock.Any(),
+					sql.GetUserAuditEventsParams{
+						UserID: userID,
+						Limit:  500,
+						Offset: 500,
+					},
+				).Return(sqlEvents(2), nil)
+
+				mock.EXPECT().GetUserImpersonations(gomock.Any(), userID).Return(nil, nil)
+
+				return mock
+			},
+			request: api.ExportUserRequestObject{},
+			expectedResponse: api.ExportUser200JSONResponse{
+				User: api.User{
+					AvatarUrl:           "",
+					CreatedAt:           createdAt,
+					DefaultRole:         "user",
+					DisplayName:         "Jane Doe",
+					Email:               ptr(types.Email("jane@acme.com")),
+					EmailVerified:       true,
+					Id:                  userID.String(),
+					IsAnonymous:         false,
+					Locale:              "en",
+					Metadata:            map[string]any{},
+					PhoneNumber:         nil,
+					PhoneNumberVerified: false,
+					Roles:               []string{"user", "me"},
+					ActiveMfaType:       nil,
+				},
+				Providers:      []api.UserExportProvider{},
+				SecurityKeys:   []api.UserExportSecurityKey{},
+				RefreshTokens:  []api.UserExportRefreshToken{},
+				Organizations:  []api.Organization{},
+				AuditEvents:    apiEvents(502),
+				Impersonations: []api.UserExportImpersonation{},
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			ctx := jwtGetter.ToContext(t.Context(), tc.jwtTokenFn())
+
+			assertRequest(ctx, t, c.ExportUser, tc.request, tc.expectedResponse)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetOrganizations( //nolint:ireturn
+	ctx context.Context, _ api.GetOrganizationsRequestObject,
+) (api.GetOrganizationsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	userID, apiErr := ctrl.wf.GetJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	orgs, err := ctrl.wf.db.GetUserOrganizations(ctx, userID)
+	if err != nil {
+		logger.Error("error getting user organizations", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	resp := make([]api.Organization, len(orgs))
+	for i, o := range orgs {
+		resp[i] = api.Organization{
+			Id:        o.ID,
+			Name:      o.Name,
+			Slug:      o.Slug,
+			CreatedAt: o.CreatedAt.Time,
+			Roles:     o.Roles,
+		}
+	}
+
+	return api.GetOrganizations200JSONResponse{
+		Organizations: resp,
+	}, nil
+}
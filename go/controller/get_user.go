@@ -3,9 +3,11 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"log/slog"
 
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
 	"github.com/oapi-codegen/runtime/types"
 )
 
@@ -33,6 +35,14 @@ func (ctrl *Controller) GetUser( //nolint:ireturn
 		roles[i] = role.Role
 	}
 
+	userData := sqlUserToAPIUser(user, roles, logger)
+
+	return api.GetUser200JSONResponse(*userData), nil
+}
+
+// sqlUserToAPIUser converts a user from the database to its API representation
+// (matching Node.js getUser response).
+func sqlUserToAPIUser(user sql.AuthUser, roles []string, logger *slog.Logger) *api.User {
 	// Parse metadata from JSON bytes
 	var metadata map[string]any
 	if err := json.Unmarshal(user.Metadata, &metadata); err != nil {
@@ -40,8 +50,7 @@ func (ctrl *Controller) GetUser( //nolint:ireturn
 		metadata = map[string]any{}
 	}
 
-	// Convert user data to API User format (matching Node.js getUser response)
-	userData := &api.User{
+	return &api.User{
 		Id:          user.ID.String(),
 		CreatedAt:   user.CreatedAt.Time,
 		DisplayName: user.DisplayName,
@@ -73,6 +82,4 @@ func (ctrl *Controller) GetUser( //nolint:ireturn
 		}(),
 		Roles: roles,
 	}
-
-	return api.GetUser200JSONResponse(*userData), nil
 }
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const defaultAuditEventsLimit = 20
+
+func (ctrl *Controller) GetUserAuditEvents( //nolint:ireturn
+	ctx context.Context, request api.GetUserAuditEventsRequestObject,
+) (api.GetUserAuditEventsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.AuditLogEnabled {
+		logger.Warn("audit log is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	userID, apiErr := ctrl.wf.GetJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	limit := defaultAuditEventsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	events, err := ctrl.wf.db.GetUserAuditEvents(ctx, sql.GetUserAuditEventsParams{
+		UserID: userID,
+		Limit:  int32(limit),                        //nolint:gosec
+		Offset: int32(deptr(request.Params.Offset)), //nolint:gosec
+	})
+	if err != nil {
+		logger.Error("error getting audit events", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	resp, err := auditEventsToAPI(events)
+	if err != nil {
+		logger.Error("error unmarshalling audit event metadata", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	return api.GetUserAuditEvents200JSONResponse{
+		Events: resp,
+	}, nil
+}
+
+func auditEventsToAPI(events []sql.AuthAuditEvent) ([]api.AuditEvent, error) {
+	resp := make([]api.AuditEvent, len(events))
+	for i, e := range events {
+		var metadata *map[string]any
+		if len(e.Metadata) > 0 {
+			if err := json.Unmarshal(e.Metadata, &metadata); err != nil {
+				return nil, fmt.Errorf("error unmarshalling metadata: %w", err)
+			}
+		}
+
+		resp[i] = api.AuditEvent{
+			Id:        e.ID,
+			CreatedAt: e.CreatedAt.Time,
+			Event:     api.AuditEventType(e.Event),
+			IpAddress: sql.ToPointerString(e.IpAddress),
+			UserAgent: sql.ToPointerString(e.UserAgent),
+			TraceId:   sql.ToPointerString(e.TraceID),
+			Metadata:  metadata,
+		}
+	}
+
+	return resp, nil
+}
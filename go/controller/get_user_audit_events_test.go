@@ -0,0 +1,175 @@
+package controller_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetUserAuditEvents(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	eventID := uuid.MustParse("8f3b3a2b-0c5e-4a8e-9f39-3b0f1d2e5c6a")
+	createdAt := time.Now()
+
+	jwtTokenFn := func() *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     []any{"user", "me"},
+					"x-hasura-default-role":      "user",
+					"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+					"x-hasura-user-is-anonymous": "false",
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	configEnabled := func() *controller.Config {
+		c := getConfig()
+		c.AuditLogEnabled = true
+		return c
+	}
+
+	cases := []testRequest[api.GetUserAuditEventsRequestObject, api.GetUserAuditEventsResponseObject]{
+		{
+			name:   "simple",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserAuditEvents(
+					gomock.Any(),
+					sql.GetUserAuditEventsParams{
+						UserID: userID,
+						Limit:  20,
+						Offset: 0,
+					},
+				).Return([]sql.AuthAuditEvent{
+					{
+						ID:        eventID,
+						CreatedAt: sql.TimestampTz(createdAt),
+						UserID:    userID,
+						Event:     "signin",
+						IpAddress: sql.Text("203.0.113.7"),
+						UserAgent: sql.Text("Mozilla/5.0"),
+						TraceID:   pgtype.Text{}, //nolint:exhaustruct
+						Metadata:  []byte(`{"method":"email-password"}`),
+					},
+				}, nil)
+
+				return mock
+			},
+			request: api.GetUserAuditEventsRequestObject{
+				Params: api.GetUserAuditEventsParams{
+					Limit:  nil,
+					Offset: nil,
+				},
+			},
+			expectedResponse: api.GetUserAuditEvents200JSONResponse{
+				Events: []api.AuditEvent{
+					{
+						Id:        eventID,
+						CreatedAt: createdAt,
+						Event:     api.Signin,
+						IpAddress: ptr("203.0.113.7"),
+						UserAgent: ptr("Mozilla/5.0"),
+						TraceId:   nil,
+						Metadata:  ptr(map[string]any{"method": "email-password"}),
+					},
+				},
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "pagination",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserAuditEvents(
+					gomock.Any(),
+					sql.GetUserAuditEventsParams{
+						UserID: userID,
+						Limit:  5,
+						Offset: 10,
+					},
+				).Return([]sql.AuthAuditEvent{}, nil)
+
+				return mock
+			},
+			request: api.GetUserAuditEventsRequestObject{
+				Params: api.GetUserAuditEventsParams{
+					Limit:  ptr(5),
+					Offset: ptr(10),
+				},
+			},
+			expectedResponse: api.GetUserAuditEvents200JSONResponse{
+				Events: []api.AuditEvent{},
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "audit log disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.GetUserAuditEventsRequestObject{
+				Params: api.GetUserAuditEventsParams{
+					Limit:  nil,
+					Offset: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        jwtTokenFn,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			ctx := jwtGetter.ToContext(t.Context(), tc.jwtTokenFn())
+
+			assertRequest(ctx, t, c.GetUserAuditEvents, tc.request, tc.expectedResponse)
+		})
+	}
+}
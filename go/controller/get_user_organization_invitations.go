@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetUserOrganizationInvitations( //nolint:ireturn
+	ctx context.Context, _ api.GetUserOrganizationInvitationsRequestObject,
+) (api.GetUserOrganizationInvitationsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if !user.Email.Valid {
+		return api.GetUserOrganizationInvitations200JSONResponse{
+			Invitations: []api.OrganizationInvitation{},
+		}, nil
+	}
+
+	invitations, err := ctrl.wf.db.GetUserOrganizationInvitations(
+		ctx, strings.ToLower(user.Email.String),
+	)
+	if err != nil {
+		logger.Error("error getting organization invitations", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	resp := make([]api.OrganizationInvitation, len(invitations))
+	for i, inv := range invitations {
+		resp[i] = api.OrganizationInvitation{
+			Id:               inv.ID,
+			OrganizationId:   inv.OrganizationID,
+			OrganizationName: &inv.OrganizationName,
+			Roles:            inv.Roles,
+			ExpiresAt:        inv.ExpiresAt.Time,
+		}
+	}
+
+	return api.GetUserOrganizationInvitations200JSONResponse{
+		Invitations: resp,
+	}, nil
+}
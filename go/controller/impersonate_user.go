@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const impersonatorClaim = "x-hasura-auth-impersonator"
+
+func (ctrl *Controller) ImpersonateUser( //nolint:ireturn
+	ctx context.Context,
+	request api.ImpersonateUserRequestObject,
+) (api.ImpersonateUserResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).With(
+		slog.String("userId", request.Body.UserId.String()),
+		slog.String("impersonator", request.Body.Impersonator),
+	)
+
+	if !ctrl.config.ImpersonationEnabled {
+		logger.Warn("impersonation is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, err := ctrl.wf.db.GetUser(ctx, request.Body.UserId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("user not found")
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+	if err != nil {
+		logger.Error("error getting user", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if user.Disabled {
+		logger.Warn("user is disabled")
+		return ctrl.sendError(ErrDisabledUser), nil
+	}
+
+	// the audit entry is mandatory, if we can't record it we don't issue the session
+	if _, err := ctrl.wf.db.InsertUserImpersonation(
+		ctx, sql.InsertUserImpersonationParams{
+			UserID:       user.ID,
+			Impersonator: request.Body.Impersonator,
+			Reason:       request.Body.Reason,
+		},
+	); err != nil {
+		logger.Error("error inserting impersonation audit entry", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	session, err := ctrl.wf.NewSession(
+		ctx,
+		user,
+		map[string]any{impersonatorClaim: request.Body.Impersonator},
+		logger,
+		SessionWithoutRefreshToken(),
+		SessionSkipLastSeen(),
+	)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(getTokenError(err)), nil
+	}
+
+	logger.Info("user impersonated", slog.String("reason", request.Body.Reason))
+
+	return api.ImpersonateUser200JSONResponse{
+		Session: session,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// InviteUser creates an account without credentials and emails the user a link
+// to accept the invitation. Following the link verifies the email and signs the
+// user in so they can set a password or register a passkey.
+func (ctrl *Controller) InviteUser( //nolint:ireturn
+	ctx context.Context,
+	request api.InviteUserRequestObject,
+) (api.InviteUserResponseObject, error) {
+	email := string(request.Body.Email)
+	logger := middleware.LoggerFromContext(ctx).With(slog.String("email", email))
+
+	if apiErr := ctrl.wf.ValidateSignupEmail(request.Body.Email, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	options, apiErr := ctrl.wf.ValidateSignUpOptions(ctx, request.Body.Options, email, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	metadata, err := json.Marshal(options.Metadata)
+	if err != nil {
+		logger.Error("error marshaling metadata", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	ticket := generateTicket(TicketTypeUserInvite)
+	resp, err := ctrl.wf.db.InsertUser(ctx, sql.InsertUserParams{
+		ID:                uuid.New(),
+		Disabled:          false,
+		DisplayName:       deptr(options.DisplayName),
+		AvatarUrl:         ctrl.wf.gravatarURL(email),
+		Email:             sql.Text(email),
+		PasswordHash:      pgtype.Text{}, //nolint:exhaustruct
+		Ticket:            sql.Text(ticket),
+		TicketExpiresAt:   sql.TimestampTz(time.Now().Add(InAMonth)),
+		EmailVerified:     false,
+		Locale:            deptr(options.Locale),
+		DefaultRole:       deptr(options.DefaultRole),
+		Metadata:          metadata,
+		Roles:             deptr(options.AllowedRoles),
+		PhoneNumber:       pgtype.Text{},        //nolint:exhaustruct
+		OtpHash:           pgtype.Text{},        //nolint:exhaustruct
+		OtpHashExpiresAt:  pgtype.Timestamptz{}, //nolint:exhaustruct
+		OtpMethodLastUsed: pgtype.Text{},        //nolint:exhaustruct
+	})
+	if err != nil {
+		return ctrl.sendError(sqlErrIsDuplicatedEmail(err, logger)), nil
+	}
+
+	metrics.SignUp(ctx)
+	ctrl.wf.EmitUserWebhookEvent(ctx, webhooks.EventUserSignedUp, resp.UserID, email, logger)
+
+	if apiErr := ctrl.wf.SendEmail(
+		ctx,
+		email,
+		deptr(options.Locale),
+		LinkTypeUserInvite,
+		ticket,
+		deptr(options.RedirectTo),
+		notifications.TemplateNameUserInvite,
+		deptr(options.DisplayName),
+		email,
+		"",
+		logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	logger.Info("user invited", slog.String("userId", resp.UserID.String()))
+
+	return api.InviteUser200JSONResponse{
+		UserId: resp.UserID,
+	}, nil
+}
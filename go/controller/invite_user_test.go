@@ -0,0 +1,178 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInviteUser(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("DB477732-48FA-4289-B694-2886A646B6EB")
+
+	cases := []testRequest[api.InviteUserRequestObject, api.InviteUserResponseObject]{
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUser(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserParams{
+						ID:                uuid.UUID{},
+						Disabled:          false,
+						DisplayName:       "Jane Doe",
+						AvatarUrl:         "",
+						Email:             sql.Text("jane@acme.com"),
+						PasswordHash:      pgtype.Text{}, //nolint:exhaustruct
+						Ticket:            sql.Text("userInvite:xxxx"),
+						TicketExpiresAt:   sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						EmailVerified:     false,
+						Locale:            "en",
+						DefaultRole:       "user",
+						Metadata:          []byte(`{"team":"support"}`),
+						Roles:             []string{"user", "me"},
+						PhoneNumber:       pgtype.Text{},        //nolint:exhaustruct
+						OtpHash:           pgtype.Text{},        //nolint:exhaustruct
+						OtpHashExpiresAt:  pgtype.Timestamptz{}, //nolint:exhaustruct
+						OtpMethodLastUsed: pgtype.Text{},        //nolint:exhaustruct
+					},
+						cmpopts.IgnoreFields(sql.InsertUserParams{}, "ID"), //nolint:exhaustruct
+					),
+				).Return(sql.InsertUserRow{
+					UserID:    userID,
+					CreatedAt: sql.TimestampTz(time.Now()),
+				}, nil)
+
+				return mock
+			},
+			request: api.InviteUserRequestObject{
+				Body: &api.InviteUserRequest{
+					Email: "jane@acme.com",
+					Options: &api.SignUpOptions{ //nolint:exhaustruct
+						DisplayName: ptr("Jane Doe"),
+						Metadata:    ptr(map[string]any{"team": "support"}),
+					},
+				},
+			},
+			expectedResponse: api.InviteUser200JSONResponse{
+				UserId: userID,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withEmailer(func(ctrl *gomock.Controller) *mock.MockEmailer {
+					mock := mock.NewMockEmailer(ctrl)
+
+					mock.EXPECT().SendEmail(
+						gomock.Any(),
+						"jane@acme.com",
+						"en",
+						notifications.TemplateNameUserInvite,
+						testhelpers.GomockCmpOpts(
+							notifications.TemplateData{
+								Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=userInvite%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=userInvite", //nolint:lll
+								DisplayName: "Jane Doe",
+								Email:       "jane@acme.com",
+								NewEmail:    "",
+								Ticket:      "userInvite:xxx",
+								RedirectTo:  "http://localhost:3000",
+								Locale:      "en",
+								ServerURL:   "https://local.auth.nhost.run",
+								ClientURL:   "http://localhost:3000",
+							},
+							testhelpers.FilterPathLast(
+								[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+							testhelpers.FilterPathLast(
+								[]string{".Link"}, cmp.Comparer(cmpLink)),
+						)).Return(nil)
+
+					return mock
+				}),
+			},
+		},
+
+		{
+			name:   "email already in use",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUser(
+					gomock.Any(),
+					gomock.Any(),
+				).Return(
+					sql.InsertUserRow{}, //nolint:exhaustruct
+					errors.New(`ERROR: duplicate key value violates unique constraint "users_email_key" (SQLSTATE 23505)`), //nolint:goerr113,lll
+				)
+
+				return mock
+			},
+			request: api.InviteUserRequestObject{
+				Body: &api.InviteUserRequest{
+					Email:   "jane@acme.com",
+					Options: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "email-already-in-use",
+				Message: "Email already in use",
+				Status:  409,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "role not allowed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.InviteUserRequestObject{
+				Body: &api.InviteUserRequest{
+					Email: "jane@acme.com",
+					Options: &api.SignUpOptions{ //nolint:exhaustruct
+						AllowedRoles: &[]string{"admin"},
+					},
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-not-allowed",
+				Message: "Role not allowed",
+				Status:  400,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			assertRequest(t.Context(), t, c.InviteUser, tc.request, tc.expectedResponse)
+		})
+	}
+}
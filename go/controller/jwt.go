@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +18,9 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/claimscache"
+	"github.com/nhost/hasura-auth/go/hooks"
+	"github.com/nhost/hasura-auth/go/metrics"
 	ginmiddleware "github.com/oapi-codegen/gin-middleware"
 )
 
@@ -120,6 +124,39 @@ type CustomClaimer interface {
 	GetClaims(ctx context.Context, userID string) (map[string]any, error)
 }
 
+// CustomClaimsInvalidator is implemented by custom claimers that cache the
+// claims of users.
+type CustomClaimsInvalidator interface {
+	Invalidate(ctx context.Context, userID string) error
+}
+
+// claims that hooks are not allowed to modify.
+var defaultClaims = []string{ //nolint:gochecknoglobals
+	"x-hasura-allowed-roles",
+	"x-hasura-default-role",
+	"x-hasura-user-id",
+	"x-hasura-user-is-anonymous",
+	impersonatorClaim,
+	claimOrganizationID,
+	claimOrganizationAllowedRoles,
+}
+
+type BeforeTokenHook interface {
+	BeforeToken(
+		ctx context.Context, req hooks.BeforeTokenRequest, logger *slog.Logger,
+	) (hooks.BeforeTokenResponse, error)
+}
+
+type JWTGetterOption func(*JWTGetter)
+
+// JWTGetterWithBeforeTokenHook calls hook before signing access tokens so it can
+// add or remove claims or deny the token.
+func JWTGetterWithBeforeTokenHook(hook BeforeTokenHook) JWTGetterOption {
+	return func(j *JWTGetter) {
+		j.beforeTokenHook = hook
+	}
+}
+
 type JWTGetter struct {
 	claimsNamespace      string
 	issuer               string
@@ -132,6 +169,7 @@ type JWTGetter struct {
 	elevatedClaimMode    string
 	db                   DBClient
 	jwks                 []api.JWK
+	beforeTokenHook      BeforeTokenHook
 }
 
 func NewJWTGetter(
@@ -140,6 +178,7 @@ func NewJWTGetter(
 	customClaimer CustomClaimer,
 	elevatedClaimMode string,
 	db DBClient,
+	opts ...JWTGetterOption,
 ) (*JWTGetter, error) {
 	jwtSecret, jwks, err := decodeJWTSecret(jwtSecretb)
 	if err != nil {
@@ -148,7 +187,7 @@ func NewJWTGetter(
 
 	method := jwt.GetSigningMethod(jwtSecret.Type)
 
-	return &JWTGetter{
+	j := &JWTGetter{
 		claimsNamespace:      jwtSecret.ClaimsNamespace,
 		issuer:               jwtSecret.Issuer,
 		signingKey:           jwtSecret.SigningKey,
@@ -160,7 +199,14 @@ func NewJWTGetter(
 		elevatedClaimMode:    elevatedClaimMode,
 		db:                   db,
 		jwks:                 jwks,
-	}, nil
+		beforeTokenHook:      nil,
+	}
+
+	for _, opt := range opts {
+		opt(j)
+	}
+
+	return j, nil
 }
 
 func pgEncode(v any) (string, error) {
@@ -235,8 +281,23 @@ func (j *JWTGetter) GetToken(
 	var customClaims map[string]any
 	var err error
 	if j.customClaimer != nil {
-		customClaims, err = j.customClaimer.GetClaims(ctx, userID.String())
-		if err != nil {
+		start := time.Now()
+		customClaims, err = j.customClaimer.GetClaims(
+			withCustomClaimsContext(ctx, hooks.CustomClaimsRequest{
+				UserID:       userID.String(),
+				IsAnonymous:  isAnonymous,
+				DefaultRole:  defaultRole,
+				AllowedRoles: allowedRoles,
+				IP:           "",
+				UserAgent:    "",
+			}, logger),
+			userID.String(),
+		)
+		metrics.ObserveCustomClaims(start, err)
+		switch {
+		case errors.Is(err, claimscache.ErrFallbackClaims):
+			logger.Warn("using fallback custom claims", slog.String("error", err.Error()))
+		case err != nil:
 			logger.Error("error getting custom claims", slog.String("error", err.Error()))
 			customClaims = map[string]any{}
 		}
@@ -257,6 +318,12 @@ func (j *JWTGetter) GetToken(
 		return "", 0, fmt.Errorf("error adding extra claims: %w", err)
 	}
 
+	if err := j.runBeforeTokenHook(
+		ctx, userID, isAnonymous, allowedRoles, defaultRole, c, logger,
+	); err != nil {
+		return "", 0, err
+	}
+
 	// Create the Claims
 	claims := &jwt.MapClaims{
 		"sub":             userID.String(),
@@ -277,6 +344,59 @@ func (j *JWTGetter) GetToken(
 	return ss, int64(j.accessTokenExpiresIn.Seconds()), nil
 }
 
+func (j *JWTGetter) runBeforeTokenHook(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAnonymous bool,
+	allowedRoles []string,
+	defaultRole string,
+	claims map[string]any,
+	logger *slog.Logger,
+) error {
+	if j.beforeTokenHook == nil {
+		return nil
+	}
+
+	resp, err := j.beforeTokenHook.BeforeToken(ctx, hooks.BeforeTokenRequest{
+		UserID:       userID.String(),
+		IsAnonymous:  isAnonymous,
+		DefaultRole:  defaultRole,
+		AllowedRoles: allowedRoles,
+		Claims:       claims,
+	}, logger)
+	if err != nil {
+		return fmt.Errorf("error calling before-token hook: %w", err)
+	}
+
+	for _, k := range resp.RemoveClaims {
+		if k = hasuraClaimName(k); !slices.Contains(defaultClaims, k) {
+			delete(claims, k)
+		}
+	}
+
+	newClaims := make(map[string]any, len(resp.Claims))
+	for k, v := range resp.Claims {
+		if k = hasuraClaimName(k); !slices.Contains(defaultClaims, k) {
+			newClaims[k] = v
+		}
+	}
+
+	if err := j.addClaimsToMap(claims, newClaims, true); err != nil {
+		return fmt.Errorf("error adding before-token hook claims: %w", err)
+	}
+
+	return nil
+}
+
+func hasuraClaimName(k string) string {
+	k = strings.ToLower(k)
+	if !strings.HasPrefix(k, "x-hasura-") {
+		k = "x-hasura-" + k
+	}
+
+	return k
+}
+
 func (j *JWTGetter) SignTokenWithClaims(
 	claims jwt.MapClaims,
 	exp time.Time,
@@ -405,6 +525,21 @@ func (j *JWTGetter) MiddlewareFunc(
 	return nil
 }
 
+// InvalidateCustomClaims removes the cached custom claims of the user, if any,
+// so changes to their roles or metadata are reflected in the next token.
+func (j *JWTGetter) InvalidateCustomClaims(ctx context.Context, userID uuid.UUID) error {
+	invalidator, ok := j.customClaimer.(CustomClaimsInvalidator)
+	if !ok {
+		return nil
+	}
+
+	if err := invalidator.Invalidate(ctx, userID.String()); err != nil {
+		return fmt.Errorf("error invalidating custom claims: %w", err)
+	}
+
+	return nil
+}
+
 func (j *JWTGetter) GetCustomClaim(token *jwt.Token, customClaim string) string {
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
@@ -427,6 +562,11 @@ func (j *JWTGetter) IsAnonymous(token *jwt.Token) bool {
 	return j.GetCustomClaim(token, "x-hasura-user-is-anonymous") == "true"
 }
 
+// IsImpersonated returns true if the token was issued by ImpersonateUser.
+func (j *JWTGetter) IsImpersonated(token *jwt.Token) bool {
+	return j.GetCustomClaim(token, impersonatorClaim) != ""
+}
+
 func (j *JWTGetter) GetUserID(token *jwt.Token) (uuid.UUID, error) {
 	userID, err := uuid.Parse(j.GetCustomClaim(token, "x-hasura-user-id"))
 	if err != nil {
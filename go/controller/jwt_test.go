@@ -17,6 +17,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/hooks"
 	ginmiddleware "github.com/oapi-codegen/gin-middleware"
 	"go.uber.org/mock/gomock"
 )
@@ -271,6 +272,158 @@ func TestGetJWTFunc(t *testing.T) {
 }
 
 //nolint:dupl
+func TestGetTokenBeforeTokenHook(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	userID := uuid.MustParse("585e21fc-3664-4d03-8539-69945342a4f4")
+
+	cases := []struct {
+		name           string
+		hook           func(ctrl *gomock.Controller) *mock.MockBeforeTokenHook
+		extraClaims    map[string]any
+		expectedClaims map[string]any
+		expectedErr    error
+	}{
+		{
+			name: "claims added and removed",
+			hook: func(ctrl *gomock.Controller) *mock.MockBeforeTokenHook {
+				mock := mock.NewMockBeforeTokenHook(ctrl)
+				mock.EXPECT().BeforeToken(
+					gomock.Any(),
+					hooks.BeforeTokenRequest{
+						UserID:       userID.String(),
+						IsAnonymous:  false,
+						DefaultRole:  "user",
+						AllowedRoles: []string{"user"},
+						Claims: map[string]any{
+							"x-hasura-allowed-roles":     []string{"user"},
+							"x-hasura-default-role":      "user",
+							"x-hasura-user-id":           userID.String(),
+							"x-hasura-user-is-anonymous": "false",
+							"x-hasura-plan":              "free",
+						},
+					},
+					gomock.Any(),
+				).Return(hooks.BeforeTokenResponse{
+					Reject: false,
+					Reason: "",
+					Claims: map[string]any{
+						"tenant":           "acme",
+						"x-hasura-user-id": "hooks-cannot-shadow-default-claims",
+					},
+					RemoveClaims: []string{"plan", "default-role"},
+				}, nil)
+				return mock
+			},
+			extraClaims: nil,
+			expectedClaims: map[string]any{
+				"x-hasura-allowed-roles":     []any{"user"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           userID.String(),
+				"x-hasura-user-is-anonymous": "false",
+				"x-hasura-tenant":            "acme",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "impersonation and organization claims are protected",
+			hook: func(ctrl *gomock.Controller) *mock.MockBeforeTokenHook {
+				mock := mock.NewMockBeforeTokenHook(ctrl)
+				mock.EXPECT().BeforeToken(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(hooks.BeforeTokenResponse{
+					Reject: false,
+					Reason: "",
+					Claims: map[string]any{
+						"org-id":            "acme",
+						"org-allowed-roles": []string{"owner"},
+						"x-hasura-plan":     "pro",
+						"x-hasura-org-id":   "acme",
+					},
+					RemoveClaims: []string{"auth-impersonator"},
+				}, nil)
+				return mock
+			},
+			extraClaims: map[string]any{
+				"x-hasura-auth-impersonator": "support@acme.com",
+			},
+			expectedClaims: map[string]any{
+				"x-hasura-allowed-roles":     []any{"user"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           userID.String(),
+				"x-hasura-user-is-anonymous": "false",
+				"x-hasura-plan":              "pro",
+				"x-hasura-auth-impersonator": "support@acme.com",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "rejected",
+			hook: func(ctrl *gomock.Controller) *mock.MockBeforeTokenHook {
+				mock := mock.NewMockBeforeTokenHook(ctrl)
+				mock.EXPECT().BeforeToken(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(hooks.BeforeTokenResponse{}, hooks.ErrRejected) //nolint:exhaustruct
+				return mock
+			},
+			extraClaims:    nil,
+			expectedClaims: nil,
+			expectedErr:    hooks.ErrRejected,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			customClaimer := mock.NewMockCustomClaimer(ctrl)
+			customClaimer.EXPECT().GetClaims(gomock.Any(), userID.String()).Return(
+				map[string]any{"plan": "free"}, nil,
+			)
+
+			jwtGetter, err := controller.NewJWTGetter(
+				jwtSecret,
+				time.Hour,
+				customClaimer,
+				"",
+				nil,
+				controller.JWTGetterWithBeforeTokenHook(tc.hook(ctrl)),
+			)
+			if err != nil {
+				t.Fatalf("failed to create jwt getter: %v", err)
+			}
+
+			accessToken, _, err := jwtGetter.GetToken(
+				t.Context(), userID, false, []string{"user"}, "user", tc.extraClaims, slog.Default(),
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			decodedToken, err := jwtGetter.Validate(accessToken)
+			if err != nil {
+				t.Fatalf("failed to validate token: %v", err)
+			}
+
+			claims, ok := decodedToken.Claims.(jwt.MapClaims)
+			if !ok {
+				t.Fatalf("unexpected claims type %T", decodedToken.Claims)
+			}
+
+			if diff := cmp.Diff(
+				tc.expectedClaims, claims["https://hasura.io/jwt/claims"],
+			); diff != "" {
+				t.Errorf("unexpected claims (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestMiddlewareFunc(t *testing.T) { //nolint:maintidx
 	t.Parallel()
 
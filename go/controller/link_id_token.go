@@ -12,6 +12,10 @@ func (ctrl *Controller) LinkIdToken( //nolint:ireturn,revive,stylecheck
 ) (api.LinkIdTokenResponseObject, error) {
 	logger := middleware.LoggerFromContext(ctx)
 
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
 	profile, apiErr := ctrl.wf.GetOIDCProfileFromIDToken(
 		req.Body.Provider,
 		req.Body.IdToken,
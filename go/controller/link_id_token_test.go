@@ -392,6 +392,31 @@ func TestLinkIdToken(t *testing.T) { //nolint:maintidx
 			expectedJWT: nil,
 			jwtTokenFn:  jwtTokenFn,
 		},
+
+		{
+			name:   "impersonated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			getControllerOpts: []getControllerOptsFunc{
+				withIDTokenValidatorProviders(getTestIDTokenValidatorProviders()),
+			},
+			request: api.LinkIdTokenRequestObject{
+				Body: &api.LinkIdTokenRequest{
+					IdToken:  token,
+					Nonce:    ptr(nonce),
+					Provider: "fake",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-impersonation",
+				Message: "Forbidden, action not allowed while impersonating a user.",
+				Status:  403,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  impersonated(jwtTokenFn),
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+type acceptLanguage struct {
+	tag string
+	q   float64
+}
+
+func parseAcceptLanguage(header string) []acceptLanguage {
+	parts := strings.Split(header, ",")
+	langs := make([]acceptLanguage, 0, len(parts))
+	for _, part := range parts {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(k) != "q" {
+				continue
+			}
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				f = 0
+			}
+			q = f
+		}
+
+		if q <= 0 {
+			continue
+		}
+
+		langs = append(langs, acceptLanguage{tag: tag, q: q})
+	}
+
+	sort.SliceStable(langs, func(i, j int) bool { return langs[i].q > langs[j].q })
+
+	return langs
+}
+
+// matchLocale returns the allowed locale that best matches locale following
+// its fallback chain (pt-BR → pt) or an empty string if none does.
+func matchLocale(locale string, allowed []string) string {
+	for _, l := range notifications.LocaleFallbacks(locale, "") {
+		if i := slices.IndexFunc(allowed, func(a string) bool {
+			return strings.EqualFold(a, l)
+		}); i >= 0 {
+			return allowed[i]
+		}
+	}
+
+	return ""
+}
+
+// negotiateLocale returns the allowed locale preferred by the user according
+// to the Accept-Language header or an empty string if none is acceptable.
+func negotiateLocale(header string, allowed []string) string {
+	for _, lang := range parseAcceptLanguage(header) {
+		if l := matchLocale(lang.tag, allowed); l != "" {
+			return l
+		}
+	}
+
+	return ""
+}
+
+// ResolveLocale returns the locale to store for a new user. If locale is set
+// the closest allowed locale is used, otherwise the locale is negotiated from
+// the Accept-Language header of the request. If neither works the default
+// locale is used.
+func (wf *Workflows) ResolveLocale(
+	ctx context.Context, locale *string, logger *slog.Logger,
+) string {
+	if locale != nil {
+		if l := matchLocale(*locale, wf.config.AllowedLocales); l != "" {
+			return l
+		}
+
+		logger.Warn("locale not allowed, using default", slog.String("locale", *locale))
+		return wf.config.DefaultLocale
+	}
+
+	if ginCtx, ok := ctx.(*gin.Context); ok && ginCtx.Request != nil {
+		header := ginCtx.Request.Header.Get("Accept-Language")
+		if l := negotiateLocale(header, wf.config.AllowedLocales); l != "" {
+			return l
+		}
+	}
+
+	return wf.config.DefaultLocale
+}
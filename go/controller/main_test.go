@@ -114,6 +114,17 @@ func cmpLink(x, y string) bool { //nolint:cyclop
 	return true
 }
 
+// impersonated adds the impersonator claim to the tokens returned by jwtTokenFn.
+func impersonated(jwtTokenFn func() *jwt.Token) func() *jwt.Token {
+	return func() *jwt.Token {
+		token := jwtTokenFn()
+		claims, _ := token.Claims.(jwt.MapClaims)
+		customClaims, _ := claims["https://hasura.io/jwt/claims"].(map[string]any)
+		customClaims["x-hasura-auth-impersonator"] = "support@acme.local"
+		return token
+	}
+}
+
 func cmpDBParams(
 	i any,
 	options ...cmp.Option,
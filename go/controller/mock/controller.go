@@ -243,6 +243,35 @@ func (mr *MockDBClientGetUserMockRecorder) GetUserByTicket(ctx, ticket any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByTicket", reflect.TypeOf((*MockDBClientGetUser)(nil).GetUserByTicket), ctx, ticket)
 }
 
+// GetUsersByPhoneNumberLegacy mocks base method.
+func (m *MockDBClientGetUser) GetUsersByPhoneNumberLegacy(ctx context.Context, digits string) ([]sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByPhoneNumberLegacy", ctx, digits)
+	ret0, _ := ret[0].([]sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByPhoneNumberLegacy indicates an expected call of GetUsersByPhoneNumberLegacy.
+func (mr *MockDBClientGetUserMockRecorder) GetUsersByPhoneNumberLegacy(ctx, digits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByPhoneNumberLegacy", reflect.TypeOf((*MockDBClientGetUser)(nil).GetUsersByPhoneNumberLegacy), ctx, digits)
+}
+
+// UpdateUserPhoneNumber mocks base method.
+func (m *MockDBClientGetUser) UpdateUserPhoneNumber(ctx context.Context, arg sql.UpdateUserPhoneNumberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPhoneNumber", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPhoneNumber indicates an expected call of UpdateUserPhoneNumber.
+func (mr *MockDBClientGetUserMockRecorder) UpdateUserPhoneNumber(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPhoneNumber", reflect.TypeOf((*MockDBClientGetUser)(nil).UpdateUserPhoneNumber), ctx, arg)
+}
+
 // MockDBClientInsertUser is a mock of DBClientInsertUser interface.
 type MockDBClientInsertUser struct {
 	ctrl     *gomock.Controller
@@ -336,6 +365,21 @@ func (m *MockDBClientUpdateUser) EXPECT() *MockDBClientUpdateUserMockRecorder {
 	return m.recorder
 }
 
+// IncrementUserOTPHashAttempts mocks base method.
+func (m *MockDBClientUpdateUser) IncrementUserOTPHashAttempts(ctx context.Context, arg sql.IncrementUserOTPHashAttemptsParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementUserOTPHashAttempts", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementUserOTPHashAttempts indicates an expected call of IncrementUserOTPHashAttempts.
+func (mr *MockDBClientUpdateUserMockRecorder) IncrementUserOTPHashAttempts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementUserOTPHashAttempts", reflect.TypeOf((*MockDBClientUpdateUser)(nil).IncrementUserOTPHashAttempts), ctx, arg)
+}
+
 // InsertSecurityKey mocks base method.
 func (m *MockDBClientUpdateUser) InsertSecurityKey(ctx context.Context, arg sql.InsertSecurityKeyParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -513,6 +557,21 @@ func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserVerifyEmail(ctx, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserVerifyEmail", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserVerifyEmail), ctx, id)
 }
 
+// UpdateUserVerifyPhoneNumber mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserVerifyPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserVerifyPhoneNumber", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserVerifyPhoneNumber indicates an expected call of UpdateUserVerifyPhoneNumber.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserVerifyPhoneNumber(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserVerifyPhoneNumber", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserVerifyPhoneNumber), ctx, id)
+}
+
 // MockDBClientUserProvider is a mock of DBClientUserProvider interface.
 type MockDBClientUserProvider struct {
 	ctrl     *gomock.Controller
@@ -612,6 +671,202 @@ func (mr *MockDBClientUserProviderMockRecorder) InsertUserWithUserProviderAndRef
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithUserProviderAndRefreshToken", reflect.TypeOf((*MockDBClientUserProvider)(nil).InsertUserWithUserProviderAndRefreshToken), ctx, arg)
 }
 
+// MockDBClientOrganization is a mock of DBClientOrganization interface.
+type MockDBClientOrganization struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBClientOrganizationMockRecorder
+	isgomock struct{}
+}
+
+// MockDBClientOrganizationMockRecorder is the mock recorder for MockDBClientOrganization.
+type MockDBClientOrganizationMockRecorder struct {
+	mock *MockDBClientOrganization
+}
+
+// NewMockDBClientOrganization creates a new mock instance.
+func NewMockDBClientOrganization(ctrl *gomock.Controller) *MockDBClientOrganization {
+	mock := &MockDBClientOrganization{ctrl: ctrl}
+	mock.recorder = &MockDBClientOrganizationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBClientOrganization) EXPECT() *MockDBClientOrganizationMockRecorder {
+	return m.recorder
+}
+
+// AcceptOrganizationInvitation mocks base method.
+func (m *MockDBClientOrganization) AcceptOrganizationInvitation(ctx context.Context, arg sql.AcceptOrganizationInvitationParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrganizationInvitation", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrganizationInvitation indicates an expected call of AcceptOrganizationInvitation.
+func (mr *MockDBClientOrganizationMockRecorder) AcceptOrganizationInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrganizationInvitation", reflect.TypeOf((*MockDBClientOrganization)(nil).AcceptOrganizationInvitation), ctx, arg)
+}
+
+// GetOrganizationMember mocks base method.
+func (m *MockDBClientOrganization) GetOrganizationMember(ctx context.Context, arg sql.GetOrganizationMemberParams) (sql.AuthOrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMember", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthOrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMember indicates an expected call of GetOrganizationMember.
+func (mr *MockDBClientOrganizationMockRecorder) GetOrganizationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMember", reflect.TypeOf((*MockDBClientOrganization)(nil).GetOrganizationMember), ctx, arg)
+}
+
+// GetUserOrganizationInvitations mocks base method.
+func (m *MockDBClientOrganization) GetUserOrganizationInvitations(ctx context.Context, email string) ([]sql.GetUserOrganizationInvitationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserOrganizationInvitations", ctx, email)
+	ret0, _ := ret[0].([]sql.GetUserOrganizationInvitationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserOrganizationInvitations indicates an expected call of GetUserOrganizationInvitations.
+func (mr *MockDBClientOrganizationMockRecorder) GetUserOrganizationInvitations(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserOrganizationInvitations", reflect.TypeOf((*MockDBClientOrganization)(nil).GetUserOrganizationInvitations), ctx, email)
+}
+
+// GetUserOrganizations mocks base method.
+func (m *MockDBClientOrganization) GetUserOrganizations(ctx context.Context, userID uuid.UUID) ([]sql.GetUserOrganizationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserOrganizations", ctx, userID)
+	ret0, _ := ret[0].([]sql.GetUserOrganizationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserOrganizations indicates an expected call of GetUserOrganizations.
+func (mr *MockDBClientOrganizationMockRecorder) GetUserOrganizations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserOrganizations", reflect.TypeOf((*MockDBClientOrganization)(nil).GetUserOrganizations), ctx, userID)
+}
+
+// InsertOrganizationInvitation mocks base method.
+func (m *MockDBClientOrganization) InsertOrganizationInvitation(ctx context.Context, arg sql.InsertOrganizationInvitationParams) (sql.AuthOrganizationInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOrganizationInvitation", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthOrganizationInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertOrganizationInvitation indicates an expected call of InsertOrganizationInvitation.
+func (mr *MockDBClientOrganizationMockRecorder) InsertOrganizationInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrganizationInvitation", reflect.TypeOf((*MockDBClientOrganization)(nil).InsertOrganizationInvitation), ctx, arg)
+}
+
+// InsertOrganizationWithOwner mocks base method.
+func (m *MockDBClientOrganization) InsertOrganizationWithOwner(ctx context.Context, arg sql.InsertOrganizationWithOwnerParams) (sql.InsertOrganizationWithOwnerRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOrganizationWithOwner", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertOrganizationWithOwnerRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertOrganizationWithOwner indicates an expected call of InsertOrganizationWithOwner.
+func (mr *MockDBClientOrganizationMockRecorder) InsertOrganizationWithOwner(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrganizationWithOwner", reflect.TypeOf((*MockDBClientOrganization)(nil).InsertOrganizationWithOwner), ctx, arg)
+}
+
+// MockDBClientMfaChallenge is a mock of DBClientMfaChallenge interface.
+type MockDBClientMfaChallenge struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBClientMfaChallengeMockRecorder
+	isgomock struct{}
+}
+
+// MockDBClientMfaChallengeMockRecorder is the mock recorder for MockDBClientMfaChallenge.
+type MockDBClientMfaChallengeMockRecorder struct {
+	mock *MockDBClientMfaChallenge
+}
+
+// NewMockDBClientMfaChallenge creates a new mock instance.
+func NewMockDBClientMfaChallenge(ctrl *gomock.Controller) *MockDBClientMfaChallenge {
+	mock := &MockDBClientMfaChallenge{ctrl: ctrl}
+	mock.recorder = &MockDBClientMfaChallengeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBClientMfaChallenge) EXPECT() *MockDBClientMfaChallengeMockRecorder {
+	return m.recorder
+}
+
+// CountActiveMfaChallenges mocks base method.
+func (m *MockDBClientMfaChallenge) CountActiveMfaChallenges(ctx context.Context, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveMfaChallenges", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveMfaChallenges indicates an expected call of CountActiveMfaChallenges.
+func (mr *MockDBClientMfaChallengeMockRecorder) CountActiveMfaChallenges(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveMfaChallenges", reflect.TypeOf((*MockDBClientMfaChallenge)(nil).CountActiveMfaChallenges), ctx, userID)
+}
+
+// DeleteMfaChallenge mocks base method.
+func (m *MockDBClientMfaChallenge) DeleteMfaChallenge(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMfaChallenge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMfaChallenge indicates an expected call of DeleteMfaChallenge.
+func (mr *MockDBClientMfaChallengeMockRecorder) DeleteMfaChallenge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMfaChallenge", reflect.TypeOf((*MockDBClientMfaChallenge)(nil).DeleteMfaChallenge), ctx, id)
+}
+
+// GetMfaChallengeByTicket mocks base method.
+func (m *MockDBClientMfaChallenge) GetMfaChallengeByTicket(ctx context.Context, arg sql.GetMfaChallengeByTicketParams) (sql.AuthMfaChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMfaChallengeByTicket", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthMfaChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMfaChallengeByTicket indicates an expected call of GetMfaChallengeByTicket.
+func (mr *MockDBClientMfaChallengeMockRecorder) GetMfaChallengeByTicket(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMfaChallengeByTicket", reflect.TypeOf((*MockDBClientMfaChallenge)(nil).GetMfaChallengeByTicket), ctx, arg)
+}
+
+// InsertMfaChallenge mocks base method.
+func (m *MockDBClientMfaChallenge) InsertMfaChallenge(ctx context.Context, arg sql.InsertMfaChallengeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMfaChallenge", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMfaChallenge indicates an expected call of InsertMfaChallenge.
+func (mr *MockDBClientMfaChallengeMockRecorder) InsertMfaChallenge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMfaChallenge", reflect.TypeOf((*MockDBClientMfaChallenge)(nil).InsertMfaChallenge), ctx, arg)
+}
+
 // MockDBClient is a mock of DBClient interface.
 type MockDBClient struct {
 	ctrl     *gomock.Controller
@@ -636,6 +891,36 @@ func (m *MockDBClient) EXPECT() *MockDBClientMockRecorder {
 	return m.recorder
 }
 
+// AcceptOrganizationInvitation mocks base method.
+func (m *MockDBClient) AcceptOrganizationInvitation(ctx context.Context, arg sql.AcceptOrganizationInvitationParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrganizationInvitation", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrganizationInvitation indicates an expected call of AcceptOrganizationInvitation.
+func (mr *MockDBClientMockRecorder) AcceptOrganizationInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrganizationInvitation", reflect.TypeOf((*MockDBClient)(nil).AcceptOrganizationInvitation), ctx, arg)
+}
+
+// CountActiveMfaChallenges mocks base method.
+func (m *MockDBClient) CountActiveMfaChallenges(ctx context.Context, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveMfaChallenges", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveMfaChallenges indicates an expected call of CountActiveMfaChallenges.
+func (mr *MockDBClientMockRecorder) CountActiveMfaChallenges(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveMfaChallenges", reflect.TypeOf((*MockDBClient)(nil).CountActiveMfaChallenges), ctx, userID)
+}
+
 // CountSecurityKeysUser mocks base method.
 func (m *MockDBClient) CountSecurityKeysUser(ctx context.Context, userID uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
@@ -651,6 +936,20 @@ func (mr *MockDBClientMockRecorder) CountSecurityKeysUser(ctx, userID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSecurityKeysUser", reflect.TypeOf((*MockDBClient)(nil).CountSecurityKeysUser), ctx, userID)
 }
 
+// DeleteMfaChallenge mocks base method.
+func (m *MockDBClient) DeleteMfaChallenge(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMfaChallenge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMfaChallenge indicates an expected call of DeleteMfaChallenge.
+func (mr *MockDBClientMockRecorder) DeleteMfaChallenge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMfaChallenge", reflect.TypeOf((*MockDBClient)(nil).DeleteMfaChallenge), ctx, id)
+}
+
 // DeleteRefreshToken mocks base method.
 func (m *MockDBClient) DeleteRefreshToken(ctx context.Context, refreshTokenHash pgtype.Text) error {
 	m.ctrl.T.Helper()
@@ -679,6 +978,20 @@ func (mr *MockDBClientMockRecorder) DeleteRefreshTokens(ctx, userID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRefreshTokens", reflect.TypeOf((*MockDBClient)(nil).DeleteRefreshTokens), ctx, userID)
 }
 
+// DeleteUser mocks base method.
+func (m *MockDBClient) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockDBClientMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockDBClient)(nil).DeleteUser), ctx, id)
+}
+
 // DeleteUserRoles mocks base method.
 func (m *MockDBClient) DeleteUserRoles(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -708,6 +1021,36 @@ func (mr *MockDBClientMockRecorder) FindUserProviderByProviderId(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserProviderByProviderId", reflect.TypeOf((*MockDBClient)(nil).FindUserProviderByProviderId), ctx, arg)
 }
 
+// GetMfaChallengeByTicket mocks base method.
+func (m *MockDBClient) GetMfaChallengeByTicket(ctx context.Context, arg sql.GetMfaChallengeByTicketParams) (sql.AuthMfaChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMfaChallengeByTicket", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthMfaChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMfaChallengeByTicket indicates an expected call of GetMfaChallengeByTicket.
+func (mr *MockDBClientMockRecorder) GetMfaChallengeByTicket(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMfaChallengeByTicket", reflect.TypeOf((*MockDBClient)(nil).GetMfaChallengeByTicket), ctx, arg)
+}
+
+// GetOrganizationMember mocks base method.
+func (m *MockDBClient) GetOrganizationMember(ctx context.Context, arg sql.GetOrganizationMemberParams) (sql.AuthOrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMember", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthOrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMember indicates an expected call of GetOrganizationMember.
+func (mr *MockDBClientMockRecorder) GetOrganizationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMember", reflect.TypeOf((*MockDBClient)(nil).GetOrganizationMember), ctx, arg)
+}
+
 // GetSecurityKeys mocks base method.
 func (m *MockDBClient) GetSecurityKeys(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserSecurityKey, error) {
 	m.ctrl.T.Helper()
@@ -738,6 +1081,21 @@ func (mr *MockDBClientMockRecorder) GetUser(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockDBClient)(nil).GetUser), ctx, id)
 }
 
+// GetUserAuditEvents mocks base method.
+func (m *MockDBClient) GetUserAuditEvents(ctx context.Context, arg sql.GetUserAuditEventsParams) ([]sql.AuthAuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAuditEvents", ctx, arg)
+	ret0, _ := ret[0].([]sql.AuthAuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAuditEvents indicates an expected call of GetUserAuditEvents.
+func (mr *MockDBClientMockRecorder) GetUserAuditEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAuditEvents", reflect.TypeOf((*MockDBClient)(nil).GetUserAuditEvents), ctx, arg)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockDBClient) GetUserByEmail(ctx context.Context, email pgtype.Text) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
@@ -843,6 +1201,81 @@ func (mr *MockDBClientMockRecorder) GetUserByTicket(ctx, ticket any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByTicket", reflect.TypeOf((*MockDBClient)(nil).GetUserByTicket), ctx, ticket)
 }
 
+// GetUserImpersonations mocks base method.
+func (m *MockDBClient) GetUserImpersonations(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserImpersonation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserImpersonations", ctx, userID)
+	ret0, _ := ret[0].([]sql.AuthUserImpersonation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserImpersonations indicates an expected call of GetUserImpersonations.
+func (mr *MockDBClientMockRecorder) GetUserImpersonations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserImpersonations", reflect.TypeOf((*MockDBClient)(nil).GetUserImpersonations), ctx, userID)
+}
+
+// GetUserOrganizationInvitations mocks base method.
+func (m *MockDBClient) GetUserOrganizationInvitations(ctx context.Context, email string) ([]sql.GetUserOrganizationInvitationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserOrganizationInvitations", ctx, email)
+	ret0, _ := ret[0].([]sql.GetUserOrganizationInvitationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserOrganizationInvitations indicates an expected call of GetUserOrganizationInvitations.
+func (mr *MockDBClientMockRecorder) GetUserOrganizationInvitations(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserOrganizationInvitations", reflect.TypeOf((*MockDBClient)(nil).GetUserOrganizationInvitations), ctx, email)
+}
+
+// GetUserOrganizations mocks base method.
+func (m *MockDBClient) GetUserOrganizations(ctx context.Context, userID uuid.UUID) ([]sql.GetUserOrganizationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserOrganizations", ctx, userID)
+	ret0, _ := ret[0].([]sql.GetUserOrganizationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserOrganizations indicates an expected call of GetUserOrganizations.
+func (mr *MockDBClientMockRecorder) GetUserOrganizations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserOrganizations", reflect.TypeOf((*MockDBClient)(nil).GetUserOrganizations), ctx, userID)
+}
+
+// GetUserProviders mocks base method.
+func (m *MockDBClient) GetUserProviders(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProviders", ctx, userID)
+	ret0, _ := ret[0].([]sql.AuthUserProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProviders indicates an expected call of GetUserProviders.
+func (mr *MockDBClientMockRecorder) GetUserProviders(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProviders", reflect.TypeOf((*MockDBClient)(nil).GetUserProviders), ctx, userID)
+}
+
+// GetUserRefreshTokens mocks base method.
+func (m *MockDBClient) GetUserRefreshTokens(ctx context.Context, userID uuid.UUID) ([]sql.GetUserRefreshTokensRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRefreshTokens", ctx, userID)
+	ret0, _ := ret[0].([]sql.GetUserRefreshTokensRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRefreshTokens indicates an expected call of GetUserRefreshTokens.
+func (mr *MockDBClientMockRecorder) GetUserRefreshTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRefreshTokens", reflect.TypeOf((*MockDBClient)(nil).GetUserRefreshTokens), ctx, userID)
+}
+
 // GetUserRoles mocks base method.
 func (m *MockDBClient) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserRole, error) {
 	m.ctrl.T.Helper()
@@ -858,6 +1291,109 @@ func (mr *MockDBClientMockRecorder) GetUserRoles(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockDBClient)(nil).GetUserRoles), ctx, userID)
 }
 
+// GetUserSignInHistory mocks base method.
+func (m *MockDBClient) GetUserSignInHistory(ctx context.Context, arg sql.GetUserSignInHistoryParams) (sql.GetUserSignInHistoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSignInHistory", ctx, arg)
+	ret0, _ := ret[0].(sql.GetUserSignInHistoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSignInHistory indicates an expected call of GetUserSignInHistory.
+func (mr *MockDBClientMockRecorder) GetUserSignInHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSignInHistory", reflect.TypeOf((*MockDBClient)(nil).GetUserSignInHistory), ctx, arg)
+}
+
+// GetUsersByPhoneNumberLegacy mocks base method.
+func (m *MockDBClient) GetUsersByPhoneNumberLegacy(ctx context.Context, digits string) ([]sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByPhoneNumberLegacy", ctx, digits)
+	ret0, _ := ret[0].([]sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByPhoneNumberLegacy indicates an expected call of GetUsersByPhoneNumberLegacy.
+func (mr *MockDBClientMockRecorder) GetUsersByPhoneNumberLegacy(ctx, digits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByPhoneNumberLegacy", reflect.TypeOf((*MockDBClient)(nil).GetUsersByPhoneNumberLegacy), ctx, digits)
+}
+
+// IncrementUserOTPHashAttempts mocks base method.
+func (m *MockDBClient) IncrementUserOTPHashAttempts(ctx context.Context, arg sql.IncrementUserOTPHashAttemptsParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementUserOTPHashAttempts", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementUserOTPHashAttempts indicates an expected call of IncrementUserOTPHashAttempts.
+func (mr *MockDBClientMockRecorder) IncrementUserOTPHashAttempts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementUserOTPHashAttempts", reflect.TypeOf((*MockDBClient)(nil).IncrementUserOTPHashAttempts), ctx, arg)
+}
+
+// InsertAuditEvent mocks base method.
+func (m *MockDBClient) InsertAuditEvent(ctx context.Context, arg sql.InsertAuditEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAuditEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAuditEvent indicates an expected call of InsertAuditEvent.
+func (mr *MockDBClientMockRecorder) InsertAuditEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAuditEvent", reflect.TypeOf((*MockDBClient)(nil).InsertAuditEvent), ctx, arg)
+}
+
+// InsertMfaChallenge mocks base method.
+func (m *MockDBClient) InsertMfaChallenge(ctx context.Context, arg sql.InsertMfaChallengeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMfaChallenge", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMfaChallenge indicates an expected call of InsertMfaChallenge.
+func (mr *MockDBClientMockRecorder) InsertMfaChallenge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMfaChallenge", reflect.TypeOf((*MockDBClient)(nil).InsertMfaChallenge), ctx, arg)
+}
+
+// InsertOrganizationInvitation mocks base method.
+func (m *MockDBClient) InsertOrganizationInvitation(ctx context.Context, arg sql.InsertOrganizationInvitationParams) (sql.AuthOrganizationInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOrganizationInvitation", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthOrganizationInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertOrganizationInvitation indicates an expected call of InsertOrganizationInvitation.
+func (mr *MockDBClientMockRecorder) InsertOrganizationInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrganizationInvitation", reflect.TypeOf((*MockDBClient)(nil).InsertOrganizationInvitation), ctx, arg)
+}
+
+// InsertOrganizationWithOwner mocks base method.
+func (m *MockDBClient) InsertOrganizationWithOwner(ctx context.Context, arg sql.InsertOrganizationWithOwnerParams) (sql.InsertOrganizationWithOwnerRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOrganizationWithOwner", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertOrganizationWithOwnerRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertOrganizationWithOwner indicates an expected call of InsertOrganizationWithOwner.
+func (mr *MockDBClientMockRecorder) InsertOrganizationWithOwner(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrganizationWithOwner", reflect.TypeOf((*MockDBClient)(nil).InsertOrganizationWithOwner), ctx, arg)
+}
+
 // InsertRefreshtoken mocks base method.
 func (m *MockDBClient) InsertRefreshtoken(ctx context.Context, arg sql.InsertRefreshtokenParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -903,6 +1439,21 @@ func (mr *MockDBClientMockRecorder) InsertUser(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUser", reflect.TypeOf((*MockDBClient)(nil).InsertUser), ctx, arg)
 }
 
+// InsertUserImpersonation mocks base method.
+func (m *MockDBClient) InsertUserImpersonation(ctx context.Context, arg sql.InsertUserImpersonationParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserImpersonation", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserImpersonation indicates an expected call of InsertUserImpersonation.
+func (mr *MockDBClientMockRecorder) InsertUserImpersonation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserImpersonation", reflect.TypeOf((*MockDBClient)(nil).InsertUserImpersonation), ctx, arg)
+}
+
 // InsertUserProvider mocks base method.
 func (m *MockDBClient) InsertUserProvider(ctx context.Context, arg sql.InsertUserProviderParams) (sql.AuthUserProvider, error) {
 	m.ctrl.T.Helper()
@@ -993,6 +1544,20 @@ func (mr *MockDBClientMockRecorder) InsertUserWithUserProviderAndRefreshToken(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithUserProviderAndRefreshToken", reflect.TypeOf((*MockDBClient)(nil).InsertUserWithUserProviderAndRefreshToken), ctx, arg)
 }
 
+// InsertWebhookOutbox mocks base method.
+func (m *MockDBClient) InsertWebhookOutbox(ctx context.Context, arg sql.InsertWebhookOutboxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWebhookOutbox", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertWebhookOutbox indicates an expected call of InsertWebhookOutbox.
+func (mr *MockDBClientMockRecorder) InsertWebhookOutbox(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWebhookOutbox", reflect.TypeOf((*MockDBClient)(nil).InsertWebhookOutbox), ctx, arg)
+}
+
 // RefreshTokenAndGetUserRoles mocks base method.
 func (m *MockDBClient) RefreshTokenAndGetUserRoles(ctx context.Context, arg sql.RefreshTokenAndGetUserRolesParams) ([]sql.RefreshTokenAndGetUserRolesRow, error) {
 	m.ctrl.T.Helper()
@@ -1008,6 +1573,20 @@ func (mr *MockDBClientMockRecorder) RefreshTokenAndGetUserRoles(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenAndGetUserRoles", reflect.TypeOf((*MockDBClient)(nil).RefreshTokenAndGetUserRoles), ctx, arg)
 }
 
+// UpdateRefreshTokenOrganization mocks base method.
+func (m *MockDBClient) UpdateRefreshTokenOrganization(ctx context.Context, arg sql.UpdateRefreshTokenOrganizationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefreshTokenOrganization", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRefreshTokenOrganization indicates an expected call of UpdateRefreshTokenOrganization.
+func (mr *MockDBClientMockRecorder) UpdateRefreshTokenOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefreshTokenOrganization", reflect.TypeOf((*MockDBClient)(nil).UpdateRefreshTokenOrganization), ctx, arg)
+}
+
 // UpdateUserActiveMFAType mocks base method.
 func (m *MockDBClient) UpdateUserActiveMFAType(ctx context.Context, arg sql.UpdateUserActiveMFATypeParams) error {
 	m.ctrl.T.Helper()
@@ -1111,6 +1690,20 @@ func (mr *MockDBClientMockRecorder) UpdateUserOTPHash(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserOTPHash", reflect.TypeOf((*MockDBClient)(nil).UpdateUserOTPHash), ctx, arg)
 }
 
+// UpdateUserPhoneNumber mocks base method.
+func (m *MockDBClient) UpdateUserPhoneNumber(ctx context.Context, arg sql.UpdateUserPhoneNumberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPhoneNumber", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPhoneNumber indicates an expected call of UpdateUserPhoneNumber.
+func (mr *MockDBClientMockRecorder) UpdateUserPhoneNumber(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPhoneNumber", reflect.TypeOf((*MockDBClient)(nil).UpdateUserPhoneNumber), ctx, arg)
+}
+
 // UpdateUserTicket mocks base method.
 func (m *MockDBClient) UpdateUserTicket(ctx context.Context, arg sql.UpdateUserTicketParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -1154,3 +1747,18 @@ func (mr *MockDBClientMockRecorder) UpdateUserVerifyEmail(ctx, id any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserVerifyEmail", reflect.TypeOf((*MockDBClient)(nil).UpdateUserVerifyEmail), ctx, id)
 }
+
+// UpdateUserVerifyPhoneNumber mocks base method.
+func (m *MockDBClient) UpdateUserVerifyPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserVerifyPhoneNumber", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserVerifyPhoneNumber indicates an expected call of UpdateUserVerifyPhoneNumber.
+func (mr *MockDBClientMockRecorder) UpdateUserVerifyPhoneNumber(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserVerifyPhoneNumber", reflect.TypeOf((*MockDBClient)(nil).UpdateUserVerifyPhoneNumber), ctx, id)
+}
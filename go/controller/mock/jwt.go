@@ -11,8 +11,10 @@ package mock
 
 import (
 	context "context"
+	slog "log/slog"
 	reflect "reflect"
 
+	hooks "github.com/nhost/hasura-auth/go/hooks"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,3 +56,80 @@ func (mr *MockCustomClaimerMockRecorder) GetClaims(ctx, userID any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaims", reflect.TypeOf((*MockCustomClaimer)(nil).GetClaims), ctx, userID)
 }
+
+// MockCustomClaimsInvalidator is a mock of CustomClaimsInvalidator interface.
+type MockCustomClaimsInvalidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomClaimsInvalidatorMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomClaimsInvalidatorMockRecorder is the mock recorder for MockCustomClaimsInvalidator.
+type MockCustomClaimsInvalidatorMockRecorder struct {
+	mock *MockCustomClaimsInvalidator
+}
+
+// NewMockCustomClaimsInvalidator creates a new mock instance.
+func NewMockCustomClaimsInvalidator(ctrl *gomock.Controller) *MockCustomClaimsInvalidator {
+	mock := &MockCustomClaimsInvalidator{ctrl: ctrl}
+	mock.recorder = &MockCustomClaimsInvalidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomClaimsInvalidator) EXPECT() *MockCustomClaimsInvalidatorMockRecorder {
+	return m.recorder
+}
+
+// Invalidate mocks base method.
+func (m *MockCustomClaimsInvalidator) Invalidate(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invalidate", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockCustomClaimsInvalidatorMockRecorder) Invalidate(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockCustomClaimsInvalidator)(nil).Invalidate), ctx, userID)
+}
+
+// MockBeforeTokenHook is a mock of BeforeTokenHook interface.
+type MockBeforeTokenHook struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeforeTokenHookMockRecorder
+	isgomock struct{}
+}
+
+// MockBeforeTokenHookMockRecorder is the mock recorder for MockBeforeTokenHook.
+type MockBeforeTokenHookMockRecorder struct {
+	mock *MockBeforeTokenHook
+}
+
+// NewMockBeforeTokenHook creates a new mock instance.
+func NewMockBeforeTokenHook(ctrl *gomock.Controller) *MockBeforeTokenHook {
+	mock := &MockBeforeTokenHook{ctrl: ctrl}
+	mock.recorder = &MockBeforeTokenHookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeforeTokenHook) EXPECT() *MockBeforeTokenHookMockRecorder {
+	return m.recorder
+}
+
+// BeforeToken mocks base method.
+func (m *MockBeforeTokenHook) BeforeToken(ctx context.Context, req hooks.BeforeTokenRequest, logger *slog.Logger) (hooks.BeforeTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeforeToken", ctx, req, logger)
+	ret0, _ := ret[0].(hooks.BeforeTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeforeToken indicates an expected call of BeforeToken.
+func (mr *MockBeforeTokenHookMockRecorder) BeforeToken(ctx, req, logger any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeToken", reflect.TypeOf((*MockBeforeTokenHook)(nil).BeforeToken), ctx, req, logger)
+}
@@ -11,8 +11,10 @@ package mock
 
 import (
 	context "context"
+	slog "log/slog"
 	reflect "reflect"
 
+	hooks "github.com/nhost/hasura-auth/go/hooks"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,3 +56,42 @@ func (mr *MockHIBPClientMockRecorder) IsPasswordPwned(ctx, password any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPasswordPwned", reflect.TypeOf((*MockHIBPClient)(nil).IsPasswordPwned), ctx, password)
 }
+
+// MockBeforeSignUpHook is a mock of BeforeSignUpHook interface.
+type MockBeforeSignUpHook struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeforeSignUpHookMockRecorder
+	isgomock struct{}
+}
+
+// MockBeforeSignUpHookMockRecorder is the mock recorder for MockBeforeSignUpHook.
+type MockBeforeSignUpHookMockRecorder struct {
+	mock *MockBeforeSignUpHook
+}
+
+// NewMockBeforeSignUpHook creates a new mock instance.
+func NewMockBeforeSignUpHook(ctrl *gomock.Controller) *MockBeforeSignUpHook {
+	mock := &MockBeforeSignUpHook{ctrl: ctrl}
+	mock.recorder = &MockBeforeSignUpHookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeforeSignUpHook) EXPECT() *MockBeforeSignUpHookMockRecorder {
+	return m.recorder
+}
+
+// BeforeSignUp mocks base method.
+func (m *MockBeforeSignUpHook) BeforeSignUp(ctx context.Context, req hooks.BeforeSignUpRequest, logger *slog.Logger) (hooks.BeforeSignUpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeforeSignUp", ctx, req, logger)
+	ret0, _ := ret[0].(hooks.BeforeSignUpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeforeSignUp indicates an expected call of BeforeSignUp.
+func (mr *MockBeforeSignUpHookMockRecorder) BeforeSignUp(ctx, req, logger any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeSignUp", reflect.TypeOf((*MockBeforeSignUpHook)(nil).BeforeSignUp), ctx, req, logger)
+}
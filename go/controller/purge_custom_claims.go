@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PurgeCustomClaims( //nolint:ireturn
+	ctx context.Context,
+	request api.PurgeCustomClaimsRequestObject,
+) (api.PurgeCustomClaimsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).With(
+		slog.String("userId", request.Body.UserId.String()),
+	)
+
+	if err := ctrl.wf.jwtGetter.InvalidateCustomClaims(ctx, request.Body.UserId); err != nil {
+		logger.Error("error purging custom claims", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	logger.Info("custom claims purged")
+
+	return api.PurgeCustomClaims200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,76 @@
+package controller_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/claimscache"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPurgeCustomClaims(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	ctrl := gomock.NewController(t)
+
+	claimer := mock.NewMockCustomClaimer(ctrl)
+	claimer.EXPECT().GetClaims(gomock.Any(), userID.String()).Return(
+		map[string]any{"org": "a"}, nil,
+	)
+	claimer.EXPECT().GetClaims(gomock.Any(), userID.String()).Return(
+		map[string]any{"org": "b"}, nil,
+	)
+
+	cache := claimscache.New(claimer, claimscache.NewInMemoryStore(), time.Hour, slog.Default())
+
+	c, _ := getController(
+		t,
+		ctrl,
+		getConfig,
+		func(ctrl *gomock.Controller) controller.DBClient {
+			return mock.NewMockDBClient(ctrl)
+		},
+		withCusomClaimer(func(*gomock.Controller) controller.CustomClaimer {
+			return cache
+		}),
+	)
+
+	for _, expected := range []string{"a", "a"} {
+		claims, err := cache.GetClaims(t.Context(), userID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if claims["org"] != expected {
+			t.Fatalf("expected org %s, got %v", expected, claims["org"])
+		}
+	}
+
+	resp, err := c.PurgeCustomClaims(
+		t.Context(),
+		api.PurgeCustomClaimsRequestObject{
+			Body: &api.PurgeCustomClaimsRequest{UserId: userID},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(api.PurgeCustomClaims200JSONResponse(api.OK), resp); diff != "" {
+		t.Fatalf("unexpected response (-want +got):\n%s", diff)
+	}
+
+	claims, err := cache.GetClaims(t.Context(), userID.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if claims["org"] != "b" {
+		t.Fatalf("expected claims to be resolved again, got %v", claims["org"])
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
 	"github.com/nhost/hasura-auth/go/sql"
@@ -34,6 +35,9 @@ func (ctrl *Controller) RefreshToken( //nolint:ireturn
 	switch {
 	case errors.Is(err, ErrInvalidRefreshToken):
 		logger.Error("invalid refresh token, token already used", logError(err))
+		if user.ID != uuid.Nil {
+			ctrl.wf.RecordAuditEvent(ctx, user.ID, api.RefreshTokenReuse, nil, logger)
+		}
 		return ctrl.sendError(ErrInvalidRefreshToken), nil
 	case err != nil:
 		logger.Error("error updating session", logError(err))
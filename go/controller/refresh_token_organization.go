@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) RefreshTokenOrganization( //nolint:ireturn
+	ctx context.Context, request api.RefreshTokenOrganizationRequestObject,
+) (api.RefreshTokenOrganizationResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserByRefreshTokenHash(
+		ctx,
+		request.Body.RefreshToken,
+		sql.RefreshTokenTypeRegular,
+		logger,
+	)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	var organizationID pgtype.UUID
+	if request.Body.OrganizationId != nil {
+		logger = logger.With(slog.String("organizationId", request.Body.OrganizationId.String()))
+		if _, apiErr := ctrl.wf.GetOrganizationMember(
+			ctx, *request.Body.OrganizationId, user.ID, logger,
+		); apiErr != nil {
+			return ctrl.sendError(apiErr), nil
+		}
+		organizationID = pgtype.UUID{Bytes: *request.Body.OrganizationId, Valid: true}
+	}
+
+	if err := ctrl.wf.db.UpdateRefreshTokenOrganization(
+		ctx, sql.UpdateRefreshTokenOrganizationParams{
+			RefreshTokenHash: sql.Text(hashRefreshToken([]byte(request.Body.RefreshToken))),
+			OrganizationID:   organizationID,
+		},
+	); err != nil {
+		logger.Error("error updating refresh token organization", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	session, apiErr := ctrl.wf.UpdateSession(ctx, user, request.Body.RefreshToken, logger)
+	switch {
+	case errors.Is(apiErr, ErrInvalidRefreshToken):
+		logger.Error("invalid refresh token, token already used", logError(apiErr))
+		return ctrl.sendError(ErrInvalidRefreshToken), nil
+	case apiErr != nil:
+		logger.Error("error updating session", logError(apiErr))
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.RefreshTokenOrganization200JSONResponse(*session), nil
+}
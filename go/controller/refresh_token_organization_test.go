@@ -0,0 +1,248 @@
+package controller_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRefreshTokenOrganization(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	orgID := uuid.MustParse("5b1c1b6a-7e0a-4c8e-8a57-0c1f0f5b6d2e")
+	token := uuid.MustParse("1fb17604-86c7-444e-b337-09a644465f2d")
+	hashedToken := `\x9698157153010b858587119503cbeef0cf288f11775e51cdb6bfd65e930d9310`
+	newTokenID := uuid.MustParse("1fb13604-86c7-4444-a337-09a644465f2d")
+
+	configEnabled := func() *controller.Config {
+		c := getConfig()
+		c.OrganizationsEnabled = true
+		return c
+	}
+
+	member := sql.AuthOrganizationMember{
+		ID:             uuid.MustParse("0d4f6e8a-1b2c-4d3e-8f9a-0b1c2d3e4f5a"),
+		CreatedAt:      sql.TimestampTz(time.Now()),
+		OrganizationID: orgID,
+		UserID:         userID,
+		Roles:          []string{"owner", "billing"},
+	}
+
+	cases := []testRequest[api.RefreshTokenOrganizationRequestObject, api.RefreshTokenOrganizationResponseObject]{ //nolint:lll
+		{
+			name:   "switch organization",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: sql.Text(hashedToken),
+						Type:             "regular",
+					},
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetOrganizationMember(
+					gomock.Any(),
+					sql.GetOrganizationMemberParams{
+						OrganizationID: orgID,
+						UserID:         userID,
+					},
+				).Return(member, nil).Times(2)
+
+				mock.EXPECT().UpdateRefreshTokenOrganization(
+					gomock.Any(),
+					sql.UpdateRefreshTokenOrganizationParams{
+						RefreshTokenHash: sql.Text(hashedToken),
+						OrganizationID:   pgtype.UUID{Bytes: orgID, Valid: true},
+					},
+				).Return(nil)
+
+				mock.EXPECT().RefreshTokenAndGetUserRoles(
+					gomock.Any(),
+					cmpDBParams(sql.RefreshTokenAndGetUserRolesParams{
+						NewRefreshTokenHash: sql.Text(""),
+						ExpiresAt: sql.TimestampTz(
+							time.Now().Add(time.Duration(2592000) * time.Second),
+						),
+						OldRefreshTokenHash: sql.Text(hashedToken),
+					}),
+				).Return([]sql.RefreshTokenAndGetUserRolesRow{
+					{
+						Role:           sql.Text("user"),
+						RefreshTokenID: newTokenID,
+						OrganizationID: pgtype.UUID{Bytes: orgID, Valid: true},
+					},
+					{
+						Role:           sql.Text("me"),
+						RefreshTokenID: newTokenID,
+						OrganizationID: pgtype.UUID{Bytes: orgID, Valid: true},
+					},
+				}, nil)
+
+				return mock
+			},
+			request: api.RefreshTokenOrganizationRequestObject{
+				Body: &api.RefreshTokenOrganizationRequest{
+					RefreshToken:   token.String(),
+					OrganizationId: &orgID,
+				},
+			},
+			expectedResponse: api.RefreshTokenOrganization200JSONResponse(
+				api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					RefreshTokenId:       "1fb13604-86c7-4444-a337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         nil,
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+						ActiveMfaType:       nil,
+					},
+				},
+			),
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+						"x-hasura-org-id":            "5b1c1b6a-7e0a-4c8e-8a57-0c1f0f5b6d2e",
+						"x-hasura-org-allowed-roles": `{"owner","billing"}`,
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "not a member",
+			config: configEnabled,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: sql.Text(hashedToken),
+						Type:             "regular",
+					},
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetOrganizationMember(
+					gomock.Any(),
+					sql.GetOrganizationMemberParams{
+						OrganizationID: orgID,
+						UserID:         userID,
+					},
+				).Return(sql.AuthOrganizationMember{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.RefreshTokenOrganizationRequestObject{
+				Body: &api.RefreshTokenOrganizationRequest{
+					RefreshToken:   token.String(),
+					OrganizationId: &orgID,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-organization",
+				Message: "User is not allowed to access this organization",
+				Status:  403,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "organizations disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.RefreshTokenOrganizationRequestObject{
+				Body: &api.RefreshTokenOrganizationRequest{
+					RefreshToken:   token.String(),
+					OrganizationId: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			//nolint:exhaustruct
+			resp := assertRequest(
+				t.Context(),
+				t,
+				c.RefreshTokenOrganization,
+				tc.request,
+				tc.expectedResponse,
+				cmpopts.IgnoreFields(
+					api.RefreshTokenOrganization200JSONResponse{},
+					"RefreshToken",
+					"AccessToken",
+				),
+			)
+
+			resp200, ok := resp.(api.RefreshTokenOrganization200JSONResponse)
+			if ok {
+				session := api.Session(resp200)
+				assertSession(t, jwtGetter, &session, tc.expectedJWT)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) SendPasswordResetSms( //nolint:ireturn
+	ctx context.Context,
+	request api.SendPasswordResetSmsRequestObject,
+) (api.SendPasswordResetSmsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("phoneNumber", request.Body.PhoneNumber))
+
+	if !ctrl.config.PhonePasswordEnabled {
+		logger.Warn("phone password is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	phoneNumber, apiErr := ctrl.wf.ValidatePhoneNumber(request.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	request.Body.PhoneNumber = phoneNumber
+
+	user, apiErr := ctrl.wf.GetUserByPhoneNumber(ctx, request.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.SendPhoneNumberOTP(
+		ctx, user, otpMethodPasswordResetSms, logger,
+	); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	return api.SendPasswordResetSms200JSONResponse(api.OK), nil
+}
@@ -3,14 +3,13 @@ package controller
 import (
 	"context"
 	"log/slog"
-	"slices"
 
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
 )
 
 func (ctrl *Controller) postSigninAnonymousValidateRequest(
-	req api.SignInAnonymousRequestObject, logger *slog.Logger,
+	ctx context.Context, req api.SignInAnonymousRequestObject, logger *slog.Logger,
 ) (api.SignInAnonymousRequestObject, *APIError) {
 	if ctrl.config.DisableSignup {
 		logger.Warn("signup disabled")
@@ -26,16 +25,7 @@ func (ctrl *Controller) postSigninAnonymousValidateRequest(
 		req.Body = &api.SignInAnonymousJSONRequestBody{} //nolint:exhaustruct
 	}
 
-	if req.Body.Locale == nil {
-		req.Body.Locale = ptr(ctrl.config.DefaultLocale)
-	}
-	if !slices.Contains(ctrl.config.AllowedLocales, deptr(req.Body.Locale)) {
-		logger.Warn(
-			"locale not allowed, using default",
-			slog.String("locale", deptr(req.Body.Locale)),
-		)
-		req.Body.Locale = ptr(ctrl.config.DefaultLocale)
-	}
+	req.Body.Locale = ptr(ctrl.wf.ResolveLocale(ctx, req.Body.Locale, logger))
 
 	if req.Body.DisplayName == nil {
 		req.Body.DisplayName = ptr("Anonymous User")
@@ -49,7 +39,7 @@ func (ctrl *Controller) SignInAnonymous( //nolint:ireturn
 ) (api.SignInAnonymousResponseObject, error) {
 	logger := middleware.LoggerFromContext(ctx)
 
-	req, apiErr := ctrl.postSigninAnonymousValidateRequest(req, logger)
+	req, apiErr := ctrl.postSigninAnonymousValidateRequest(ctx, req, logger)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
 	}
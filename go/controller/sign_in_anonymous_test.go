@@ -1,9 +1,13 @@
 package controller_test
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -11,6 +15,7 @@ import (
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
 	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
 	"go.uber.org/mock/gomock"
 )
 
@@ -25,6 +30,14 @@ func TestSignInAnonymous(t *testing.T) { //nolint:maintidx
 		RefreshTokenID: refreshTokenID,
 	}
 
+	modifyHook := beforeSignUpHookStub(
+		t, "", `{"options": {"displayName": "Anon from the hook", "metadata": {"plan": "free"}}}`,
+	)
+	rejectHook := beforeSignUpHookStub(t, "", `{"reject": true, "reason": "not today"}`)
+	adminHook := beforeSignUpHookStub(
+		t, "", `{"options": {"allowedRoles": ["anonymous", "admin"]}}`,
+	)
+
 	cases := []testRequest[api.SignInAnonymousRequestObject, api.SignInAnonymousResponseObject]{
 		{
 			name: "no body",
@@ -303,6 +316,290 @@ func TestSignInAnonymous(t *testing.T) { //nolint:maintidx
 			},
 		},
 
+		{
+			name: "before-signup hook modifies options",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.AnonymousUsersEnabled = true
+				cfg.HookBeforeSignUpURL = modifyHook.URL
+				cfg.HookSecret = "secret"
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithRefreshToken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserWithRefreshTokenParams{
+						Disabled:              false,
+						DisplayName:           "Anon from the hook",
+						AvatarUrl:             "",
+						Email:                 sql.Text(""),
+						PasswordHash:          pgtype.Text{}, //nolint:exhaustruct
+						Ticket:                pgtype.Text{}, //nolint:exhaustruct
+						TicketExpiresAt:       sql.TimestampTz(time.Now()),
+						EmailVerified:         false,
+						Locale:                "es",
+						DefaultRole:           "anonymous",
+						Metadata:              []byte(`{"plan":"free"}`),
+						Roles:                 []string{"anonymous"},
+						IsAnonymous:           true,
+						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+					}),
+				).Return(insertResponse, nil)
+
+				return mock
+			},
+			request: api.SignInAnonymousRequestObject{
+				Body: &api.SignInAnonymousJSONRequestBody{
+					DisplayName: ptr("J. Doe"),
+					Locale:      ptr("es"),
+					Metadata: &map[string]any{
+						"key":  "value",
+						"key2": "value2",
+					},
+				},
+			},
+			expectedResponse: api.SignInAnonymous200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:     "",
+						CreatedAt:     time.Now(),
+						DefaultRole:   "anonymous",
+						DisplayName:   "Anon from the hook",
+						Email:         nil,
+						EmailVerified: false,
+						Id:            "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:   true,
+						Locale:        "es",
+						Metadata: map[string]any{
+							"plan": "free",
+						},
+						PhoneNumber:         nil,
+						PhoneNumberVerified: false,
+						Roles:               []string{"anonymous"},
+						ActiveMfaType:       nil,
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"anonymous"},
+						"x-hasura-default-role":      "anonymous",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "true",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn: nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withHIBP(mock.NewMockHIBPClient),
+				withEmailer(mock.NewMockEmailer),
+			},
+		},
+
+		{
+			name: "before-signup hook rejects",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.AnonymousUsersEnabled = true
+				cfg.HookBeforeSignUpURL = rejectHook.URL
+				cfg.HookSecret = "secret"
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.SignInAnonymousRequestObject{
+				Body: nil,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "hook-rejected",
+				Message: "The request was rejected",
+				Status:  403,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "before-signup hook returns a role not allowed",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.AnonymousUsersEnabled = true
+				cfg.HookBeforeSignUpURL = adminHook.URL
+				cfg.HookSecret = "secret"
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.SignInAnonymousRequestObject{
+				Body: nil,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-not-allowed",
+				Message: "Role not allowed",
+				Status:  400,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "with audit log and webhook subscription",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.AnonymousUsersEnabled = true
+				cfg.AuditLogEnabled = true
+				cfg.WebhookSubscriptions = []webhooks.Subscription{
+					{
+						URL:    "https://example.com/hooks",
+						Events: []webhooks.Event{webhooks.EventUserSignedUp},
+						Secret: "secret",
+					},
+				}
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithRefreshToken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserWithRefreshTokenParams{
+						Disabled:              false,
+						DisplayName:           "J. Doe",
+						AvatarUrl:             "",
+						Email:                 sql.Text(""),
+						PasswordHash:          pgtype.Text{}, //nolint:exhaustruct
+						Ticket:                pgtype.Text{}, //nolint:exhaustruct
+						TicketExpiresAt:       sql.TimestampTz(time.Now()),
+						EmailVerified:         false,
+						Locale:                "es",
+						DefaultRole:           "anonymous",
+						Metadata:              []byte(`{"key":"value","key2":"value2"}`),
+						Roles:                 []string{"anonymous"},
+						IsAnonymous:           true,
+						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+					}),
+				).Return(insertResponse, nil)
+
+				mock.EXPECT().InsertWebhookOutbox(
+					gomock.Any(),
+					gomock.Cond(func(arg sql.InsertWebhookOutboxParams) bool {
+						var payload webhooks.Payload
+						if err := json.Unmarshal(arg.Payload, &payload); err != nil {
+							return false
+						}
+
+						_, hasEmail := payload.Data["email"]
+						return arg.Event == string(webhooks.EventUserSignedUp) &&
+							payload.Data["userId"] == userID.String() &&
+							!hasEmail
+					}),
+				).Return(nil)
+
+				mock.EXPECT().InsertAuditEvent(
+					gomock.Any(),
+					sql.InsertAuditEventParams{
+						UserID:    userID,
+						Event:     "signin",
+						IpAddress: pgtype.Text{}, //nolint:exhaustruct
+						UserAgent: pgtype.Text{}, //nolint:exhaustruct
+						TraceID:   pgtype.Text{}, //nolint:exhaustruct
+						Metadata:  []byte(`{"method":"anonymous"}`),
+					},
+				).Return(nil)
+
+				return mock
+			},
+			request: api.SignInAnonymousRequestObject{
+				Body: &api.SignInAnonymousJSONRequestBody{
+					DisplayName: ptr("J. Doe"),
+					Locale:      ptr("es"),
+					Metadata: &map[string]any{
+						"key":  "value",
+						"key2": "value2",
+					},
+				},
+			},
+			expectedResponse: api.SignInAnonymous200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:     "",
+						CreatedAt:     time.Now(),
+						DefaultRole:   "anonymous",
+						DisplayName:   "J. Doe",
+						Email:         nil,
+						EmailVerified: false,
+						Id:            "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:   true,
+						Locale:        "es",
+						Metadata: map[string]any{
+							"key":  "value",
+							"key2": "value2",
+						},
+						PhoneNumber:         nil,
+						PhoneNumberVerified: false,
+						Roles:               []string{"anonymous"},
+						ActiveMfaType:       nil,
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"anonymous"},
+						"x-hasura-default-role":      "anonymous",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "true",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn: nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withHIBP(mock.NewMockHIBPClient),
+				withEmailer(mock.NewMockEmailer),
+			},
+		},
+
 		{ //nolint:dupl
 			name: "signup disabled",
 			config: func() *controller.Config {
@@ -423,3 +720,108 @@ func TestSignInAnonymous(t *testing.T) { //nolint:maintidx
 		})
 	}
 }
+
+func TestSignInAnonymousLocale(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		locale         *string
+		acceptLanguage string
+		expectedLocale string
+	}{
+		{
+			name:           "accept-language",
+			locale:         nil,
+			acceptLanguage: "pt-BR, es;q=0.9, en;q=0.8",
+			expectedLocale: "es",
+		},
+		{
+			name:           "accept-language region fallback",
+			locale:         nil,
+			acceptLanguage: "de;q=0.9, es-MX",
+			expectedLocale: "es",
+		},
+		{
+			name:           "accept-language not allowed",
+			locale:         nil,
+			acceptLanguage: "de, *;q=0.5",
+			expectedLocale: "en",
+		},
+		{
+			name:           "locale takes precedence",
+			locale:         ptr("ca"),
+			acceptLanguage: "es",
+			expectedLocale: "ca",
+		},
+		{
+			name:           "locale region fallback",
+			locale:         ptr("ca_ES"),
+			acceptLanguage: "",
+			expectedLocale: "ca",
+		},
+		{
+			name:           "locale not allowed",
+			locale:         ptr("de-AT"),
+			acceptLanguage: "es",
+			expectedLocale: "en",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			config := func() *controller.Config {
+				cfg := getConfig()
+				cfg.AnonymousUsersEnabled = true
+				return cfg
+			}
+
+			db := func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithRefreshToken(
+					gomock.Any(),
+					gomock.Cond(func(p sql.InsertUserWithRefreshTokenParams) bool {
+						return p.Locale == tc.expectedLocale
+					}),
+				).Return(sql.InsertUserWithRefreshTokenRow{
+					ID:             uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb"),
+					RefreshTokenID: uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"),
+				}, nil)
+
+				return mock
+			}
+
+			c, _ := getController(t, ctrl, config, db, withHIBP(mock.NewMockHIBPClient))
+
+			req := httptest.NewRequest(http.MethodPost, "/signin/anonymous", nil)
+			req.Header.Set("Accept-Language", tc.acceptLanguage)
+			ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ginCtx.Request = req
+
+			resp, err := c.SignInAnonymous(ginCtx, api.SignInAnonymousRequestObject{
+				Body: &api.SignInAnonymousJSONRequestBody{ //nolint:exhaustruct
+					Locale: tc.locale,
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resp200, ok := resp.(api.SignInAnonymous200JSONResponse)
+			if !ok {
+				t.Fatalf("unexpected response: %#v", resp)
+			}
+
+			if resp200.Session.User.Locale != tc.expectedLocale {
+				t.Errorf(
+					"expected locale %s, got %s", tc.expectedLocale, resp200.Session.User.Locale,
+				)
+			}
+		})
+	}
+}
@@ -8,25 +8,37 @@ import (
 	"github.com/google/uuid"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
 )
 
-func (ctrl *Controller) postSigninEmailPasswordWithTOTP( //nolint:ireturn
+// mfaChallenge starts the MFA challenge for users with MFA active. It returns
+// nil if the user doesn't have MFA active and can get a session right away.
+func (ctrl *Controller) mfaChallenge(
 	ctx context.Context,
-	userID uuid.UUID,
+	user sql.AuthUser,
 	logger *slog.Logger,
-) (api.SignInEmailPasswordResponseObject, error) {
-	ticket := "mfaTotp:" + uuid.NewString()
-	expiresAt := time.Now().Add(In5Minutes)
+) (*api.MFAChallengePayload, *APIError) {
+	var ticket string
+	switch api.UserMfaRequestActiveMfaType(user.ActiveMfaType.String) { //nolint:exhaustive
+	case api.Totp:
+		ticket = "mfaTotp:" + uuid.NewString()
+		expiresAt := time.Now().Add(In5Minutes)
 
-	if apiErr := ctrl.wf.SetTicket(ctx, userID, ticket, expiresAt, logger); apiErr != nil {
-		return ctrl.respondWithError(apiErr), nil
+		if apiErr := ctrl.wf.SetTicket(ctx, user.ID, ticket, expiresAt, logger); apiErr != nil {
+			return nil, apiErr
+		}
+	case api.Email, api.Sms:
+		var apiErr *APIError
+		ticket, apiErr = ctrl.wf.SendMfaOTPChallenge(ctx, user, logger)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+	default:
+		return nil, nil
 	}
 
-	return api.SignInEmailPassword200JSONResponse{
-		Mfa: &api.MFAChallengePayload{
-			Ticket: ticket,
-		},
-		Session: nil,
+	return &api.MFAChallengePayload{
+		Ticket: ticket,
 	}, nil
 }
 
@@ -43,19 +55,31 @@ func (ctrl *Controller) SignInEmailPassword( //nolint:ireturn
 
 	if !verifyHashPassword(request.Body.Password, user.PasswordHash.String) {
 		logger.Warn("password doesn't match")
+		ctrl.wf.RecordAuditEvent(
+			ctx, user.ID, api.SigninFailed, map[string]any{"method": signInMethodEmailPassword}, logger,
+		)
 		return ctrl.sendError(ErrInvalidEmailPassword), nil
 	}
 
-	if user.ActiveMfaType.String == string(api.Totp) {
-		return ctrl.postSigninEmailPasswordWithTOTP(ctx, user.ID, logger)
+	mfa, apiErr := ctrl.mfaChallenge(ctx, user, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+	if mfa != nil {
+		return api.SignInEmailPassword200JSONResponse{
+			Mfa:     mfa,
+			Session: nil,
+		}, nil
 	}
 
 	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(getTokenError(err)), nil
 	}
 
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodEmailPassword, logger)
+
 	return api.SignInEmailPassword200JSONResponse{
 		Session: session,
 		Mfa:     nil,
@@ -5,13 +5,16 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
 	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
 )
@@ -396,6 +399,54 @@ func TestSignInEmailPassword(t *testing.T) { //nolint:maintidx
 			},
 		},
 
+		{
+			name: "wrong password with audit log",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.AuditLogEnabled = true
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().InsertAuditEvent(
+					gomock.Any(),
+					sql.InsertAuditEventParams{
+						UserID:    userID,
+						Event:     "signin-failed",
+						IpAddress: pgtype.Text{}, //nolint:exhaustruct
+						UserAgent: pgtype.Text{}, //nolint:exhaustruct
+						TraceID:   pgtype.Text{}, //nolint:exhaustruct
+						Metadata:  []byte(`{"method":"email-password"}`),
+					},
+				).Return(nil)
+
+				return mock
+			},
+			request: api.SignInEmailPasswordRequestObject{
+				Body: &api.SignInEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "wrongpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withHIBP(mock.NewMockHIBPClient),
+				withEmailer(mock.NewMockEmailer),
+			},
+		},
+
 		{
 			name:   "user not verified but verification disabled",
 			config: getConfig,
@@ -571,6 +622,182 @@ func TestSignInEmailPassword(t *testing.T) { //nolint:maintidx
 				withEmailer(mock.NewMockEmailer),
 			},
 		},
+
+		{
+			name:   "email mfa enabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.ActiveMfaType = sql.Text("email")
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().CountActiveMfaChallenges(gomock.Any(), userID).Return(int64(0), nil)
+
+				mock.EXPECT().InsertMfaChallenge(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertMfaChallengeParams{
+							UserID:    userID,
+							Ticket:    "mfaEmail:xxxx",
+							MfaType:   "email",
+							OtpHash:   "",
+							ExpiresAt: sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+						testhelpers.FilterPathLast(
+							[]string{".OtpHash"},
+							cmp.Comparer(func(x, y string) bool { return x != "" || y != "" }),
+						),
+					),
+				).Return(nil)
+
+				return mock
+			},
+			request: api.SignInEmailPasswordRequestObject{
+				Body: &api.SignInEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: api.SignInEmailPassword200JSONResponse{
+				Mfa: &api.MFAChallengePayload{
+					Ticket: "mfaEmail:xxxx",
+				},
+				Session: nil,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withHIBP(mock.NewMockHIBPClient),
+				withEmailer(func(ctrl *gomock.Controller) *mock.MockEmailer {
+					mock := mock.NewMockEmailer(ctrl)
+
+					mock.EXPECT().SendEmail(
+						gomock.Any(),
+						"jane@acme.com",
+						"en",
+						notifications.TemplateNameSigninOTP,
+						testhelpers.GomockCmpOpts(
+							notifications.TemplateData{
+								Link:        "",
+								DisplayName: "Jane Doe",
+								Email:       "jane@acme.com",
+								NewEmail:    "",
+								Ticket:      "xxx",
+								RedirectTo:  "http://localhost:3000",
+								Locale:      "en",
+								ServerURL:   "https://local.auth.nhost.run",
+								ClientURL:   "http://localhost:3000",
+							},
+							testhelpers.FilterPathLast(
+								[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+						)).Return(nil)
+
+					return mock
+				}),
+			},
+		},
+
+		{
+			name:   "sms mfa enabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.ActiveMfaType = sql.Text("sms")
+				user.PhoneNumber = sql.Text("+14155552671")
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().CountActiveMfaChallenges(gomock.Any(), userID).Return(int64(0), nil)
+
+				mock.EXPECT().InsertMfaChallenge(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertMfaChallengeParams{
+							UserID:    userID,
+							Ticket:    "mfaSms:xxxx",
+							MfaType:   "sms",
+							OtpHash:   "otpHash",
+							ExpiresAt: sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+					),
+				).Return(nil)
+
+				return mock
+			},
+			request: api.SignInEmailPasswordRequestObject{
+				Body: &api.SignInEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: api.SignInEmailPassword200JSONResponse{
+				Mfa: &api.MFAChallengePayload{
+					Ticket: "mfaSms:xxxx",
+				},
+				Session: nil,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withHIBP(mock.NewMockHIBPClient),
+				withSMS(func(ctrl *gomock.Controller) *mock.MockSMSer {
+					mock := mock.NewMockSMSer(ctrl)
+
+					mock.EXPECT().SendVerificationCode(
+						"+14155552671", "en",
+					).Return("otpHash", time.Now().Add(5*time.Minute), nil)
+
+					return mock
+				}),
+			},
+		},
+
+		{
+			name:   "sms mfa enabled, too many pending codes",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.ActiveMfaType = sql.Text("sms")
+				user.PhoneNumber = sql.Text("+14155552671")
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().CountActiveMfaChallenges(gomock.Any(), userID).Return(int64(3), nil)
+
+				return mock
+			},
+			request: api.SignInEmailPasswordRequestObject{
+				Body: &api.SignInEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "too-many-requests",
+				Message: "Too many requests, please try again later",
+				Status:  429,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withHIBP(mock.NewMockHIBPClient),
+				withSMS(mock.NewMockSMSer),
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -98,7 +98,7 @@ func (ctrl *Controller) providerSignInFlow(
 }
 
 func (ctrl *Controller) providerFlowSignUpValidateOptions(
-	options *api.SignUpOptions, profile oidc.Profile, logger *slog.Logger,
+	ctx context.Context, options *api.SignUpOptions, profile oidc.Profile, logger *slog.Logger,
 ) (*api.SignUpOptions, *APIError) {
 	if ctrl.config.DisableSignup {
 		logger.Warn("signup disabled")
@@ -120,7 +120,7 @@ func (ctrl *Controller) providerFlowSignUpValidateOptions(
 	}
 
 	options, err := ctrl.wf.ValidateSignUpOptions(
-		options, profile.ProviderUserID, logger,
+		ctx, options, profile.ProviderUserID, logger,
 	)
 	if err != nil {
 		return nil, err
@@ -138,7 +138,7 @@ func (ctrl *Controller) providerFlowSignUp(
 ) (*api.Session, *APIError) {
 	logger.Info("user doesn't exist, signing up")
 
-	options, apiError := ctrl.providerFlowSignUpValidateOptions(options, profile, logger)
+	options, apiError := ctrl.providerFlowSignUpValidateOptions(ctx, options, profile, logger)
 	if apiError != nil {
 		return nil, apiError
 	}
@@ -226,7 +226,7 @@ func (ctrl *Controller) providerFlowSignupWithoutSession(
 		ticketExpiresAt pgtype.Timestamptz,
 		metadata []byte,
 		gravatarURL string,
-	) error {
+	) (uuid.UUID, error) {
 		avatarURL := gravatarURL
 		if profile.Picture != "" {
 			avatarURL = profile.Picture
@@ -237,7 +237,7 @@ func (ctrl *Controller) providerFlowSignupWithoutSession(
 			email = sql.Text(profile.Email)
 		}
 
-		_, err := ctrl.wf.db.InsertUserWithUserProvider(ctx, sql.InsertUserWithUserProviderParams{
+		userID, err := ctrl.wf.db.InsertUserWithUserProvider(ctx, sql.InsertUserWithUserProviderParams{
 			ID:              uuid.New(),
 			Disabled:        ctrl.config.DisableNewUsers,
 			DisplayName:     deptr(options.DisplayName),
@@ -254,9 +254,9 @@ func (ctrl *Controller) providerFlowSignupWithoutSession(
 			ProviderUserID:  profile.ProviderUserID,
 		})
 		if err != nil {
-			return fmt.Errorf("error inserting user: %w", err)
+			return uuid.Nil, fmt.Errorf("error inserting user: %w", err)
 		}
-		return nil
+		return userID, nil
 	}
 }
 
@@ -285,8 +285,15 @@ func (ctrl *Controller) providerFlowSignIn(
 	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return nil, ErrInternalServerError
+		return nil, getTokenError(err)
 	}
 
+	ctrl.wf.recordSignIn(
+		ctx,
+		user.ID,
+		map[string]any{"method": signInMethodProvider, "provider": provider},
+		logger,
+	)
+
 	return session, nil
 }
@@ -23,7 +23,7 @@ func (ctrl *Controller) SignInOTPEmail( //nolint:ireturn
 	}
 
 	options, apiErr := ctrl.signinEmailValidateRequest(
-		string(request.Body.Email), request.Body.Options, logger)
+		ctx, string(request.Body.Email), request.Body.Options, logger)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
 	}
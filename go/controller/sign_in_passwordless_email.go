@@ -28,7 +28,7 @@ func (ctrl *Controller) SignInPasswordlessEmail( //nolint:ireturn
 	}
 
 	options, apiErr := ctrl.signinEmailValidateRequest(
-		string(request.Body.Email), request.Body.Options, logger)
+		ctx, string(request.Body.Email), request.Body.Options, logger)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
 	}
@@ -53,6 +53,7 @@ func (ctrl *Controller) SignInPasswordlessEmail( //nolint:ireturn
 }
 
 func (ctrl *Controller) signinEmailValidateRequest(
+	ctx context.Context,
 	email string,
 	options *api.SignUpOptions,
 	logger *slog.Logger,
@@ -62,7 +63,7 @@ func (ctrl *Controller) signinEmailValidateRequest(
 		return nil, ErrInvalidEmailPassword
 	}
 
-	options, apiErr := ctrl.wf.ValidateSignUpOptions(options, email, logger)
+	options, apiErr := ctrl.wf.ValidateSignUpOptions(ctx, options, email, logger)
 	if apiErr != nil {
 		return nil, apiErr
 	}
@@ -144,7 +145,7 @@ func (ctrl *Controller) signinWithTicketSignUp(
 			_ pgtype.Timestamptz,
 			metadata []byte,
 			gravatarURL string,
-		) error {
+		) (uuid.UUID, error) {
 			resp, err := ctrl.wf.db.InsertUser(ctx, sql.InsertUserParams{
 				ID:                uuid.New(),
 				Disabled:          ctrl.config.DisableNewUsers,
@@ -165,7 +166,7 @@ func (ctrl *Controller) signinWithTicketSignUp(
 				OtpMethodLastUsed: pgtype.Text{},        //nolint:exhaustruct
 			})
 			if err != nil {
-				return fmt.Errorf("error inserting user: %w", err)
+				return uuid.Nil, fmt.Errorf("error inserting user: %w", err)
 			}
 
 			user = sql.AuthUser{ //nolint:exhaustruct
@@ -174,7 +175,7 @@ func (ctrl *Controller) signinWithTicketSignUp(
 				DisplayName: deptr(options.DisplayName),
 			}
 
-			return nil
+			return resp.UserID, nil
 		},
 		logger,
 	)
@@ -25,8 +25,14 @@ func (ctrl *Controller) SignInPasswordlessSms( //nolint:ireturn
 		return ctrl.sendError(ErrDisabledEndpoint), nil
 	}
 
+	phoneNumber, apiErr := ctrl.wf.ValidatePhoneNumber(request.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	request.Body.PhoneNumber = phoneNumber
+
 	options, apiErr := ctrl.signinSmsValidateRequest(
-		request.Body.PhoneNumber, request.Body.Options, logger)
+		ctx, request.Body.PhoneNumber, request.Body.Options, logger)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
 	}
@@ -56,11 +62,12 @@ func (ctrl *Controller) SignInPasswordlessSms( //nolint:ireturn
 }
 
 func (ctrl *Controller) signinSmsValidateRequest(
+	ctx context.Context,
 	phoneNumber string,
 	options *api.SignUpOptions,
 	logger *slog.Logger,
 ) (*api.SignUpOptions, *APIError) {
-	options, apiErr := ctrl.wf.ValidateSignUpOptions(options, phoneNumber, logger)
+	options, apiErr := ctrl.wf.ValidateSignUpOptions(ctx, options, phoneNumber, logger)
 	if apiErr != nil {
 		return nil, apiErr
 	}
@@ -125,8 +132,8 @@ func (ctrl *Controller) postSigninPasswordlessSmsSignup(
 			_ pgtype.Timestamptz,
 			metadata []byte,
 			gravatarURL string,
-		) error {
-			_, err := ctrl.wf.db.InsertUser(ctx, sql.InsertUserParams{
+		) (uuid.UUID, error) {
+			resp, err := ctrl.wf.db.InsertUser(ctx, sql.InsertUserParams{
 				ID:                uuid.New(),
 				Disabled:          ctrl.config.DisableNewUsers,
 				DisplayName:       deptr(options.DisplayName),
@@ -146,10 +153,10 @@ func (ctrl *Controller) postSigninPasswordlessSmsSignup(
 				Roles:             deptr(options.AllowedRoles),
 			})
 			if err != nil {
-				return fmt.Errorf("error inserting user: %w", err)
+				return uuid.Nil, fmt.Errorf("error inserting user: %w", err)
 			}
 
-			return nil
+			return resp.UserID, nil
 		},
 		logger,
 	)
@@ -38,17 +38,21 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 				mock.EXPECT().GetUserByPhoneNumber(
 					gomock.Any(),
-					sql.Text("+1234567890"),
+					sql.Text("+14155552671"),
 				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
 
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return(nil, nil)
+
 				mock.EXPECT().InsertUser(
 					gomock.Any(),
 					cmpDBParams(sql.InsertUserParams{
 						ID:                uuid.UUID{},
 						Disabled:          false,
-						DisplayName:       "+1234567890",
+						DisplayName:       "+14155552671",
 						AvatarUrl:         "",
-						PhoneNumber:       sql.Text("+1234567890"),
+						PhoneNumber:       sql.Text("+14155552671"),
 						OtpHash:           sql.Text("hashedOTP"),
 						OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(time.Minute * 5)),
 						OtpMethodLastUsed: sql.Text("sms"),
@@ -84,7 +88,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options:     nil,
 				},
 			},
@@ -96,7 +100,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					mock.EXPECT().SendVerificationCode(
-						"+1234567890",
+						"+14155552671",
 						"en",
 					).Return("hashedOTP", time.Now().Add(time.Minute*5), nil)
 
@@ -118,7 +122,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options:     nil,
 				},
 			},
@@ -141,7 +145,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options: &api.SignUpOptions{
 						AllowedRoles: &[]string{"admin"},
 						DefaultRole:  nil,
@@ -170,17 +174,21 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 				mock.EXPECT().GetUserByPhoneNumber(
 					gomock.Any(),
-					sql.Text("+1234567890"),
+					sql.Text("+14155552671"),
 				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
 
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return(nil, nil)
+
 				mock.EXPECT().InsertUser(
 					gomock.Any(),
 					cmpDBParams(sql.InsertUserParams{
 						ID:                uuid.UUID{},
 						Disabled:          false,
-						DisplayName:       "+1234567890",
+						DisplayName:       "+14155552671",
 						AvatarUrl:         "",
-						PhoneNumber:       sql.Text("+1234567890"),
+						PhoneNumber:       sql.Text("+14155552671"),
 						OtpHash:           sql.Text("hashedOTP"),
 						OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(time.Minute * 5)),
 						OtpMethodLastUsed: sql.Text("sms"),
@@ -216,7 +224,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options: &api.SignUpOptions{
 						AllowedRoles: nil,
 						DefaultRole:  nil,
@@ -235,7 +243,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					mock.EXPECT().SendVerificationCode(
-						"+1234567890",
+						"+14155552671",
 						"en",
 					).Return("hashedOTP", time.Now().Add(time.Minute*5), nil)
 
@@ -253,7 +261,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options: &api.SignUpOptions{
 						AllowedRoles: nil,
 						DefaultRole:  nil,
@@ -287,9 +295,13 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 				mock.EXPECT().GetUserByPhoneNumber(
 					gomock.Any(),
-					sql.Text("+1234567890"),
+					sql.Text("+14155552671"),
 				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
 
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return(nil, nil)
+
 				mock.EXPECT().InsertUser(
 					gomock.Any(),
 					cmpDBParams(sql.InsertUserParams{
@@ -297,7 +309,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 						Disabled:          false,
 						DisplayName:       "Jane Doe",
 						AvatarUrl:         "",
-						PhoneNumber:       sql.Text("+1234567890"),
+						PhoneNumber:       sql.Text("+14155552671"),
 						OtpHash:           sql.Text("hashedOTP"),
 						OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(time.Minute * 5)),
 						OtpMethodLastUsed: sql.Text("sms"),
@@ -333,7 +345,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options: &api.SignUpOptions{
 						AllowedRoles: &[]string{"user"},
 						DefaultRole:  ptr("user"),
@@ -352,7 +364,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					mock.EXPECT().SendVerificationCode(
-						"+1234567890",
+						"+14155552671",
 						"fr",
 					).Return("hashedOTP", time.Now().Add(time.Minute*5), nil)
 
@@ -373,14 +385,18 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 				mock.EXPECT().GetUserByPhoneNumber(
 					gomock.Any(),
-					sql.Text("+1234567890"),
+					sql.Text("+14155552671"),
 				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
 
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return(nil, nil)
+
 				return mock
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options:     nil,
 				},
 			},
@@ -396,7 +412,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					mock.EXPECT().SendVerificationCode(
-						"+1234567890",
+						"+14155552671",
 						"en",
 					).Return("hashedOTP", time.Now().Add(time.Minute*5), nil)
 
@@ -413,18 +429,18 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 				mock.EXPECT().GetUserByPhoneNumber(
 					gomock.Any(),
-					sql.Text("+1234567890"),
+					sql.Text("+14155552671"),
 				).Return(sql.AuthUser{
 					ID:                       userID,
 					CreatedAt:                pgtype.Timestamptz{}, //nolint:exhaustruct
 					UpdatedAt:                pgtype.Timestamptz{}, //nolint:exhaustruct
 					LastSeen:                 pgtype.Timestamptz{}, //nolint:exhaustruct
 					Disabled:                 false,
-					DisplayName:              "+1234567890",
+					DisplayName:              "+14155552671",
 					AvatarUrl:                "",
 					Locale:                   "en",
 					Email:                    pgtype.Text{}, //nolint:exhaustruct
-					PhoneNumber:              sql.Text("+1234567890"),
+					PhoneNumber:              sql.Text("+14155552671"),
 					PasswordHash:             pgtype.Text{}, //nolint:exhaustruct
 					EmailVerified:            false,
 					PhoneNumberVerified:      false,
@@ -468,7 +484,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options:     nil,
 				},
 			},
@@ -480,7 +496,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					mock.EXPECT().SendVerificationCode(
-						"+1234567890",
+						"+14155552671",
 						"en",
 					).Return("hashedOTP", time.Now().Add(time.Minute*5), nil)
 
@@ -489,6 +505,140 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 		},
 
+		{
+			name:   "signup not required - phone number normalized",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient { //nolint:dupl
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+14155552671"),
+				).Return(sql.AuthUser{
+					ID:                       userID,
+					CreatedAt:                pgtype.Timestamptz{}, //nolint:exhaustruct
+					UpdatedAt:                pgtype.Timestamptz{}, //nolint:exhaustruct
+					LastSeen:                 pgtype.Timestamptz{}, //nolint:exhaustruct
+					Disabled:                 false,
+					DisplayName:              "+14155552671",
+					AvatarUrl:                "",
+					Locale:                   "en",
+					Email:                    pgtype.Text{}, //nolint:exhaustruct
+					PhoneNumber:              sql.Text("+14155552671"),
+					PasswordHash:             pgtype.Text{}, //nolint:exhaustruct
+					EmailVerified:            false,
+					PhoneNumberVerified:      false,
+					NewEmail:                 pgtype.Text{},        //nolint:exhaustruct
+					OtpMethodLastUsed:        pgtype.Text{},        //nolint:exhaustruct
+					OtpHash:                  pgtype.Text{},        //nolint:exhaustruct
+					OtpHashExpiresAt:         pgtype.Timestamptz{}, //nolint:exhaustruct
+					DefaultRole:              "",
+					IsAnonymous:              false,
+					TotpSecret:               pgtype.Text{},        //nolint:exhaustruct
+					ActiveMfaType:            pgtype.Text{},        //nolint:exhaustruct
+					Ticket:                   pgtype.Text{},        //nolint:exhaustruct
+					TicketExpiresAt:          pgtype.Timestamptz{}, //nolint:exhaustruct
+					Metadata:                 []byte{},
+					WebauthnCurrentChallenge: pgtype.Text{}, //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().UpdateUserOTPHash(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserOTPHashParams{
+						ID:                userID,
+						OtpHash:           sql.Text("hashedOTP"),
+						OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(time.Minute * 5)),
+						OtpMethodLastUsed: sql.Text("sms"),
+					},
+						testhelpers.FilterPathLast(
+							[]string{".OtpHash", "text()"},
+							cmp.Comparer(func(x, y string) bool { return x != "" && y != "" }),
+						),
+						testhelpers.FilterPathLast(
+							[]string{
+								".OtpHashExpiresAt",
+								"time()",
+							},
+							cmpopts.EquateApproxTime(time.Minute),
+						),
+					),
+				).Return(userID, nil)
+
+				return mock
+			},
+			request: api.SignInPasswordlessSmsRequestObject{
+				Body: &api.SignInPasswordlessSmsRequest{
+					PhoneNumber: "+1 (415) 555-2671",
+					Options:     nil,
+				},
+			},
+			expectedResponse: api.SignInPasswordlessSms200JSONResponse(api.OK),
+			jwtTokenFn:       nil,
+			expectedJWT:      nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withSMS(func(ctrl *gomock.Controller) *mock.MockSMSer {
+					mock := mock.NewMockSMSer(ctrl)
+
+					mock.EXPECT().SendVerificationCode(
+						"+14155552671",
+						"en",
+					).Return("hashedOTP", time.Now().Add(time.Minute*5), nil)
+
+					return mock
+				}),
+			},
+		},
+
+		{
+			name:   "invalid phone number",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.SignInPasswordlessSmsRequestObject{
+				Body: &api.SignInPasswordlessSmsRequest{
+					PhoneNumber: "+1 (555) 123-4567",
+					Options:     nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-phone-number",
+				Message: "Invalid phone number",
+				Status:  400,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "phone number country not allowed",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.BlockedPhoneCountryCodes = []string{"US"}
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.SignInPasswordlessSmsRequestObject{
+				Body: &api.SignInPasswordlessSmsRequest{
+					PhoneNumber: "+14155552671",
+					Options:     nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "phone-number-not-allowed",
+				Message: "Phone number not allowed",
+				Status:  403,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
 		{
 			name:   "signup not required - user disabled",
 			config: getConfig,
@@ -497,18 +647,18 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 				mock.EXPECT().GetUserByPhoneNumber(
 					gomock.Any(),
-					sql.Text("+1234567890"),
+					sql.Text("+14155552671"),
 				).Return(sql.AuthUser{
 					ID:                       userID,
 					CreatedAt:                pgtype.Timestamptz{}, //nolint:exhaustruct
 					UpdatedAt:                pgtype.Timestamptz{}, //nolint:exhaustruct
 					LastSeen:                 pgtype.Timestamptz{}, //nolint:exhaustruct
 					Disabled:                 true,
-					DisplayName:              "+1234567890",
+					DisplayName:              "+14155552671",
 					AvatarUrl:                "",
 					Locale:                   "en",
 					Email:                    pgtype.Text{}, //nolint:exhaustruct
-					PhoneNumber:              sql.Text("+1234567890"),
+					PhoneNumber:              sql.Text("+14155552671"),
 					PasswordHash:             pgtype.Text{}, //nolint:exhaustruct
 					EmailVerified:            false,
 					PhoneNumberVerified:      false,
@@ -530,7 +680,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options:     nil,
 				},
 			},
@@ -550,18 +700,18 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 				mock.EXPECT().GetUserByPhoneNumber(
 					gomock.Any(),
-					sql.Text("+1234567890"),
+					sql.Text("+14155552671"),
 				).Return(sql.AuthUser{
 					ID:                       userID,
 					CreatedAt:                pgtype.Timestamptz{}, //nolint:exhaustruct
 					UpdatedAt:                pgtype.Timestamptz{}, //nolint:exhaustruct
 					LastSeen:                 pgtype.Timestamptz{}, //nolint:exhaustruct
 					Disabled:                 false,
-					DisplayName:              "+1234567890",
+					DisplayName:              "+14155552671",
 					AvatarUrl:                "",
 					Locale:                   "en",
 					Email:                    pgtype.Text{}, //nolint:exhaustruct
-					PhoneNumber:              sql.Text("+1234567890"),
+					PhoneNumber:              sql.Text("+14155552671"),
 					PasswordHash:             pgtype.Text{}, //nolint:exhaustruct
 					EmailVerified:            false,
 					PhoneNumberVerified:      false,
@@ -583,7 +733,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.SignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Options:     nil,
 				},
 			},
@@ -597,7 +747,7 @@ func TestSignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					mock.EXPECT().SendVerificationCode(
-						"+1234567890",
+						"+14155552671",
 						"en",
 					).Return("", time.Time{}, errors.New("SMS service error")) //nolint:err113
 
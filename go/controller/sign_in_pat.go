@@ -27,9 +27,11 @@ func (ctrl *Controller) SignInPAT( //nolint:ireturn
 	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(getTokenError(err)), nil
 	}
 
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodPAT, logger)
+
 	return api.SignInPAT200JSONResponse{
 		Session: session,
 	}, nil
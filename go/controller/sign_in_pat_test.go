@@ -1,9 +1,12 @@
 package controller_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -11,6 +14,7 @@ import (
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
@@ -395,3 +399,127 @@ func TestSignInPAT(t *testing.T) { //nolint:maintidx
 		})
 	}
 }
+
+func TestSignInPATNewDevice(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	pat := uuid.MustParse("1fb17604-86c7-444e-b337-09a644465f2d")
+
+	cases := []struct {
+		name          string
+		auditLog      bool
+		history       sql.GetUserSignInHistoryRow
+		expectedEmail bool
+	}{
+		{
+			name:          "new device",
+			auditLog:      true,
+			history:       sql.GetUserSignInHistoryRow{HasSignedIn: true, FromKnownDevice: false},
+			expectedEmail: true,
+		},
+		{
+			name:          "known device",
+			auditLog:      true,
+			history:       sql.GetUserSignInHistoryRow{HasSignedIn: true, FromKnownDevice: true},
+			expectedEmail: false,
+		},
+		{
+			name:          "first sign-in",
+			auditLog:      true,
+			history:       sql.GetUserSignInHistoryRow{HasSignedIn: false, FromKnownDevice: false},
+			expectedEmail: false,
+		},
+		{
+			name:          "audit log disabled",
+			auditLog:      false,
+			history:       sql.GetUserSignInHistoryRow{HasSignedIn: false, FromKnownDevice: false},
+			expectedEmail: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			config := func() *controller.Config {
+				cfg := getConfig()
+				cfg.AuditLogEnabled = tc.auditLog
+				cfg.SecurityNotifications = []string{"signin-new-device"}
+				return cfg
+			}
+
+			db := func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(), gomock.Any(),
+				).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(nil, nil)
+				mock.EXPECT().InsertRefreshtoken(gomock.Any(), gomock.Any()).Return(uuid.New(), nil)
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				if tc.auditLog {
+					mock.EXPECT().GetUserSignInHistory(
+						gomock.Any(),
+						sql.GetUserSignInHistoryParams{
+							UserID:    userID,
+							IpAddress: sql.Text("192.0.2.1"),
+							UserAgent: sql.Text("Mozilla/5.0"),
+						},
+					).Return(tc.history, nil)
+					mock.EXPECT().InsertAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+				}
+
+				if tc.expectedEmail {
+					mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				}
+
+				return mock
+			}
+
+			emailer := func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				if tc.expectedEmail {
+					mock.EXPECT().SendEmail(
+						gomock.Any(),
+						"jane@acme.com",
+						"en",
+						notifications.TemplateNameSigninNewDevice,
+						gomock.Cond(func(data notifications.TemplateData) bool {
+							return data.IPAddress == "192.0.2.1" && data.UserAgent == "Mozilla/5.0"
+						}),
+					).Return(nil)
+				}
+
+				return mock
+			}
+
+			c, _ := getController(t, ctrl, config, db, withEmailer(emailer))
+
+			req := httptest.NewRequest(http.MethodPost, "/signin/pat", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			req.Header.Set("User-Agent", "Mozilla/5.0")
+			ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ginCtx.Request = req
+
+			resp, err := c.SignInPAT(ginCtx, api.SignInPATRequestObject{
+				Body: &api.SignInPATRequest{
+					PersonalAccessToken: pat.String(),
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _, ok := resp.(api.SignInPAT200JSONResponse); !ok {
+				t.Fatalf("unexpected response: %#v", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) SignInPhonePassword( //nolint:ireturn
+	ctx context.Context, request api.SignInPhonePasswordRequestObject,
+) (api.SignInPhonePasswordResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("phoneNumber", request.Body.PhoneNumber))
+
+	if !ctrl.config.PhonePasswordEnabled {
+		logger.Warn("phone password signin is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	phoneNumber, apiErr := ctrl.wf.ValidatePhoneNumber(request.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	request.Body.PhoneNumber = phoneNumber
+
+	user, apiErr := ctrl.wf.GetUserByPhoneNumber(ctx, request.Body.PhoneNumber, logger)
+	switch {
+	case errors.Is(apiErr, ErrUserPhoneNumberNotFound):
+		return ctrl.sendError(ErrInvalidPhoneNumberPassword), nil
+	case apiErr != nil:
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if !verifyHashPassword(request.Body.Password, user.PasswordHash.String) {
+		logger.Warn("password doesn't match")
+		ctrl.wf.RecordAuditEvent(
+			ctx, user.ID, api.SigninFailed, map[string]any{"method": signInMethodPhonePassword}, logger,
+		)
+		return ctrl.sendError(ErrInvalidPhoneNumberPassword), nil
+	}
+
+	if !user.PhoneNumberVerified {
+		logger.Warn("phone number is not verified")
+		return ctrl.sendError(ErrUnverifiedUser), nil
+	}
+
+	if apiErr := ctrl.wf.ValidateUserEmailOptional(user, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	mfa, apiErr := ctrl.mfaChallenge(ctx, user, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+	if mfa != nil {
+		return api.SignInPhonePassword200JSONResponse{
+			Mfa:     mfa,
+			Session: nil,
+		}, nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(getTokenError(err)), nil
+	}
+
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodPhonePassword, logger)
+
+	return api.SignInPhonePassword200JSONResponse{
+		Session: session,
+		Mfa:     nil,
+	}, nil
+}
@@ -0,0 +1,408 @@
+package controller_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func getPhonePasswordUser(userID uuid.UUID) sql.AuthUser {
+	user := getSigninUser(userID)
+	user.Email = pgtype.Text{} //nolint:exhaustruct
+	user.EmailVerified = false
+	user.PhoneNumber = sql.Text("+14155552671")
+	user.PhoneNumberVerified = true
+	return user
+}
+
+func TestSignInPhonePassword(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.PhonePasswordEnabled = true
+		return config
+	}
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.SignInPhonePasswordRequestObject, api.SignInPhonePasswordResponseObject]{
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(getPhonePasswordUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			request: api.SignInPhonePasswordRequestObject{
+				Body: &api.SignInPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+				},
+			},
+			expectedResponse: api.SignInPhonePassword200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               nil,
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         ptr("+14155552671"),
+						PhoneNumberVerified: true,
+						Roles:               []string{"user", "me"},
+						ActiveMfaType:       nil,
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "legacy phone number",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				legacyUser := getPhonePasswordUser(userID)
+				legacyUser.PhoneNumber = sql.Text("+1 (415) 555-2671")
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return([]sql.AuthUser{legacyUser}, nil)
+
+				mock.EXPECT().UpdateUserPhoneNumber(
+					gomock.Any(),
+					sql.UpdateUserPhoneNumberParams{
+						ID:          userID,
+						PhoneNumber: sql.Text("+14155552671"),
+					},
+				).Return(nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			request: api.SignInPhonePasswordRequestObject{
+				Body: &api.SignInPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+				},
+			},
+			expectedResponse: api.SignInPhonePassword200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               nil,
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         ptr("+14155552671"),
+						PhoneNumberVerified: true,
+						Roles:               []string{"user", "me"},
+						ActiveMfaType:       nil,
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.PhonePasswordEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.SignInPhonePasswordRequestObject{
+				Body: &api.SignInPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return(nil, nil)
+
+				return mock
+			},
+			request: api.SignInPhonePasswordRequestObject{
+				Body: &api.SignInPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-phone-number-password",
+				Message: "Incorrect phone number or password",
+				Status:  401,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "ambiguous legacy phone number",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				legacyUser := getPhonePasswordUser(userID)
+				legacyUser.PhoneNumber = sql.Text("+1 (415) 555-2671")
+				otherUser := getPhonePasswordUser(uuid.New())
+				otherUser.PhoneNumber = sql.Text("14155552671")
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return([]sql.AuthUser{legacyUser, otherUser}, nil)
+
+				return mock
+			},
+			request: api.SignInPhonePasswordRequestObject{
+				Body: &api.SignInPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-phone-number-password",
+				Message: "Incorrect phone number or password",
+				Status:  401,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "wrong password",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(getPhonePasswordUser(userID), nil)
+
+				return mock
+			},
+			request: api.SignInPhonePasswordRequestObject{
+				Body: &api.SignInPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "wrongpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-phone-number-password",
+				Message: "Incorrect phone number or password",
+				Status:  401,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "phone number not verified",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getPhonePasswordUser(userID)
+				user.PhoneNumberVerified = false
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.SignInPhonePasswordRequestObject{
+				Body: &api.SignInPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unverified-user",
+				Message: "User is not verified.",
+				Status:  401,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			resp := assertRequest(
+				t.Context(), t, c.SignInPhonePassword, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.SignInPhonePassword200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
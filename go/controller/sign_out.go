@@ -21,6 +21,9 @@ func (ctrl *Controller) SignOut( //nolint:ireturn
 		if apiErr := ctrl.wf.DeleteUserRefreshTokens(ctx, userID, logger); apiErr != nil {
 			return ctrl.sendError(apiErr), nil
 		}
+
+		ctrl.wf.RecordAuditEvent(ctx, userID, api.Signout, map[string]any{"all": true}, logger)
+
 		return api.SignOut200JSONResponse(api.OK), nil
 	}
 
@@ -34,5 +37,13 @@ func (ctrl *Controller) SignOut( //nolint:ireturn
 		return ctrl.sendError(apiErr), nil
 	}
 
+	// the refresh token doesn't tell us who the user is, so we can only record
+	// the event if the request is authenticated
+	if jwtToken, ok := ctrl.wf.jwtGetter.FromContext(ctx); ok {
+		if userID, err := ctrl.wf.jwtGetter.GetUserID(jwtToken); err == nil {
+			ctrl.wf.RecordAuditEvent(ctx, userID, api.Signout, map[string]any{"all": false}, logger)
+		}
+	}
+
 	return api.SignOut200JSONResponse(api.OK), nil
 }
@@ -30,7 +30,7 @@ func (ctrl *Controller) postSignupEmailPasswordValidateRequest(
 	}
 
 	options, err := ctrl.wf.ValidateSignUpOptions(
-		req.Body.Options, string(req.Body.Email), logger,
+		ctx, req.Body.Options, string(req.Body.Email), logger,
 	)
 	if err != nil {
 		return api.SignUpEmailPasswordRequestObject{}, err
@@ -130,8 +130,8 @@ func (ctrl *Controller) postSignupEmailPasswordWithoutSession(
 		ticketExpiresAt pgtype.Timestamptz,
 		metadata []byte,
 		gravatarURL string,
-	) error {
-		_, err := ctrl.wf.db.InsertUser(ctx, sql.InsertUserParams{
+	) (uuid.UUID, error) {
+		resp, err := ctrl.wf.db.InsertUser(ctx, sql.InsertUserParams{
 			ID:                uuid.New(),
 			Disabled:          ctrl.config.DisableNewUsers,
 			DisplayName:       deptr(options.DisplayName),
@@ -151,8 +151,8 @@ func (ctrl *Controller) postSignupEmailPasswordWithoutSession(
 			OtpMethodLastUsed: pgtype.Text{},        //nolint:exhaustruct
 		})
 		if err != nil {
-			return fmt.Errorf("error inserting user: %w", err)
+			return uuid.Nil, fmt.Errorf("error inserting user: %w", err)
 		}
-		return nil
+		return resp.UserID, nil
 	}
 }
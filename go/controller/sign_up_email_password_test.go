@@ -1,7 +1,10 @@
 package controller_test
 
 import (
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -13,13 +16,35 @@ import (
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/hooks"
 	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 	"github.com/nhost/hasura-auth/go/testhelpers"
+	"github.com/nhost/hasura-auth/go/webhooks"
 	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
 )
 
+func beforeSignUpHookStub(t *testing.T, email string, response string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req hooks.BeforeSignUpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("error decoding hook request: %v", err)
+		}
+
+		if req.Email != email {
+			t.Errorf("unexpected email in hook request: %s", req.Email)
+		}
+
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
 func TestSignUpEmailPassword(t *testing.T) { //nolint:maintidx
 	t.Parallel()
 
@@ -31,6 +56,11 @@ func TestSignUpEmailPassword(t *testing.T) { //nolint:maintidx
 		RefreshTokenID: refreshTokenID,
 	}
 
+	modifyHook := beforeSignUpHookStub(
+		t, "jane@acme.com", `{"options": {"displayName": "Jane from the hook", "metadata": {"plan": "free"}}}`,
+	)
+	rejectHook := beforeSignUpHookStub(t, "jane@acme.com", `{"reject": true, "reason": "not today"}`)
+
 	cases := []testRequest[api.SignUpEmailPasswordRequestObject, api.SignUpEmailPasswordResponseObject]{
 		{
 			name:   "simple",
@@ -118,6 +148,242 @@ func TestSignUpEmailPassword(t *testing.T) { //nolint:maintidx
 			getControllerOpts: []getControllerOptsFunc{},
 		},
 
+		{
+			name: "before-signup hook modifies options",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.HookBeforeSignUpURL = modifyHook.URL
+				c.HookSecret = "secret"
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithRefreshToken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserWithRefreshTokenParams{
+						Disabled:              false,
+						DisplayName:           "Jane from the hook",
+						AvatarUrl:             "",
+						Email:                 sql.Text("jane@acme.com"),
+						PasswordHash:          pgtype.Text{}, //nolint:exhaustruct
+						Ticket:                pgtype.Text{}, //nolint:exhaustruct
+						TicketExpiresAt:       sql.TimestampTz(time.Now()),
+						EmailVerified:         false,
+						Locale:                "en",
+						DefaultRole:           "user",
+						Metadata:              []byte(`{"plan":"free"}`),
+						Roles:                 []string{"user", "me"},
+						IsAnonymous:           false,
+						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+					}),
+				).Return(insertResponse, nil)
+
+				return mock
+			},
+			request: api.SignUpEmailPasswordRequestObject{
+				Body: &api.SignUpEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+					Options:  nil,
+				},
+			},
+			expectedResponse: api.SignUpEmailPassword200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane from the hook",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{"plan": "free"},
+						PhoneNumber:         nil,
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+						ActiveMfaType:       nil,
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "before-signup hook rejects",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.HookBeforeSignUpURL = rejectHook.URL
+				c.HookSecret = "secret"
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.SignUpEmailPasswordRequestObject{
+				Body: &api.SignUpEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+					Options:  nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "hook-rejected",
+				Message: "The request was rejected",
+				Status:  403,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "simple with webhook subscription",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.WebhookSubscriptions = []webhooks.Subscription{
+					{
+						URL:    "https://example.com/hooks",
+						Events: []webhooks.Event{webhooks.EventUserSignedUp},
+						Secret: "secret",
+					},
+					{
+						URL:    "https://example.com/other",
+						Events: []webhooks.Event{webhooks.EventUserDeleted},
+						Secret: "secret",
+					},
+				}
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithRefreshToken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserWithRefreshTokenParams{
+						Disabled:              false,
+						DisplayName:           "jane@acme.com",
+						AvatarUrl:             "",
+						Email:                 sql.Text("jane@acme.com"),
+						PasswordHash:          pgtype.Text{}, //nolint:exhaustruct
+						Ticket:                pgtype.Text{}, //nolint:exhaustruct
+						TicketExpiresAt:       sql.TimestampTz(time.Now()),
+						EmailVerified:         false,
+						Locale:                "en",
+						DefaultRole:           "user",
+						Metadata:              []byte("null"),
+						Roles:                 []string{"user", "me"},
+						IsAnonymous:           false,
+						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+					}),
+				).Return(insertResponse, nil)
+
+				mock.EXPECT().InsertWebhookOutbox(
+					gomock.Any(),
+					gomock.Cond(func(arg sql.InsertWebhookOutboxParams) bool {
+						var payload webhooks.Payload
+						if err := json.Unmarshal(arg.Payload, &payload); err != nil {
+							return false
+						}
+
+						return arg.Event == string(webhooks.EventUserSignedUp) &&
+							arg.Url == "https://example.com/hooks" &&
+							payload.Event == webhooks.EventUserSignedUp &&
+							payload.Data["userId"] == userID.String() &&
+							payload.Data["email"] == "jane@acme.com"
+					}),
+				).Return(nil)
+
+				return mock
+			},
+			request: api.SignUpEmailPasswordRequestObject{
+				Body: &api.SignUpEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+					Options:  nil,
+				},
+			},
+			expectedResponse: api.SignUpEmailPassword200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "jane@acme.com",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            nil,
+						PhoneNumber:         nil,
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+						ActiveMfaType:       nil,
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
 		{
 			name:   "simple with options",
 			config: getConfig,
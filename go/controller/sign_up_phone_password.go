@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) postSignupPhonePasswordValidateRequest(
+	ctx context.Context, req api.SignUpPhonePasswordRequestObject, logger *slog.Logger,
+) (api.SignUpPhonePasswordRequestObject, *APIError) {
+	if ctrl.config.DisableSignup {
+		logger.Warn("signup disabled")
+		return api.SignUpPhonePasswordRequestObject{}, ErrSignupDisabled
+	}
+
+	phoneNumber, apiErr := ctrl.wf.ValidatePhoneNumber(req.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return api.SignUpPhonePasswordRequestObject{}, apiErr
+	}
+	req.Body.PhoneNumber = phoneNumber
+
+	_, err := ctrl.wf.lookupUserByPhoneNumber(ctx, req.Body.PhoneNumber, logger)
+	switch {
+	case err == nil, errors.Is(err, errAmbiguousPhoneNumber):
+		logger.Warn("phone number already in use")
+		return api.SignUpPhonePasswordRequestObject{}, ErrPhoneNumberAlreadyInUse
+	case !errors.Is(err, pgx.ErrNoRows):
+		logger.Error("error getting user by phone number", logError(err))
+		return api.SignUpPhonePasswordRequestObject{}, ErrInternalServerError
+	}
+
+	if err := ctrl.wf.ValidatePassword(ctx, req.Body.Password, logger); err != nil {
+		return api.SignUpPhonePasswordRequestObject{}, err
+	}
+
+	options, apiErr := ctrl.wf.ValidateSignUpOptions(
+		ctx, req.Body.Options, req.Body.PhoneNumber, logger,
+	)
+	if apiErr != nil {
+		return api.SignUpPhonePasswordRequestObject{}, apiErr
+	}
+
+	req.Body.Options = options
+
+	return req, nil
+}
+
+func (ctrl *Controller) SignUpPhonePassword( //nolint:ireturn
+	ctx context.Context,
+	req api.SignUpPhonePasswordRequestObject,
+) (api.SignUpPhonePasswordResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("phoneNumber", req.Body.PhoneNumber))
+
+	if !ctrl.config.PhonePasswordEnabled {
+		logger.Warn("phone password signup is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	req, apiErr := ctrl.postSignupPhonePasswordValidateRequest(ctx, req, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if ctrl.wf.sms == nil {
+		logger.Error("phone password is enabled but no SMS provider is configured")
+		return ctrl.sendError(ErrCannotSendSMS), nil
+	}
+
+	hashedPassword, err := hashPassword(req.Body.Password)
+	if err != nil {
+		logger.Error("error hashing password", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	// the user is inserted before the code is sent so an SMS is only sent once
+	// the before sign-up hook and the database accepted the user
+	var userID uuid.UUID
+	options := req.Body.Options
+	if apiErr := ctrl.wf.SignupUserWithouthSession(
+		ctx,
+		"", // email is empty for phone number signup
+		options,
+		false,
+		func(
+			_ pgtype.Text,
+			_ pgtype.Timestamptz,
+			metadata []byte,
+			gravatarURL string,
+		) (uuid.UUID, error) {
+			resp, err := ctrl.wf.db.InsertUser(ctx, sql.InsertUserParams{
+				ID:                uuid.New(),
+				Disabled:          ctrl.config.DisableNewUsers,
+				DisplayName:       deptr(options.DisplayName),
+				AvatarUrl:         gravatarURL,
+				PhoneNumber:       sql.Text(req.Body.PhoneNumber),
+				PasswordHash:      sql.Text(hashedPassword),
+				OtpHash:           pgtype.Text{},        //nolint:exhaustruct
+				OtpHashExpiresAt:  pgtype.Timestamptz{}, //nolint:exhaustruct
+				OtpMethodLastUsed: pgtype.Text{},        //nolint:exhaustruct
+				Email:             pgtype.Text{},        //nolint:exhaustruct
+				Ticket:            pgtype.Text{},        //nolint:exhaustruct
+				TicketExpiresAt:   pgtype.Timestamptz{}, //nolint:exhaustruct
+				EmailVerified:     false,
+				Locale:            deptr(options.Locale),
+				DefaultRole:       deptr(options.DefaultRole),
+				Metadata:          metadata,
+				Roles:             deptr(options.AllowedRoles),
+			})
+			if err != nil {
+				return uuid.Nil, fmt.Errorf("error inserting user: %w", err)
+			}
+
+			userID = resp.UserID
+			return resp.UserID, nil
+		},
+		logger,
+	); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.SendPhoneNumberOTP(
+		ctx,
+		sql.AuthUser{ //nolint:exhaustruct
+			ID:          userID,
+			PhoneNumber: sql.Text(req.Body.PhoneNumber),
+			Locale:      deptr(options.Locale),
+		},
+		otpMethodPhoneVerification,
+		logger,
+	); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	return api.SignUpPhonePassword200JSONResponse(api.OK), nil
+}
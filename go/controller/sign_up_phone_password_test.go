@@ -0,0 +1,313 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSignUpPhonePassword(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.PhonePasswordEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("DB477732-48FA-4289-B694-2886A646B6EB")
+
+	cases := []testRequest[api.SignUpPhonePasswordRequestObject, api.SignUpPhonePasswordResponseObject]{
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+14155552671"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return(nil, nil)
+
+				mock.EXPECT().InsertUser(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserParams{
+						ID:                uuid.UUID{},
+						Disabled:          false,
+						DisplayName:       "+14155552671",
+						AvatarUrl:         "",
+						PhoneNumber:       sql.Text("+14155552671"),
+						OtpHash:           pgtype.Text{},        //nolint:exhaustruct
+						OtpHashExpiresAt:  pgtype.Timestamptz{}, //nolint:exhaustruct
+						OtpMethodLastUsed: pgtype.Text{},        //nolint:exhaustruct
+						Email:             pgtype.Text{},        //nolint:exhaustruct
+						PasswordHash: sql.Text(
+							"$2a$10$pyv7eu9ioQcFnLSz7u/enex22P3ORdh6z6116Vj5a3vSjo0oxFa1u",
+						),
+						Ticket:          pgtype.Text{},        //nolint:exhaustruct
+						TicketExpiresAt: pgtype.Timestamptz{}, //nolint:exhaustruct
+						EmailVerified:   false,
+						Locale:          "en",
+						DefaultRole:     "user",
+						Metadata:        []byte("null"),
+						Roles:           []string{"user", "me"},
+					},
+						cmpopts.IgnoreFields(sql.InsertUserParams{}, "ID"), //nolint:exhaustruct
+						testhelpers.FilterPathLast(
+							[]string{
+								".OtpHashExpiresAt",
+								"time()",
+							},
+							cmpopts.EquateApproxTime(time.Minute),
+						),
+					),
+				).Return(sql.InsertUserRow{
+					UserID:    userID,
+					CreatedAt: sql.TimestampTz(time.Now()),
+				}, nil)
+
+				mock.EXPECT().UpdateUserOTPHash(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserOTPHashParams{
+						ID:                userID,
+						OtpHash:           sql.Text("hashedOTP"),
+						OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(time.Minute * 5)),
+						OtpMethodLastUsed: sql.Text("phone-verification"),
+					},
+						testhelpers.FilterPathLast(
+							[]string{".OtpHashExpiresAt", "time()"},
+							cmpopts.EquateApproxTime(time.Minute),
+						),
+					),
+				).Return(userID, nil)
+
+				return mock
+			},
+			request: api.SignUpPhonePasswordRequestObject{
+				Body: &api.SignUpPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+					Options:     nil,
+				},
+			},
+			expectedResponse: api.SignUpPhonePassword200JSONResponse(api.OK),
+			jwtTokenFn:       nil,
+			expectedJWT:      nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withSMS(func(ctrl *gomock.Controller) *mock.MockSMSer {
+					mock := mock.NewMockSMSer(ctrl)
+
+					mock.EXPECT().SendVerificationCode(
+						"+14155552671",
+						"en",
+					).Return("hashedOTP", time.Now().Add(time.Minute*5), nil)
+
+					return mock
+				}),
+			},
+		},
+
+		{
+			name:   "insert fails, no SMS sent",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+14155552671"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return(nil, nil)
+
+				mock.EXPECT().InsertUser(gomock.Any(), gomock.Any()).Return(
+					sql.InsertUserRow{}, errors.New("database error"), //nolint:exhaustruct,err113
+				)
+
+				return mock
+			},
+			request: api.SignUpPhonePasswordRequestObject{
+				Body: &api.SignUpPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+					Options:     nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "internal-server-error",
+				Message: "Internal server error",
+				Status:  500,
+			},
+			jwtTokenFn:  nil,
+			expectedJWT: nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withSMS(func(ctrl *gomock.Controller) *mock.MockSMSer {
+					return mock.NewMockSMSer(ctrl)
+				}),
+			},
+		},
+
+		{
+			name: "disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.PhonePasswordEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.SignUpPhonePasswordRequestObject{
+				Body: &api.SignUpPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+					Options:     nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "phone number already in use",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+14155552671"),
+				).Return(getPhonePasswordUser(userID), nil)
+
+				return mock
+			},
+			request: api.SignUpPhonePasswordRequestObject{
+				Body: &api.SignUpPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+					Options:     nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "phone-number-already-in-use",
+				Message: "Phone number already in use",
+				Status:  409,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "phone number used by several legacy users",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+14155552671"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return([]sql.AuthUser{
+					getPhonePasswordUser(userID), getPhonePasswordUser(uuid.New()),
+				}, nil)
+
+				return mock
+			},
+			request: api.SignUpPhonePasswordRequestObject{
+				Body: &api.SignUpPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+					Options:     nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "phone-number-already-in-use",
+				Message: "Phone number already in use",
+				Status:  409,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "password too short",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.PasswordMinLength = 12
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+14155552671"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetUsersByPhoneNumberLegacy(
+					gomock.Any(), "14155552671",
+				).Return(nil, nil)
+
+				return mock
+			},
+			request: api.SignUpPhonePasswordRequestObject{
+				Body: &api.SignUpPhonePasswordRequest{
+					PhoneNumber: "+14155552671",
+					Password:    "password",
+					Options:     nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "password-too-short",
+				Message: "Password is too short",
+				Status:  400,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			assertRequest(
+				t.Context(), t, c.SignUpPhonePassword, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
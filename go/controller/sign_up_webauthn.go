@@ -10,6 +10,7 @@ import (
 )
 
 func (ctrl *Controller) postSignupWebauthnValidateRequest(
+	ctx context.Context,
 	request api.SignUpWebauthnRequestObject,
 	logger *slog.Logger,
 ) (*api.SignUpOptions, *APIError) {
@@ -24,7 +25,7 @@ func (ctrl *Controller) postSignupWebauthnValidateRequest(
 	}
 
 	options, apiErr := ctrl.wf.ValidateSignUpOptions(
-		request.Body.Options, string(request.Body.Email), logger,
+		ctx, request.Body.Options, string(request.Body.Email), logger,
 	)
 	if apiErr != nil {
 		return nil, apiErr
@@ -44,7 +45,7 @@ func (ctrl *Controller) SignUpWebauthn( //nolint:ireturn
 	logger := middleware.LoggerFromContext(ctx).
 		With(slog.String("email", string(request.Body.Email)))
 
-	options, apiErr := ctrl.postSignupWebauthnValidateRequest(request, logger)
+	options, apiErr := ctrl.postSignupWebauthnValidateRequest(ctx, request, logger)
 	if apiErr != nil {
 		return ctrl.sendError(apiErr), nil
 	}
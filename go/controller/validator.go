@@ -2,12 +2,19 @@
 package controller
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"slices"
 	"strings"
 
 	"github.com/gobwas/glob"
+	"github.com/nyaruka/phonenumbers"
+)
+
+var (
+	errPhoneNumberInvalid    = errors.New("invalid phone number")
+	errPhoneNumberNotAllowed = errors.New("phone number country not allowed")
 )
 
 func ValidateRedirectTo( //nolint:cyclop
@@ -105,3 +112,45 @@ func ValidateEmail(
 		return len(allowedEmailDomains) == 0 && len(allowedEmails) == 0
 	}
 }
+
+// NormalizePhoneNumber returns a function that parses a phone number in
+// international format, validates it against the numbering plan of its
+// country and returns it in E.164 format. Country codes are ISO 3166-1
+// alpha-2 codes, blocked ones take precedence and an empty allow list
+// accepts every country.
+func NormalizePhoneNumber(
+	allowedCountryCodes []string,
+	blockedCountryCodes []string,
+) func(phoneNumber string) (string, error) {
+	allowed := make([]string, len(allowedCountryCodes))
+	for i, c := range allowedCountryCodes {
+		allowed[i] = strings.ToUpper(c)
+	}
+
+	blocked := make([]string, len(blockedCountryCodes))
+	for i, c := range blockedCountryCodes {
+		blocked[i] = strings.ToUpper(c)
+	}
+
+	return func(phoneNumber string) (string, error) {
+		num, err := phonenumbers.Parse(phoneNumber, "")
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", errPhoneNumberInvalid, err)
+		}
+
+		if !phonenumbers.IsValidNumber(num) {
+			return "", errPhoneNumberInvalid
+		}
+
+		region := phonenumbers.GetRegionCodeForNumber(num)
+		if slices.Contains(blocked, region) {
+			return "", errPhoneNumberNotAllowed
+		}
+
+		if len(allowed) > 0 && !slices.Contains(allowed, region) {
+			return "", errPhoneNumberNotAllowed
+		}
+
+		return phonenumbers.Format(num, phonenumbers.E164), nil
+	}
+}
@@ -58,6 +58,18 @@ func getConfig() *controller.Config {
 		SMSTwilioAccountSid:         "smsAccountSid",
 		SMSTwilioAuthToken:          "smsAuthToken",
 		SMSTwilioMessagingServiceID: "smsMessagingServiceID",
+		ImpersonationEnabled:        false,
+		AuditLogEnabled:             false,
+		WebhookSubscriptions:        nil,
+		HookBeforeSignUpURL:         "",
+		HookBeforeTokenURL:          "",
+		HookSecret:                  "",
+		HookTimeout:                 0,
+		HookFailOpen:                false,
+		OrganizationsEnabled:        false,
+		PhonePasswordEnabled:        false,
+		AllowedPhoneCountryCodes:    []string{},
+		BlockedPhoneCountryCodes:    []string{},
 	}
 }
 
@@ -357,3 +369,110 @@ func TestValidateEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		allowed     []string
+		blocked     []string
+		phoneNumber string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:        "e164",
+			allowed:     []string{},
+			blocked:     []string{},
+			phoneNumber: "+14155552671",
+			expected:    "+14155552671",
+			expectedErr: false,
+		},
+		{
+			name:        "formatted",
+			allowed:     []string{},
+			blocked:     []string{},
+			phoneNumber: "+1 (415) 555-2671",
+			expected:    "+14155552671",
+			expectedErr: false,
+		},
+		{
+			name:        "invalid for country",
+			allowed:     []string{},
+			blocked:     []string{},
+			phoneNumber: "+1 (555) 123-4567",
+			expected:    "",
+			expectedErr: true,
+		},
+		{
+			name:        "missing country code",
+			allowed:     []string{},
+			blocked:     []string{},
+			phoneNumber: "4155552671",
+			expected:    "",
+			expectedErr: true,
+		},
+		{
+			name:        "empty",
+			allowed:     []string{},
+			blocked:     []string{},
+			phoneNumber: "",
+			expected:    "",
+			expectedErr: true,
+		},
+		{
+			name:        "allowed country",
+			allowed:     []string{"us", "GB"},
+			blocked:     []string{},
+			phoneNumber: "+44 20 7183 8750",
+			expected:    "+442071838750",
+			expectedErr: false,
+		},
+		{
+			name:        "country not in allowed list",
+			allowed:     []string{"US"},
+			blocked:     []string{},
+			phoneNumber: "+442071838750",
+			expected:    "",
+			expectedErr: true,
+		},
+		{
+			name:        "blocked country",
+			allowed:     []string{},
+			blocked:     []string{"GB"},
+			phoneNumber: "+442071838750",
+			expected:    "",
+			expectedErr: true,
+		},
+		{
+			name:        "blocking takes precedence over allowing",
+			allowed:     []string{"GB"},
+			blocked:     []string{"GB"},
+			phoneNumber: "+442071838750",
+			expected:    "",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fn := controller.NormalizePhoneNumber(tc.allowed, tc.blocked)
+			got, err := fn(tc.phoneNumber)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error for %s: %v", tc.phoneNumber, err)
+			}
+
+			if got != tc.expected {
+				t.Errorf(
+					"unexpected result for %s: got %s, expected %s",
+					tc.phoneNumber,
+					got,
+					tc.expected,
+				)
+			}
+		})
+	}
+}
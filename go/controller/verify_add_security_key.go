@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 )
 
@@ -21,6 +22,10 @@ func (ctrl *Controller) VerifyAddSecurityKey( //nolint:ireturn
 		return ctrl.sendError(ErrDisabledEndpoint), nil
 	}
 
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
 	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
 	if apiErr != nil {
 		return ctrl.sendError(apiErr), nil
@@ -61,6 +66,17 @@ func (ctrl *Controller) VerifyAddSecurityKey( //nolint:ireturn
 		return ctrl.sendError(ErrInternalServerError), nil
 	}
 
+	ctrl.wf.RecordAuditEvent(
+		ctx,
+		user.ID,
+		api.SecurityKeyAdded,
+		map[string]any{"securityKeyId": securityKeyID.String()},
+		logger,
+	)
+	ctrl.wf.SendSecurityNotification(
+		ctx, user, notifications.TemplateNameSecurityKeyAdded, logger,
+	)
+
 	return api.VerifyAddSecurityKey200JSONResponse{
 		Id:       securityKeyID.String(),
 		Nickname: request.Body.Nickname,
@@ -3,13 +3,58 @@ package controller
 import (
 	"context"
 	"log/slog"
+	"strings"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 )
 
+// verifyCurrentMfa checks the user can present the MFA method that is active
+// before it is deactivated or replaced, an access token alone isn't enough to
+// weaken the second factor.
+func (ctrl *Controller) verifyCurrentMfa(
+	ctx context.Context,
+	req api.VerifyChangeUserMfaRequestObject,
+	user sql.AuthUser,
+	logger *slog.Logger,
+) *APIError {
+	switch api.UserMfaRequestActiveMfaType(user.ActiveMfaType.String) { //nolint:exhaustive
+	case api.Totp:
+		if user.TotpSecret.String == "" {
+			logger.Warn("user does not have totp secret")
+			return ErrNoTotpSecret
+		}
+
+		if !ctrl.totp.Validate(req.Body.Code, user.TotpSecret.String) {
+			logger.Warn("invalid totp")
+			return ErrInvalidTotp
+		}
+	case api.Email, api.Sms:
+		if req.Body.Ticket == nil || req.Body.Otp == nil ||
+			!strings.HasPrefix(*req.Body.Ticket, mfaTicketPrefixChange) {
+			logger.Warn("missing ticket to change the active mfa")
+			return ErrInvalidTicket
+		}
+
+		challengeUser, apiErr := ctrl.wf.VerifyMfaOTPChallenge(
+			ctx, *req.Body.Ticket, *req.Body.Otp, logger,
+		)
+		if apiErr != nil {
+			return apiErr
+		}
+
+		if challengeUser.ID != user.ID {
+			logger.Warn("mfa ticket belongs to a different user")
+			return ErrInvalidTicket
+		}
+	}
+
+	return nil
+}
+
 func (ctrl *Controller) postUserMfaDeactivate( //nolint:ireturn
 	ctx context.Context,
 	req api.VerifyChangeUserMfaRequestObject,
@@ -18,20 +63,13 @@ func (ctrl *Controller) postUserMfaDeactivate( //nolint:ireturn
 ) api.VerifyChangeUserMfaResponseObject {
 	logger.Info("deactivating mfa")
 
-	if user.ActiveMfaType.String != string(api.Totp) {
-		logger.Warn("user does not have totp mfa enabled")
+	if !user.ActiveMfaType.Valid || user.ActiveMfaType.String == "" {
+		logger.Warn("user does not have mfa enabled")
 		return ctrl.sendError(ErrDisabledMfaTotp)
 	}
 
-	if user.TotpSecret.String == "" {
-		logger.Warn("user does not have totp secret")
-		return ctrl.sendError(ErrNoTotpSecret)
-	}
-
-	valid := ctrl.totp.Validate(req.Body.Code, user.TotpSecret.String)
-	if !valid {
-		logger.Warn("invalid totp")
-		return ctrl.sendError(ErrInvalidTotp)
+	if apiErr := ctrl.verifyCurrentMfa(ctx, req, user, logger); apiErr != nil {
+		return ctrl.sendError(apiErr)
 	}
 
 	if err := ctrl.wf.db.UpdateUserActiveMFAType(
@@ -44,6 +82,11 @@ func (ctrl *Controller) postUserMfaDeactivate( //nolint:ireturn
 		return ctrl.sendError(ErrInternalServerError)
 	}
 
+	ctrl.wf.RecordAuditEvent(
+		ctx, user.ID, api.MfaDisabled, map[string]any{"mfaType": user.ActiveMfaType.String}, logger,
+	)
+	ctrl.wf.SendSecurityNotification(ctx, user, notifications.TemplateNameMfaDisabled, logger)
+
 	return api.VerifyChangeUserMfa200JSONResponse(api.OK)
 }
 
@@ -65,6 +108,10 @@ func (ctrl *Controller) postUserMfaActivate( //nolint:ireturn
 		return ctrl.sendError(ErrNoTotpSecret)
 	}
 
+	if apiErr := ctrl.verifyCurrentMfa(ctx, req, user, logger); apiErr != nil {
+		return ctrl.sendError(apiErr)
+	}
+
 	valid := ctrl.totp.Validate(req.Body.Code, user.TotpSecret.String)
 	if !valid {
 		logger.Warn("invalid totp")
@@ -81,6 +128,64 @@ func (ctrl *Controller) postUserMfaActivate( //nolint:ireturn
 		return ctrl.sendError(ErrInternalServerError)
 	}
 
+	ctrl.wf.RecordAuditEvent(
+		ctx, user.ID, api.MfaEnabled, map[string]any{"mfaType": api.Totp}, logger,
+	)
+	ctrl.wf.SendSecurityNotification(ctx, user, notifications.TemplateNameMfaEnabled, logger)
+
+	return api.VerifyChangeUserMfa200JSONResponse(api.OK)
+}
+
+func (ctrl *Controller) postUserMfaActivateOTP( //nolint:ireturn
+	ctx context.Context,
+	req api.VerifyChangeUserMfaRequestObject,
+	mfaType api.UserMfaRequestActiveMfaType,
+	user sql.AuthUser,
+	logger *slog.Logger,
+) api.VerifyChangeUserMfaResponseObject {
+	logger.Info("activating mfa", slog.String("mfaType", string(mfaType)))
+
+	if user.ActiveMfaType.String == string(mfaType) {
+		logger.Info("mfa type already active")
+		return api.VerifyChangeUserMfa200JSONResponse(api.OK)
+	}
+
+	switch mfaType { //nolint:exhaustive
+	case api.Email:
+		if !user.Email.Valid || !user.EmailVerified {
+			logger.Warn("user email is not verified")
+			return ctrl.sendError(ErrUnverifiedUser)
+		}
+	case api.Sms:
+		if !user.PhoneNumber.Valid || !user.PhoneNumberVerified {
+			logger.Warn("user phone number is not verified")
+			return ctrl.sendError(ErrUnverifiedUser)
+		}
+		if ctrl.wf.sms == nil {
+			logger.Warn("no SMS provider is configured")
+			return ctrl.sendError(ErrCannotSendSMS)
+		}
+	}
+
+	if apiErr := ctrl.verifyCurrentMfa(ctx, req, user, logger); apiErr != nil {
+		return ctrl.sendError(apiErr)
+	}
+
+	if err := ctrl.wf.db.UpdateUserActiveMFAType(
+		ctx, sql.UpdateUserActiveMFATypeParams{
+			ID:            user.ID,
+			ActiveMfaType: sql.Text(mfaType),
+		},
+	); err != nil {
+		logger.Error("failed to update active MFA type", logError(err))
+		return ctrl.sendError(ErrInternalServerError)
+	}
+
+	ctrl.wf.RecordAuditEvent(
+		ctx, user.ID, api.MfaEnabled, map[string]any{"mfaType": mfaType}, logger,
+	)
+	ctrl.wf.SendSecurityNotification(ctx, user, notifications.TemplateNameMfaEnabled, logger)
+
 	return api.VerifyChangeUserMfa200JSONResponse(api.OK)
 }
 
@@ -94,6 +199,10 @@ func (ctrl *Controller) VerifyChangeUserMfa( //nolint:ireturn
 		return ctrl.sendError(ErrDisabledEndpoint), nil
 	}
 
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
 	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
 	if apiErr != nil {
 		return ctrl.sendError(apiErr), nil
@@ -104,8 +213,37 @@ func (ctrl *Controller) VerifyChangeUserMfa( //nolint:ireturn
 		return ctrl.postUserMfaDeactivate(ctx, req, user, logger), nil
 	case *req.Body.ActiveMfaType == api.Totp:
 		return ctrl.postUserMfaActivate(ctx, req, user, logger), nil
+	case *req.Body.ActiveMfaType == api.Email || *req.Body.ActiveMfaType == api.Sms:
+		return ctrl.postUserMfaActivateOTP(ctx, req, *req.Body.ActiveMfaType, user, logger), nil
 	}
 
 	logger.Warn("invalid mfa type, we shouldn't be here")
 	return ctrl.sendError(ErrInternalServerError), nil
 }
+
+func (ctrl *Controller) SendUserMfaOtp( //nolint:ireturn
+	ctx context.Context, _ api.SendUserMfaOtpRequestObject,
+) (api.SendUserMfaOtpResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.MfaEnabled {
+		logger.Warn("mfa disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	if apiErr := ctrl.wf.ForbidImpersonation(ctx, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	ticket, apiErr := ctrl.wf.SendMfaChangeOTPChallenge(ctx, user, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.SendUserMfaOtp200JSONResponse{Ticket: ticket}, nil
+}
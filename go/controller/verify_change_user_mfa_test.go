@@ -20,6 +20,11 @@ func TestVerifyChangeUserMfa(t *testing.T) { //nolint:maintidx
 	t.Parallel()
 
 	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	challengeID := uuid.MustParse("2c1f0a9e-8d7b-4c6a-9e5f-4a3b2c1d0e9f")
+
+	// bcrypt hash of "123456"
+	otpHash := "$2a$10$U6v0Us8wXVoA8w1QO9S22eVnCh80ImqcCujDs0x4FNq/8fJHNglDC"
+
 	jwtTokenFn := func() *jwt.Token {
 		return &jwt.Token{
 			Raw:    "",
@@ -210,6 +215,252 @@ func TestVerifyChangeUserMfa(t *testing.T) { //nolint:maintidx
 			},
 		},
 
+		{
+			name:   "enable email",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:            userID,
+					Email:         sql.Text("user@acme.local"),
+					EmailVerified: true,
+					ActiveMfaType: pgtype.Text{}, //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().UpdateUserActiveMFAType(
+					gomock.Any(),
+					sql.UpdateUserActiveMFATypeParams{
+						ID:            userID,
+						ActiveMfaType: pgtype.Text{String: "email", Valid: true},
+					},
+				).Return(nil)
+
+				return mock
+			},
+			jwtTokenFn: jwtTokenFn,
+			request: api.VerifyChangeUserMfaRequestObject{
+				Body: &api.VerifyChangeUserMfaJSONRequestBody{
+					ActiveMfaType: ptr(api.Email),
+					Code:          "",
+				},
+			},
+			expectedResponse:  api.VerifyChangeUserMfa200JSONResponse(api.OK),
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "enable sms - phone number not verified",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:                  userID,
+					Email:               sql.Text("user@acme.local"),
+					PhoneNumber:         sql.Text("+14155552671"),
+					PhoneNumberVerified: false,
+					ActiveMfaType:       pgtype.Text{}, //nolint:exhaustruct
+				}, nil)
+
+				return mock
+			},
+			jwtTokenFn: jwtTokenFn,
+			request: api.VerifyChangeUserMfaRequestObject{
+				Body: &api.VerifyChangeUserMfaJSONRequestBody{
+					ActiveMfaType: ptr(api.Sms),
+					Code:          "",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unverified-user",
+				Message: "User is not verified.",
+				Status:  http.StatusUnauthorized,
+			},
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "disable email",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				emailUser := sql.AuthUser{ //nolint:exhaustruct
+					ID:            userID,
+					Email:         sql.Text("user@acme.local"),
+					EmailVerified: true,
+					ActiveMfaType: sql.Text("email"),
+				}
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(emailUser, nil).Times(2)
+
+				mock.EXPECT().GetMfaChallengeByTicket(
+					gomock.Any(),
+					sql.GetMfaChallengeByTicketParams{
+						Ticket:      "mfaChangeEmail:123456",
+						MaxAttempts: 5,
+					},
+				).Return(sql.AuthMfaChallenge{ //nolint:exhaustruct
+					ID:      challengeID,
+					UserID:  userID,
+					Ticket:  "mfaChangeEmail:123456",
+					MfaType: "email",
+					OtpHash: otpHash,
+				}, nil)
+
+				mock.EXPECT().DeleteMfaChallenge(gomock.Any(), challengeID).Return(nil)
+
+				mock.EXPECT().UpdateUserActiveMFAType(
+					gomock.Any(),
+					sql.UpdateUserActiveMFATypeParams{
+						ID:            userID,
+						ActiveMfaType: pgtype.Text{}, //nolint:exhaustruct
+					},
+				).Return(nil)
+
+				return mock
+			},
+			jwtTokenFn: jwtTokenFn,
+			request: api.VerifyChangeUserMfaRequestObject{
+				Body: &api.VerifyChangeUserMfaJSONRequestBody{
+					ActiveMfaType: nil,
+					Code:          "",
+					Ticket:        ptr("mfaChangeEmail:123456"),
+					Otp:           ptr("123456"),
+				},
+			},
+			expectedResponse:  api.VerifyChangeUserMfa200JSONResponse(api.OK),
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "disable email - no ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:            userID,
+					Email:         sql.Text("user@acme.local"),
+					EmailVerified: true,
+					ActiveMfaType: sql.Text("email"),
+				}, nil)
+
+				return mock
+			},
+			jwtTokenFn: jwtTokenFn,
+			request: api.VerifyChangeUserMfaRequestObject{
+				Body: &api.VerifyChangeUserMfaJSONRequestBody{
+					ActiveMfaType: nil,
+					Code:          "",
+					Ticket:        nil,
+					Otp:           nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid ticket",
+				Status:  http.StatusUnauthorized,
+			},
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "disable email - sign in ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:            userID,
+					Email:         sql.Text("user@acme.local"),
+					EmailVerified: true,
+					ActiveMfaType: sql.Text("email"),
+				}, nil)
+
+				return mock
+			},
+			jwtTokenFn: jwtTokenFn,
+			request: api.VerifyChangeUserMfaRequestObject{
+				Body: &api.VerifyChangeUserMfaJSONRequestBody{
+					ActiveMfaType: nil,
+					Code:          "",
+					Ticket:        ptr("mfaEmail:123456"),
+					Otp:           ptr("123456"),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid ticket",
+				Status:  http.StatusUnauthorized,
+			},
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "switch totp to sms - wrong code",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:                  userID,
+					Email:               sql.Text("user@acme.local"),
+					PhoneNumber:         sql.Text("+14155552671"),
+					PhoneNumberVerified: true,
+					TotpSecret:          sql.Text("FEWCQAIILM6UOYZCPFYRAPAUCIFUUUK3JUZXWKJIN4ORQNK4EQCQ"),
+					ActiveMfaType:       sql.Text("totp"),
+				}, nil)
+
+				return mock
+			},
+			jwtTokenFn: jwtTokenFn,
+			request: api.VerifyChangeUserMfaRequestObject{
+				Body: &api.VerifyChangeUserMfaJSONRequestBody{
+					ActiveMfaType: ptr(api.Sms),
+					Code:          "123456",
+					Ticket:        nil,
+					Otp:           nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-totp",
+				Message: "Invalid TOTP code",
+				Status:  http.StatusUnauthorized,
+			},
+			expectedJWT: nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withSMS(func(ctrl *gomock.Controller) *mock.MockSMSer {
+					return mock.NewMockSMSer(ctrl)
+				}),
+				withTotp(controller.NewTotp(
+					"auth-test",
+					fakeNow(time.Date(2025, 3, 29, 14, 50, 0o0, 0, time.UTC)),
+				)),
+			},
+		},
+
 		{
 			name:   "disable - already disabled",
 			config: getConfig,
@@ -421,6 +672,30 @@ func TestVerifyChangeUserMfa(t *testing.T) { //nolint:maintidx
 				)),
 			},
 		},
+
+		{
+			name:   "impersonated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			jwtTokenFn: impersonated(jwtTokenFn),
+			request: api.VerifyChangeUserMfaRequestObject{
+				Body: &api.VerifyChangeUserMfaJSONRequestBody{
+					ActiveMfaType: nil,
+					Code:          "",
+					Ticket:        nil,
+					Otp:           nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-impersonation",
+				Message: "Forbidden, action not allowed while impersonating a user.",
+				Status:  403,
+			},
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
 	}
 
 	for _, tc := range cases {
@@ -53,7 +53,7 @@ func (ctrl *Controller) VerifyElevateWebauthn( //nolint:ireturn
 	)
 	if err != nil {
 		logger.Error("failed to create elevated session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(getTokenError(err)), nil
 	}
 
 	return api.VerifyElevateWebauthn200JSONResponse{
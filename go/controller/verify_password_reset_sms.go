@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) VerifyPasswordResetSms( //nolint:ireturn
+	ctx context.Context,
+	request api.VerifyPasswordResetSmsRequestObject,
+) (api.VerifyPasswordResetSmsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("phoneNumber", request.Body.PhoneNumber))
+
+	if !ctrl.config.PhonePasswordEnabled {
+		logger.Warn("phone password is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	phoneNumber, apiErr := ctrl.wf.ValidatePhoneNumber(request.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	request.Body.PhoneNumber = phoneNumber
+
+	// validate the new password first so a rejected password doesn't use up the code
+	if apiErr := ctrl.wf.ValidatePassword(ctx, request.Body.NewPassword, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserByPhoneNumber(ctx, request.Body.PhoneNumber, logger)
+	switch {
+	case errors.Is(apiErr, ErrUserPhoneNumberNotFound):
+		return ctrl.sendError(ErrInvalidOTP), nil
+	case apiErr != nil:
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr = ctrl.wf.VerifyPhoneNumberOTP(
+		ctx, user, request.Body.Otp, otpMethodPasswordResetSms, logger,
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.ChangePassword(
+		ctx, user.ID, request.Body.NewPassword, logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.VerifyPasswordResetSms200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,301 @@
+package controller_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVerifyPasswordResetSms(t *testing.T) {
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.PhonePasswordEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	getUser := func(method string, expiresAt time.Time) sql.AuthUser {
+		user := getPhonePasswordUser(userID)
+		user.OtpHash = sql.Text("$2a$10$U6v0Us8wXVoA8w1QO9S22eVnCh80ImqcCujDs0x4FNq/8fJHNglDC")
+		user.OtpHashExpiresAt = sql.TimestampTz(expiresAt)
+		user.OtpMethodLastUsed = sql.Text(method)
+		return user
+	}
+
+	cases := []testRequest[api.VerifyPasswordResetSmsRequestObject, api.VerifyPasswordResetSmsResponseObject]{
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(getUser("password-reset-sms", time.Now().Add(time.Minute)), nil)
+
+				mock.EXPECT().IncrementUserOTPHashAttempts(
+					gomock.Any(),
+					sql.IncrementUserOTPHashAttemptsParams{ID: userID, MaxAttempts: 5},
+				).Return(int32(1), nil)
+
+				mock.EXPECT().UpdateUserVerifyPhoneNumber(
+					gomock.Any(), userID,
+				).Return(getPhonePasswordUser(userID), nil)
+
+				mock.EXPECT().UpdateUserChangePassword(
+					gomock.Any(),
+					testhelpers.GomockCmpOpts(
+						sql.UpdateUserChangePasswordParams{
+							ID:           userID,
+							PasswordHash: sql.Text("newpassword"),
+						},
+						cmpopts.IgnoreFields(
+							sql.UpdateUserChangePasswordParams{}, //nolint:exhaustruct
+							"PasswordHash",
+						),
+					),
+				).Return(userID, nil)
+
+				return mock
+			},
+			request: api.VerifyPasswordResetSmsRequestObject{
+				Body: &api.UserPasswordResetSmsVerifyRequest{
+					PhoneNumber: "+14155552671",
+					Otp:         "123456",
+					NewPassword: "newpassword",
+				},
+			},
+			expectedResponse:  api.VerifyPasswordResetSms200JSONResponse(api.OK),
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "wrong otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(getUser("password-reset-sms", time.Now().Add(time.Minute)), nil)
+
+				mock.EXPECT().IncrementUserOTPHashAttempts(
+					gomock.Any(),
+					sql.IncrementUserOTPHashAttemptsParams{ID: userID, MaxAttempts: 5},
+				).Return(int32(1), nil)
+
+				return mock
+			},
+			request: api.VerifyPasswordResetSmsRequestObject{
+				Body: &api.UserPasswordResetSmsVerifyRequest{
+					PhoneNumber: "+14155552671",
+					Otp:         "654321",
+					NewPassword: "newpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "otp issued for phone verification",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(getUser("phone-verification", time.Now().Add(time.Minute)), nil)
+
+				mock.EXPECT().IncrementUserOTPHashAttempts(
+					gomock.Any(),
+					sql.IncrementUserOTPHashAttemptsParams{ID: userID, MaxAttempts: 5},
+				).Return(int32(1), nil)
+
+				return mock
+			},
+			request: api.VerifyPasswordResetSmsRequestObject{
+				Body: &api.UserPasswordResetSmsVerifyRequest{
+					PhoneNumber: "+14155552671",
+					Otp:         "123456",
+					NewPassword: "newpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "provider otp issued for phone verification",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser("phone-verification", time.Now().Add(time.Minute))
+				user.OtpHash = pgtype.Text{} //nolint:exhaustruct
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(user, nil)
+
+				mock.EXPECT().IncrementUserOTPHashAttempts(
+					gomock.Any(),
+					sql.IncrementUserOTPHashAttemptsParams{ID: userID, MaxAttempts: 5},
+				).Return(int32(1), nil)
+
+				return mock
+			},
+			request: api.VerifyPasswordResetSmsRequestObject{
+				Body: &api.UserPasswordResetSmsVerifyRequest{
+					PhoneNumber: "+14155552671",
+					Otp:         "123456",
+					NewPassword: "newpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+			getControllerOpts: []getControllerOptsFunc{
+				withSMS(func(ctrl *gomock.Controller) *mock.MockSMSer {
+					return mock.NewMockSMSer(ctrl)
+				}),
+			},
+		},
+
+		{
+			name:   "expired otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(getUser("password-reset-sms", time.Now().Add(-time.Minute)), nil)
+
+				mock.EXPECT().IncrementUserOTPHashAttempts(
+					gomock.Any(),
+					sql.IncrementUserOTPHashAttemptsParams{ID: userID, MaxAttempts: 5},
+				).Return(int32(1), nil)
+
+				return mock
+			},
+			request: api.VerifyPasswordResetSmsRequestObject{
+				Body: &api.UserPasswordResetSmsVerifyRequest{
+					PhoneNumber: "+14155552671",
+					Otp:         "123456",
+					NewPassword: "newpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "too many attempts",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+14155552671"),
+				).Return(getUser("password-reset-sms", time.Now().Add(time.Minute)), nil)
+
+				mock.EXPECT().IncrementUserOTPHashAttempts(
+					gomock.Any(),
+					sql.IncrementUserOTPHashAttemptsParams{ID: userID, MaxAttempts: 5},
+				).Return(int32(0), pgx.ErrNoRows)
+
+				return mock
+			},
+			request: api.VerifyPasswordResetSmsRequestObject{
+				Body: &api.UserPasswordResetSmsVerifyRequest{
+					PhoneNumber: "+14155552671",
+					Otp:         "123456",
+					NewPassword: "newpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "password too short, otp not used",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.VerifyPasswordResetSmsRequestObject{
+				Body: &api.UserPasswordResetSmsVerifyRequest{
+					PhoneNumber: "+14155552671",
+					Otp:         "123456",
+					NewPassword: "p",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "password-too-short",
+				Message: "Password is too short",
+				Status:  400,
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			assertRequest(
+				t.Context(), t, c.VerifyPasswordResetSms, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
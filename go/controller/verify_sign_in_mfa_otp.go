@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) VerifySignInMfaOtp( //nolint:ireturn
+	ctx context.Context, req api.VerifySignInMfaOtpRequestObject,
+) (api.VerifySignInMfaOtpResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.MfaEnabled {
+		logger.Warn("mfa disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	// change tickets start with mfaTicketPrefixSignIn as well
+	if !strings.HasPrefix(req.Body.Ticket, mfaTicketPrefixSignIn+"Email:") &&
+		!strings.HasPrefix(req.Body.Ticket, mfaTicketPrefixSignIn+"Sms:") {
+		logger.Warn("ticket is not a sign-in mfa ticket")
+		return ctrl.sendError(ErrInvalidTicket), nil
+	}
+
+	user, apiErr := ctrl.wf.VerifyMfaOTPChallenge(ctx, req.Body.Ticket, req.Body.Otp, logger)
+	if errors.Is(apiErr, ErrInvalidOTP) {
+		ctrl.wf.RecordAuditEvent(
+			ctx,
+			user.ID,
+			api.SigninFailed,
+			map[string]any{"method": signInMethodMfa(user.ActiveMfaType.String)},
+			logger,
+		)
+	}
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(getTokenError(err)), nil
+	}
+
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodMfa(user.ActiveMfaType.String), logger)
+
+	return api.VerifySignInMfaOtp200JSONResponse{
+		Session: session,
+	}, nil
+}
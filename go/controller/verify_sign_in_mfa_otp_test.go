@@ -0,0 +1,392 @@
+package controller_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func getUserSigninMfaOTP(userID uuid.UUID, mfaType string) sql.AuthUser {
+	user := getUserSigninMfaTotp(userID)
+	user.TotpSecret = pgtype.Text{} //nolint:exhaustruct
+	user.ActiveMfaType = sql.Text(mfaType)
+	user.PhoneNumber = sql.Text("+14155552671")
+	user.PhoneNumberVerified = true
+	return user
+}
+
+func TestVerifySignInMfaOtp(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("DB477732-48FA-4289-B694-2886A646B6EB")
+	challengeID := uuid.MustParse("2c1f0a9e-8d7b-4c6a-9e5f-4a3b2c1d0e9f")
+
+	// bcrypt hash of "123456"
+	otpHash := "$2a$10$U6v0Us8wXVoA8w1QO9S22eVnCh80ImqcCujDs0x4FNq/8fJHNglDC"
+
+	getChallenge := func(mfaType string, otpHash string) sql.AuthMfaChallenge {
+		return sql.AuthMfaChallenge{
+			ID:        challengeID,
+			CreatedAt: sql.TimestampTz(time.Now()),
+			UserID:    userID,
+			Ticket:    "mfaEmail:123456",
+			MfaType:   mfaType,
+			OtpHash:   otpHash,
+			Attempts:  1,
+			ExpiresAt: sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+		}
+	}
+
+	newSession := func(mock *mock.MockDBClient) {
+		mock.EXPECT().GetUserRoles(
+			gomock.Any(), userID,
+		).Return([]sql.AuthUserRole{
+			{UserID: userID, Role: "user"}, //nolint:exhaustruct
+			{UserID: userID, Role: "me"},   //nolint:exhaustruct
+		}, nil)
+
+		mock.EXPECT().InsertRefreshtoken(
+			gomock.Any(),
+			cmpDBParams(sql.InsertRefreshtokenParams{
+				UserID:           userID,
+				RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+				ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+				Type:             sql.RefreshTokenTypeRegular,
+				Metadata:         nil,
+			}),
+		).Return(refreshTokenID, nil)
+
+		mock.EXPECT().UpdateUserLastSeen(
+			gomock.Any(), userID,
+		).Return(sql.TimestampTz(time.Now()), nil)
+	}
+
+	expectedSession := api.VerifySignInMfaOtp200JSONResponse{
+		Session: &api.Session{
+			AccessToken:          "",
+			AccessTokenExpiresIn: 900,
+			RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+			RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+			User: &api.User{
+				AvatarUrl:           "",
+				CreatedAt:           time.Now(),
+				DefaultRole:         "user",
+				DisplayName:         "Jane Doe",
+				Email:               ptr(types.Email("jane@acme.com")),
+				EmailVerified:       true,
+				Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+				IsAnonymous:         false,
+				Locale:              "en",
+				Metadata:            map[string]any{},
+				PhoneNumber:         ptr("+14155552671"),
+				PhoneNumberVerified: true,
+				Roles:               []string{"user", "me"},
+				ActiveMfaType:       nil,
+			},
+		},
+	}
+
+	expectedJWT := &jwt.Token{
+		Raw:    "",
+		Method: jwt.SigningMethodHS256,
+		Header: map[string]any{
+			"alg": "HS256",
+			"typ": "JWT",
+		},
+		Claims: jwt.MapClaims{
+			"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "me"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+				"x-hasura-user-is-anonymous": "false",
+			},
+			"iat": float64(time.Now().Unix()),
+			"iss": "hasura-auth",
+			"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+		},
+		Signature: []byte{},
+		Valid:     true,
+	}
+
+	cases := []testRequest[api.VerifySignInMfaOtpRequestObject, api.VerifySignInMfaOtpResponseObject]{
+		{
+			name:   "success email",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetMfaChallengeByTicket(
+					gomock.Any(),
+					sql.GetMfaChallengeByTicketParams{
+						Ticket:      "mfaEmail:123456",
+						MaxAttempts: 5,
+					},
+				).Return(getChallenge("email", otpHash), nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getUserSigninMfaOTP(userID, "email"), nil)
+
+				mock.EXPECT().DeleteMfaChallenge(gomock.Any(), challengeID).Return(nil)
+
+				newSession(mock)
+
+				return mock
+			},
+			request: api.VerifySignInMfaOtpRequestObject{
+				Body: &api.VerifySignInMfaOtpJSONRequestBody{
+					Otp:    "123456",
+					Ticket: "mfaEmail:123456",
+				},
+			},
+			expectedResponse:  expectedSession,
+			jwtTokenFn:        nil,
+			expectedJWT:       expectedJWT,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "success sms verified by provider",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetMfaChallengeByTicket(
+					gomock.Any(),
+					sql.GetMfaChallengeByTicketParams{
+						Ticket:      "mfaEmail:123456",
+						MaxAttempts: 5,
+					},
+				).Return(getChallenge("sms", ""), nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getUserSigninMfaOTP(userID, "sms"), nil)
+
+				mock.EXPECT().DeleteMfaChallenge(gomock.Any(), challengeID).Return(nil)
+
+				newSession(mock)
+
+				return mock
+			},
+			request: api.VerifySignInMfaOtpRequestObject{
+				Body: &api.VerifySignInMfaOtpJSONRequestBody{
+					Otp:    "123456",
+					Ticket: "mfaEmail:123456",
+				},
+			},
+			expectedResponse: expectedSession,
+			jwtTokenFn:       nil,
+			expectedJWT:      expectedJWT,
+			getControllerOpts: []getControllerOptsFunc{
+				withSMS(func(ctrl *gomock.Controller) *mock.MockSMSer {
+					mock := mock.NewMockSMSer(ctrl)
+
+					mock.EXPECT().CheckVerificationCode(
+						gomock.Any(), "+14155552671", "123456",
+					).Return(getUserSigninMfaOTP(userID, "sms"), nil)
+
+					return mock
+				}),
+			},
+		},
+
+		{
+			name: "wrong otp",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.AuditLogEnabled = true
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetMfaChallengeByTicket(
+					gomock.Any(),
+					sql.GetMfaChallengeByTicketParams{
+						Ticket:      "mfaEmail:123456",
+						MaxAttempts: 5,
+					},
+				).Return(getChallenge("email", otpHash), nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getUserSigninMfaOTP(userID, "email"), nil)
+
+				mock.EXPECT().InsertAuditEvent(
+					gomock.Any(),
+					sql.InsertAuditEventParams{
+						UserID:    userID,
+						Event:     "signin-failed",
+						IpAddress: pgtype.Text{}, //nolint:exhaustruct
+						UserAgent: pgtype.Text{}, //nolint:exhaustruct
+						TraceID:   pgtype.Text{}, //nolint:exhaustruct
+						Metadata:  []byte(`{"method":"mfa-email"}`),
+					},
+				).Return(nil)
+
+				return mock
+			},
+			request: api.VerifySignInMfaOtpRequestObject{
+				Body: &api.VerifySignInMfaOtpJSONRequestBody{
+					Otp:    "654321",
+					Ticket: "mfaEmail:123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "expired or out of attempts",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetMfaChallengeByTicket(
+					gomock.Any(),
+					sql.GetMfaChallengeByTicketParams{
+						Ticket:      "mfaEmail:123456",
+						MaxAttempts: 5,
+					},
+				).Return(sql.AuthMfaChallenge{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.VerifySignInMfaOtpRequestObject{
+				Body: &api.VerifySignInMfaOtpJSONRequestBody{
+					Otp:    "123456",
+					Ticket: "mfaEmail:123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid ticket",
+				Status:  401,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "mfa type changed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetMfaChallengeByTicket(
+					gomock.Any(),
+					sql.GetMfaChallengeByTicketParams{
+						Ticket:      "mfaEmail:123456",
+						MaxAttempts: 5,
+					},
+				).Return(getChallenge("email", otpHash), nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getUserSigninMfaTotp(userID), nil)
+
+				return mock
+			},
+			request: api.VerifySignInMfaOtpRequestObject{
+				Body: &api.VerifySignInMfaOtpJSONRequestBody{
+					Otp:    "123456",
+					Ticket: "mfaEmail:123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid ticket",
+				Status:  401,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name:   "change ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.VerifySignInMfaOtpRequestObject{
+				Body: &api.VerifySignInMfaOtpJSONRequestBody{
+					Otp:    "123456",
+					Ticket: "mfaChangeEmail:123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid ticket",
+				Status:  401,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+
+		{
+			name: "mfa disabled",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.MfaEnabled = false
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			request: api.VerifySignInMfaOtpRequestObject{
+				Body: &api.VerifySignInMfaOtpJSONRequestBody{
+					Otp:    "123456",
+					Ticket: "mfaEmail:123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			jwtTokenFn:        nil,
+			expectedJWT:       nil,
+			getControllerOpts: []getControllerOptsFunc{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, tc.getControllerOpts...)
+
+			assertRequest(
+				t.Context(),
+				t,
+				c.VerifySignInMfaOtp,
+				tc.request,
+				tc.expectedResponse,
+			)
+		})
+	}
+}
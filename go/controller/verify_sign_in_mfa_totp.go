@@ -35,15 +35,20 @@ func (ctrl *Controller) VerifySignInMfaTotp( //nolint:ireturn
 	valid := ctrl.totp.Validate(req.Body.Otp, user.TotpSecret.String)
 	if !valid {
 		logger.Warn("invalid totp")
+		ctrl.wf.RecordAuditEvent(
+			ctx, user.ID, api.SigninFailed, map[string]any{"method": signInMethodMfaTotp}, logger,
+		)
 		return ctrl.sendError(ErrInvalidTotp), nil
 	}
 
 	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(getTokenError(err)), nil
 	}
 
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodMfaTotp, logger)
+
 	return api.VerifySignInMfaTotp200JSONResponse{
 		Session: session,
 	}, nil
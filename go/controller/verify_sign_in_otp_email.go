@@ -25,9 +25,11 @@ func (ctrl *Controller) VerifySignInOTPEmail( //nolint:ireturn
 	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(getTokenError(err)), nil
 	}
 
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodOTPEmail, logger)
+
 	return api.VerifySignInOTPEmail200JSONResponse{
 		Session: session,
 	}, nil
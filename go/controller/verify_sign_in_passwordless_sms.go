@@ -20,6 +20,12 @@ func (ctrl *Controller) VerifySignInPasswordlessSms( //nolint:ireturn
 		return ctrl.sendError(ErrDisabledEndpoint), nil
 	}
 
+	phoneNumber, apiErr := ctrl.wf.ValidatePhoneNumber(request.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	request.Body.PhoneNumber = phoneNumber
+
 	user, err := ctrl.wf.sms.CheckVerificationCode(
 		ctx, request.Body.PhoneNumber, request.Body.Otp,
 	)
@@ -35,9 +41,11 @@ func (ctrl *Controller) VerifySignInPasswordlessSms( //nolint:ireturn
 	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(getTokenError(err)), nil
 	}
 
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodPasswordlessSMS, logger)
+
 	return api.VerifySignInPasswordlessSms200JSONResponse{
 		Session: session,
 		Mfa:     nil,
@@ -61,7 +61,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "123456",
 				},
 			},
@@ -82,7 +82,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 						IsAnonymous:         false,
 						Locale:              "en",
 						Metadata:            map[string]any{},
-						PhoneNumber:         ptr("+1234567890"),
+						PhoneNumber:         ptr("+14155552671"),
 						PhoneNumberVerified: true,
 						Roles:               []string{"user", "me"},
 						ActiveMfaType:       nil,
@@ -118,12 +118,12 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					user := getSigninUser(userID)
-					user.PhoneNumber = sql.Text("+1234567890")
+					user.PhoneNumber = sql.Text("+14155552671")
 					user.PhoneNumberVerified = true
 
 					mock.EXPECT().CheckVerificationCode(
 						gomock.Any(),
-						"+1234567890",
+						"+14155552671",
 						"123456",
 					).Return(user, nil)
 
@@ -145,7 +145,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "123456",
 				},
 			},
@@ -168,7 +168,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "wrong",
 				},
 			},
@@ -185,7 +185,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 					mock.EXPECT().CheckVerificationCode(
 						gomock.Any(),
-						"+1234567890",
+						"+14155552671",
 						"wrong",
 					).Return(sql.AuthUser{}, errors.New("invalid OTP")) //nolint:err113,exhaustruct
 
@@ -203,7 +203,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "123456",
 				},
 			},
@@ -219,13 +219,13 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					user := getSigninUser(userID)
-					user.PhoneNumber = sql.Text("+1234567890")
+					user.PhoneNumber = sql.Text("+14155552671")
 					user.PhoneNumberVerified = true
 					user.Disabled = true
 
 					mock.EXPECT().CheckVerificationCode(
 						gomock.Any(),
-						"+1234567890",
+						"+14155552671",
 						"123456",
 					).Return(user, nil)
 
@@ -247,7 +247,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "123456",
 				},
 			},
@@ -263,13 +263,13 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					user := getSigninUser(userID)
-					user.PhoneNumber = sql.Text("+1234567890")
+					user.PhoneNumber = sql.Text("+14155552671")
 					user.PhoneNumberVerified = true
 					user.EmailVerified = false
 
 					mock.EXPECT().CheckVerificationCode(
 						gomock.Any(),
-						"+1234567890",
+						"+14155552671",
 						"123456",
 					).Return(user, nil)
 
@@ -292,7 +292,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "123456",
 				},
 			},
@@ -308,12 +308,12 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					user := getSigninUser(userID)
-					user.PhoneNumber = sql.Text("+1234567890")
+					user.PhoneNumber = sql.Text("+14155552671")
 					user.PhoneNumberVerified = true
 
 					mock.EXPECT().CheckVerificationCode(
 						gomock.Any(),
-						"+1234567890",
+						"+14155552671",
 						"123456",
 					).Return(user, nil)
 
@@ -354,7 +354,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "123456",
 				},
 			},
@@ -375,7 +375,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 						IsAnonymous:         false,
 						Locale:              "en",
 						Metadata:            map[string]any{},
-						PhoneNumber:         ptr("+1234567890"),
+						PhoneNumber:         ptr("+14155552671"),
 						PhoneNumberVerified: true,
 						Roles:               []string{"user", "me"},
 						ActiveMfaType:       nil,
@@ -411,14 +411,14 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 					mock := mock.NewMockSMSer(ctrl)
 
 					user := getSigninUser(userID)
-					user.PhoneNumber = sql.Text("+1234567890")
+					user.PhoneNumber = sql.Text("+14155552671")
 					user.PhoneNumberVerified = true
 					user.Email = pgtype.Text{} //nolint:exhaustruct
 					user.EmailVerified = false
 
 					mock.EXPECT().CheckVerificationCode(
 						gomock.Any(),
-						"+1234567890",
+						"+14155552671",
 						"123456",
 					).Return(user, nil)
 
@@ -441,24 +441,14 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
-				Error:   "invalid-request",
-				Message: "The request payload is incorrect",
+				Error:   "invalid-phone-number",
+				Message: "Invalid phone number",
 				Status:  400,
 			},
 			expectedJWT: nil,
 			jwtTokenFn:  nil,
 			getControllerOpts: []getControllerOptsFunc{
-				withSMS(func(ctrl *gomock.Controller) *mock.MockSMSer {
-					mock := mock.NewMockSMSer(ctrl)
-
-					mock.EXPECT().CheckVerificationCode(
-						gomock.Any(),
-						"",
-						"123456",
-					).Return(sql.AuthUser{}, errors.New("invalid phone number")) //nolint:err113,exhaustruct
-
-					return mock
-				}),
+				withSMS(mock.NewMockSMSer),
 			},
 		},
 
@@ -471,7 +461,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "",
 				},
 			},
@@ -488,7 +478,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 					mock.EXPECT().CheckVerificationCode(
 						gomock.Any(),
-						"+1234567890",
+						"+14155552671",
 						"",
 					).Return(sql.AuthUser{}, errors.New("empty OTP")) //nolint:err113,exhaustruct
 
@@ -506,7 +496,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 			},
 			request: api.VerifySignInPasswordlessSmsRequestObject{
 				Body: &api.SignInPasswordlessSmsOtpRequest{
-					PhoneNumber: "+1234567890",
+					PhoneNumber: "+14155552671",
 					Otp:         "123456",
 				},
 			},
@@ -523,7 +513,7 @@ func TestVerifySignInPasswordlessSms(t *testing.T) { //nolint:maintidx
 
 					mock.EXPECT().CheckVerificationCode(
 						gomock.Any(),
-						"+1234567890",
+						"+14155552671",
 						"123456",
 					).Return(sql.AuthUser{}, errors.New("SMS service unavailable")) //nolint:err113,exhaustruct
 
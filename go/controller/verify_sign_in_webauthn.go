@@ -104,9 +104,11 @@ func (ctrl *Controller) VerifySignInWebauthn( //nolint:ireturn
 	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
 	if err != nil {
 		logger.Error("failed to create session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(getTokenError(err)), nil
 	}
 
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodWebauthn, logger)
+
 	return api.VerifySignInWebauthn200JSONResponse{
 		Session: session,
 	}, nil
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) VerifySignUpPhonePassword( //nolint:ireturn
+	ctx context.Context,
+	req api.VerifySignUpPhonePasswordRequestObject,
+) (api.VerifySignUpPhonePasswordResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("phoneNumber", req.Body.PhoneNumber))
+
+	if !ctrl.config.PhonePasswordEnabled {
+		logger.Warn("phone password signup is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	phoneNumber, apiErr := ctrl.wf.ValidatePhoneNumber(req.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	req.Body.PhoneNumber = phoneNumber
+
+	user, apiErr := ctrl.wf.GetUserByPhoneNumber(ctx, req.Body.PhoneNumber, logger)
+	switch {
+	case errors.Is(apiErr, ErrUserPhoneNumberNotFound):
+		return ctrl.sendError(ErrInvalidOTP), nil
+	case apiErr != nil:
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr = ctrl.wf.VerifyPhoneNumberOTP(
+		ctx, user, req.Body.Otp, otpMethodPhoneVerification, logger,
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.ValidateUserEmailOptional(user, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(getTokenError(err)), nil
+	}
+
+	ctrl.wf.RecordSignIn(ctx, user.ID, signInMethodPhonePassword, logger)
+
+	return api.VerifySignUpPhonePassword200JSONResponse{
+		Session: session,
+	}, nil
+}
@@ -17,6 +17,7 @@ import (
 )
 
 func (ctrl *Controller) postSignupWebauthnVerifyValidateRequest( //nolint:cyclop
+	ctx context.Context,
 	request api.VerifySignUpWebauthnRequestObject,
 	logger *slog.Logger,
 ) (*protocol.ParsedCredentialCreationData, *api.SignUpOptions, string, *APIError) {
@@ -69,7 +70,7 @@ func (ctrl *Controller) postSignupWebauthnVerifyValidateRequest( //nolint:cyclop
 			options.RedirectTo = request.Body.Options.RedirectTo
 		}
 
-		options, apiErr = ctrl.wf.ValidateSignUpOptions(options, ch.User.Email, logger)
+		options, apiErr = ctrl.wf.ValidateSignUpOptions(ctx, options, ch.User.Email, logger)
 		if apiErr != nil {
 			return nil, nil, "", apiErr
 		}
@@ -85,6 +86,7 @@ func (ctrl *Controller) VerifySignUpWebauthn( //nolint:ireturn
 	logger := middleware.LoggerFromContext(ctx)
 
 	credData, options, nickname, apiErr := ctrl.postSignupWebauthnVerifyValidateRequest(
+		ctx,
 		request,
 		logger,
 	)
@@ -173,8 +175,8 @@ func (ctrl *Controller) postSignupWebauthnVerifyWithoutSession(
 		ticketExpiresAt pgtype.Timestamptz,
 		metadata []byte,
 		gravatarURL string,
-	) error {
-		_, err := ctrl.wf.db.InsertUserWithSecurityKey(
+	) (uuid.UUID, error) {
+		userID, err := ctrl.wf.db.InsertUserWithSecurityKey(
 			ctx, sql.InsertUserWithSecurityKeyParams{
 				ID:                  webauthnUser.ID,
 				Disabled:            ctrl.wf.config.DisableNewUsers,
@@ -194,9 +196,9 @@ func (ctrl *Controller) postSignupWebauthnVerifyWithoutSession(
 			},
 		)
 		if err != nil {
-			return fmt.Errorf("error inserting user with security key: %w", err)
+			return uuid.Nil, fmt.Errorf("error inserting user with security key: %w", err)
 		}
 
-		return nil
+		return userID, nil
 	}
 }
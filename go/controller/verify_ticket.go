@@ -9,6 +9,7 @@ import (
 
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 )
 
@@ -24,6 +25,8 @@ func getTicketType(ticket string, logger *slog.Logger) (TicketType, *APIError) {
 		return TicketTypePasswordReset, nil
 	case strings.HasPrefix(ticket, "otp:"):
 		return TicketTypeOTP, nil
+	case strings.HasPrefix(ticket, "userInvite:"):
+		return TicketTypeUserInvite, nil
 	default:
 		logger.Error("unknown ticket type", slog.String("ticket", ticket))
 		return "", ErrInvalidTicket
@@ -42,7 +45,7 @@ func (ctrl *Controller) getVerifyHandleTicketType(
 	case TicketTypePasswordReset:
 		// noop, just redirecting the user to the client (as signed-in).
 		// this isn't great, but it is for historical reasons.
-	case TicketTypeVerifyEmail:
+	case TicketTypeVerifyEmail, TicketTypeUserInvite:
 		apiErr = ctrl.getVerifyEmail(ctx, user, logger)
 	case TicketTypeOTP:
 		logger.Error("OTP verification is not supported in this context")
@@ -73,9 +76,17 @@ func (ctrl *Controller) VerifyTicket( //nolint:ireturn
 	session, err := ctrl.wf.NewSession(ctx, user, nil, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(getTokenError(err)), nil
 	}
 
+	ctrl.wf.RecordAuditEvent(
+		ctx,
+		user.ID,
+		api.Signin,
+		map[string]any{"method": signInMethodTicket, "ticketType": string(ticketType)},
+		logger,
+	)
+
 	redirectTo = generateRedirectURL(redirectTo, map[string]string{
 		"refreshToken": session.RefreshToken,
 		"type":         string(ticketType),
@@ -114,7 +125,9 @@ func (ctrl *Controller) getVerifyValidateRequest(
 	user, apiErr := ctrl.wf.GetUserByTicket(ctx, req.Params.Ticket, logger)
 	switch {
 	case errors.Is(apiErr, ErrUnverifiedUser) &&
-		(ticketType == TicketTypeVerifyEmail || ticketType == TicketTypePasswordLessEmail):
+		(ticketType == TicketTypeVerifyEmail ||
+			ticketType == TicketTypePasswordLessEmail ||
+			ticketType == TicketTypeUserInvite):
 		// this isn't an error
 	case apiErr != nil:
 		return user, ticketType, redirectTo, apiErr
@@ -130,6 +143,10 @@ func (ctrl *Controller) getVerifyEmailConfirmChange(
 		return apiErr
 	}
 
+	ctrl.wf.RecordAuditEvent(ctx, user.ID, api.EmailChanged, nil, logger)
+	// user still has the old email address so that's where the notification goes
+	ctrl.wf.SendSecurityNotification(ctx, user, notifications.TemplateNameEmailChanged, logger)
+
 	return nil
 }
 
@@ -485,6 +485,84 @@ func TestVerifyTicket(t *testing.T) { //nolint:maintidx
 			getControllerOpts: nil,
 		},
 
+		{
+			name: "userInvite",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.RequireEmailVerification = true
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.EmailVerified = false
+
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(),
+					sql.Text("userInvite:123"),
+				).Return(
+					user,
+					nil,
+				)
+
+				mock.EXPECT().UpdateUserVerifyEmail(
+					gomock.Any(),
+					userID,
+				).Return(
+					getSigninUser(userID),
+					nil,
+				)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(),
+					userID,
+				).Return(
+					[]sql.AuthUserRole{},
+					nil,
+				)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(
+					refreshTokenID,
+					nil,
+				)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(),
+					userID,
+				).Return(
+					sql.TimestampTz(time.Now()),
+					nil,
+				)
+
+				return mock
+			},
+			request: api.VerifyTicketRequestObject{
+				Params: api.VerifyTicketParams{
+					Ticket:     "userInvite:123",
+					RedirectTo: "http://localhost:3000/redirect",
+					Type:       nil,
+				},
+			},
+			expectedResponse: api.VerifyTicket302Response{
+				Headers: api.VerifyTicket302ResponseHeaders{
+					Location: `http:\/\/localhost:3000\/redirect\?refreshToken=.+&type=userInvite`,
+				},
+			},
+			expectedJWT:       nil,
+			jwtTokenFn:        nil,
+			getControllerOpts: nil,
+		},
+
 		{
 			name: "passwordReset:email not verified",
 			config: func() *controller.Config {
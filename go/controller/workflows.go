@@ -15,9 +15,12 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/hooks"
+	"github.com/nhost/hasura-auth/go/metrics"
 	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/oidc"
 	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
 	"github.com/oapi-codegen/runtime/types"
 )
 
@@ -27,6 +30,12 @@ type HIBPClient interface {
 	IsPasswordPwned(ctx context.Context, password string) (bool, error)
 }
 
+type BeforeSignUpHook interface {
+	BeforeSignUp(
+		ctx context.Context, req hooks.BeforeSignUpRequest, logger *slog.Logger,
+	) (hooks.BeforeSignUpResponse, error)
+}
+
 type Workflows struct {
 	config               *Config
 	jwtGetter            JWTGetter
@@ -37,7 +46,9 @@ type Workflows struct {
 	idTokenValidator     *oidc.IDTokenValidatorProviders
 	redirectURLValidator func(redirectTo string) bool
 	ValidateEmail        func(email string) bool
+	normalizePhoneNumber func(phoneNumber string) (string, error)
 	gravatarURL          func(string) string
+	beforeSignUpHook     BeforeSignUpHook
 }
 
 func NewWorkflows(
@@ -68,6 +79,18 @@ func NewWorkflows(
 		cfg.AllowedEmails,
 	)
 
+	phoneNumberNormalizer := NormalizePhoneNumber(
+		cfg.AllowedPhoneCountryCodes,
+		cfg.BlockedPhoneCountryCodes,
+	)
+
+	var beforeSignUpHook BeforeSignUpHook
+	if cfg.HookBeforeSignUpURL != "" {
+		beforeSignUpHook = hooks.NewClient(
+			cfg.HookBeforeSignUpURL, cfg.HookSecret, cfg.HookTimeout, cfg.HookFailOpen,
+		)
+	}
+
 	return &Workflows{
 		config:               cfg,
 		jwtGetter:            jwtGetter,
@@ -77,8 +100,10 @@ func NewWorkflows(
 		sms:                  sms,
 		redirectURLValidator: redirectURLValidator,
 		ValidateEmail:        emailValidator,
+		normalizePhoneNumber: phoneNumberNormalizer,
 		idTokenValidator:     idTokenValidator,
 		gravatarURL:          gravatarURL,
+		beforeSignUpHook:     beforeSignUpHook,
 	}, nil
 }
 
@@ -115,7 +140,7 @@ func (wf *Workflows) ValidatePassword(
 }
 
 func (wf *Workflows) ValidateSignUpOptions( //nolint:cyclop
-	options *api.SignUpOptions, defaultName string, logger *slog.Logger,
+	ctx context.Context, options *api.SignUpOptions, defaultName string, logger *slog.Logger,
 ) (*api.SignUpOptions, *APIError) {
 	if options == nil {
 		options = &api.SignUpOptions{} //nolint:exhaustruct
@@ -152,16 +177,7 @@ func (wf *Workflows) ValidateSignUpOptions( //nolint:cyclop
 		options.DisplayName = &defaultName
 	}
 
-	if options.Locale == nil {
-		options.Locale = ptr(wf.config.DefaultLocale)
-	}
-	if !slices.Contains(wf.config.AllowedLocales, deptr(options.Locale)) {
-		logger.Warn(
-			"locale not allowed, using default",
-			slog.String("locale", deptr(options.Locale)),
-		)
-		options.Locale = ptr(wf.config.DefaultLocale)
-	}
+	options.Locale = ptr(wf.ResolveLocale(ctx, options.Locale, logger))
 
 	return options, nil
 }
@@ -455,12 +471,19 @@ func (wf *Workflows) UpdateSession( //nolint:funlen
 		allowedRoles = append(allowedRoles, user.DefaultRole)
 	}
 
+	extraClaims, apiErr := wf.organizationClaims(
+		ctx, userRoles[0].OrganizationID, user.ID, logger,
+	)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
 	accessToken, expiresIn, err := wf.jwtGetter.GetToken(
-		ctx, user.ID, user.IsAnonymous, allowedRoles, user.DefaultRole, nil, logger,
+		ctx, user.ID, user.IsAnonymous, allowedRoles, user.DefaultRole, extraClaims, logger,
 	)
 	if err != nil {
 		logger.Error("error getting jwt", logError(err))
-		return nil, ErrInternalServerError
+		return nil, getTokenError(err)
 	}
 
 	var metadata map[string]any
@@ -495,12 +518,41 @@ func (wf *Workflows) UpdateSession( //nolint:funlen
 	}, nil
 }
 
-func (wf *Workflows) NewSession( //nolint:funlen
+type sessionOptions struct {
+	withoutRefreshToken bool
+	skipLastSeen        bool
+}
+
+type SessionOption func(*sessionOptions)
+
+// SessionWithoutRefreshToken creates a session that can't be renewed once
+// its access token expires.
+func SessionWithoutRefreshToken() SessionOption {
+	return func(o *sessionOptions) {
+		o.withoutRefreshToken = true
+	}
+}
+
+// SessionSkipLastSeen doesn't update the user's last_seen column, useful when
+// the session isn't created by the user themselves.
+func SessionSkipLastSeen() SessionOption {
+	return func(o *sessionOptions) {
+		o.skipLastSeen = true
+	}
+}
+
+func (wf *Workflows) NewSession( //nolint:funlen,cyclop
 	ctx context.Context,
 	user sql.AuthUser,
 	customClaims map[string]any,
 	logger *slog.Logger,
+	opts ...SessionOption,
 ) (*api.Session, error) {
+	var options sessionOptions
+	for _, o := range opts {
+		o(&options)
+	}
+
 	userRoles, err := wf.db.GetUserRoles(ctx, user.ID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting roles by user id: %w", err)
@@ -514,17 +566,24 @@ func (wf *Workflows) NewSession( //nolint:funlen
 		allowedRoles = append(allowedRoles, user.DefaultRole)
 	}
 
-	refreshToken := uuid.New()
-	expiresAt := time.Now().Add(time.Duration(wf.config.RefreshTokenExpiresIn) * time.Second)
-	refreshTokenID, apiErr := wf.InsertRefreshtoken(
-		ctx, user.ID, refreshToken.String(), expiresAt, sql.RefreshTokenTypeRegular, nil, logger,
-	)
-	if apiErr != nil {
-		return nil, apiErr
+	var refreshToken, refreshTokenID string
+	if !options.withoutRefreshToken {
+		token := uuid.New()
+		expiresAt := time.Now().Add(time.Duration(wf.config.RefreshTokenExpiresIn) * time.Second)
+		tokenID, apiErr := wf.InsertRefreshtoken(
+			ctx, user.ID, token.String(), expiresAt, sql.RefreshTokenTypeRegular, nil, logger,
+		)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		refreshToken = token.String()
+		refreshTokenID = tokenID.String()
 	}
 
-	if _, err := wf.db.UpdateUserLastSeen(ctx, user.ID); err != nil {
-		return nil, fmt.Errorf("error updating user last seen: %w", err)
+	if !options.skipLastSeen {
+		if _, err := wf.db.UpdateUserLastSeen(ctx, user.ID); err != nil {
+			return nil, fmt.Errorf("error updating user last seen: %w", err)
+		}
 	}
 
 	accessToken, expiresIn, err := wf.jwtGetter.GetToken(
@@ -543,8 +602,8 @@ func (wf *Workflows) NewSession( //nolint:funlen
 	return &api.Session{
 		AccessToken:          accessToken,
 		AccessTokenExpiresIn: expiresIn,
-		RefreshTokenId:       refreshTokenID.String(),
-		RefreshToken:         refreshToken.String(),
+		RefreshTokenId:       refreshTokenID,
+		RefreshToken:         refreshToken,
 		User: &api.User{
 			AvatarUrl:           user.AvatarUrl,
 			CreatedAt:           user.CreatedAt.Time,
@@ -592,6 +651,29 @@ func (wf *Workflows) GetJWTInContext(
 	return userID, nil
 }
 
+// ForbidImpersonation rejects the request if the token in the context was
+// issued to an impersonator. Impersonation sessions are meant for support, they
+// can't manage the user's credentials, MFA or account.
+func (wf *Workflows) ForbidImpersonation(
+	ctx context.Context,
+	logger *slog.Logger,
+) *APIError {
+	jwtToken, ok := wf.jwtGetter.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if wf.jwtGetter.IsImpersonated(jwtToken) {
+		logger.Warn(
+			"action not allowed while impersonating",
+			slog.String("impersonator", wf.jwtGetter.GetCustomClaim(jwtToken, impersonatorClaim)),
+		)
+		return ErrForbiddenImpersonation
+	}
+
+	return nil
+}
+
 func (wf *Workflows) GetUserFromJWTInContext(
 	ctx context.Context,
 	logger *slog.Logger,
@@ -720,6 +802,12 @@ func (wf *Workflows) ChangePassword(
 		return ErrInternalServerError
 	}
 
+	wf.RecordAuditEvent(ctx, userID, api.PasswordChanged, nil, logger)
+	wf.EmitUserWebhookEvent(ctx, webhooks.EventUserPasswordChanged, userID, "", logger)
+	wf.SendSecurityNotificationByUserID(
+		ctx, userID, notifications.TemplateNamePasswordChanged, logger,
+	)
+
 	return nil
 }
 
@@ -783,7 +871,7 @@ type databaseWithoutSessionFn func(
 	ticketExpiresAt pgtype.Timestamptz,
 	metadata []byte,
 	gravatarURL string,
-) error
+) (uuid.UUID, error)
 
 func (wf *Workflows) SignupUserWithFn(
 	ctx context.Context,
@@ -815,6 +903,10 @@ func (wf *Workflows) SignupUserWithSession( //nolint:funlen
 		return nil, ErrSignupDisabled
 	}
 
+	if apiErr := wf.BeforeSignUp(ctx, email, options, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
 	refreshToken := uuid.New()
 	refreshTokenExpiresAt := time.Now().
 		Add(time.Duration(wf.config.RefreshTokenExpiresIn) * time.Second)
@@ -837,6 +929,9 @@ func (wf *Workflows) SignupUserWithSession( //nolint:funlen
 		return nil, sqlErrIsDuplicatedEmail(err, logger)
 	}
 
+	metrics.SignUp(ctx)
+	wf.EmitUserWebhookEvent(ctx, webhooks.EventUserSignedUp, userID, email, logger)
+
 	if wf.config.DisableNewUsers {
 		logger.Warn("new user disabled")
 		return nil, ErrDisabledUser
@@ -847,7 +942,7 @@ func (wf *Workflows) SignupUserWithSession( //nolint:funlen
 	)
 	if err != nil {
 		logger.Error("error getting jwt", logError(err))
-		return nil, ErrInternalServerError
+		return nil, getTokenError(err)
 	}
 
 	return &api.Session{
@@ -887,6 +982,10 @@ func (wf *Workflows) SignupUserWithouthSession(
 		return ErrSignupDisabled
 	}
 
+	if apiErr := wf.BeforeSignUp(ctx, email, options, logger); apiErr != nil {
+		return apiErr
+	}
+
 	metadata, err := json.Marshal(options.Metadata)
 	if err != nil {
 		logger.Error("error marshaling metadata", logError(err))
@@ -902,10 +1001,14 @@ func (wf *Workflows) SignupUserWithouthSession(
 		ticketExpiresAt = sql.TimestampTz(time.Now().Add(InAMonth))
 	}
 
-	if err := databaseWithoutSession(ticket, ticketExpiresAt, metadata, gravatarURL); err != nil {
+	userID, err := databaseWithoutSession(ticket, ticketExpiresAt, metadata, gravatarURL)
+	if err != nil {
 		return sqlErrIsDuplicatedEmail(err, logger)
 	}
 
+	metrics.SignUp(ctx)
+	wf.EmitUserWebhookEvent(ctx, webhooks.EventUserSignedUp, userID, email, logger)
+
 	if wf.config.DisableNewUsers {
 		logger.Warn("new user disabled")
 		return ErrDisabledUser
@@ -944,6 +1047,31 @@ func (wf *Workflows) SignupAnonymousUser( //nolint:funlen
 		return nil, ErrSignupDisabled
 	}
 
+	options := &api.SignUpOptions{
+		AllowedRoles: &[]string{anonymousRole},
+		DefaultRole:  ptr(anonymousRole),
+		DisplayName:  &displayName,
+		Locale:       &locale,
+		Metadata:     &reqMetadata,
+		RedirectTo:   nil,
+	}
+	if apiErr := wf.BeforeSignUp(ctx, "", options, logger); apiErr != nil {
+		return nil, apiErr
+	}
+	displayName = deptr(options.DisplayName)
+	locale = deptr(options.Locale)
+	reqMetadata = deptr(options.Metadata)
+	roles := deptr(options.AllowedRoles)
+	defaultRole := deptr(options.DefaultRole)
+
+	// roles set by the hook are held to the same rules as the ones in requests
+	for _, role := range roles {
+		if role != anonymousRole && !slices.Contains(wf.config.DefaultAllowedRoles, role) {
+			logger.Warn("role not allowed", slog.String("role", role))
+			return nil, ErrRoleNotAllowed
+		}
+	}
+
 	refreshToken := uuid.New()
 	refreshTokenExpiresAt := time.Now().
 		Add(time.Duration(wf.config.RefreshTokenExpiresIn) * time.Second)
@@ -967,26 +1095,30 @@ func (wf *Workflows) SignupAnonymousUser( //nolint:funlen
 			IsAnonymous:           true,
 			EmailVerified:         false,
 			Locale:                locale,
-			DefaultRole:           anonymousRole,
+			DefaultRole:           defaultRole,
 			Metadata:              metadata,
 			RefreshTokenHash:      sql.Text(hashRefreshToken([]byte(refreshToken.String()))),
 			RefreshTokenExpiresAt: sql.TimestampTz(refreshTokenExpiresAt),
-			Roles:                 []string{anonymousRole},
+			Roles:                 roles,
 		},
 	)
 	if err != nil {
 		logger.Error("error inserting user", logError(err))
 		return nil, &APIError{api.InternalServerError}
 	}
+	metrics.SignUp(ctx)
+	wf.EmitUserWebhookEvent(ctx, webhooks.EventUserSignedUp, resp.ID, "", logger)
 
 	accessToken, expiresIn, err := wf.jwtGetter.GetToken(
-		ctx, resp.ID, true, []string{anonymousRole}, anonymousRole, nil, logger,
+		ctx, resp.ID, true, roles, defaultRole, nil, logger,
 	)
 	if err != nil {
 		logger.Error("error getting jwt", logError(err))
-		return nil, ErrInternalServerError
+		return nil, getTokenError(err)
 	}
 
+	wf.RecordSignIn(ctx, resp.ID, signInMethodAnonymous, logger)
+
 	return &api.Session{
 		AccessToken:          accessToken,
 		AccessTokenExpiresIn: expiresIn,
@@ -995,7 +1127,7 @@ func (wf *Workflows) SignupAnonymousUser( //nolint:funlen
 		User: &api.User{
 			AvatarUrl:           "",
 			CreatedAt:           time.Now(),
-			DefaultRole:         anonymousRole,
+			DefaultRole:         defaultRole,
 			DisplayName:         displayName,
 			Email:               nil,
 			EmailVerified:       false,
@@ -1005,7 +1137,7 @@ func (wf *Workflows) SignupAnonymousUser( //nolint:funlen
 			Metadata:            reqMetadata,
 			PhoneNumber:         nil,
 			PhoneNumberVerified: false,
-			Roles:               []string{anonymousRole},
+			Roles:               roles,
 			ActiveMfaType:       nil,
 		},
 	}, nil
@@ -1062,6 +1194,8 @@ func (wf *Workflows) DeanonymizeUser(
 		return ErrInternalServerError
 	}
 
+	wf.InvalidateCustomClaims(ctx, userID, logger)
+
 	if deleteRefreshTokens {
 		if err := wf.db.DeleteRefreshTokens(ctx, userID); err != nil {
 			logger.Error("error deleting refresh tokens", logError(err))
@@ -1069,9 +1203,22 @@ func (wf *Workflows) DeanonymizeUser(
 		}
 	}
 
+	wf.EmitUserWebhookEvent(ctx, webhooks.EventUserDeanonymized, userID, email, logger)
+
 	return nil
 }
 
+// InvalidateCustomClaims drops the cached custom claims of the user after
+// their roles, metadata or organizations change. Failing to do so isn't fatal
+// as the cache entry eventually expires.
+func (wf *Workflows) InvalidateCustomClaims(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) {
+	if err := wf.jwtGetter.InvalidateCustomClaims(ctx, userID); err != nil {
+		logger.Warn("error invalidating custom claims", logError(err))
+	}
+}
+
 func (wf *Workflows) GetOIDCProfileFromIDToken(
 	providerID api.IdTokenProvider,
 	idToken string,
@@ -1190,6 +1337,10 @@ func (wf *Workflows) UpdateUserVerifyEmail(
 		return sql.AuthUser{}, ErrInternalServerError
 	}
 
+	wf.EmitUserWebhookEvent(
+		ctx, webhooks.EventUserEmailVerified, userP.ID, userP.Email.String, logger,
+	)
+
 	return userP, nil
 }
 
@@ -1216,11 +1367,15 @@ func (wf *Workflows) GetUserByPhoneNumber(
 	phoneNumber string,
 	logger *slog.Logger,
 ) (sql.AuthUser, *APIError) {
-	user, err := wf.db.GetUserByPhoneNumber(ctx, sql.Text(phoneNumber))
+	user, err := wf.lookupUserByPhoneNumber(ctx, phoneNumber, logger)
 	if errors.Is(err, pgx.ErrNoRows) {
 		logger.Warn("user not found by phone number")
 		return sql.AuthUser{}, ErrUserPhoneNumberNotFound
 	}
+	if errors.Is(err, errAmbiguousPhoneNumber) {
+		logger.Error("phone number matches several users, it needs to be fixed manually")
+		return sql.AuthUser{}, ErrUserPhoneNumberNotFound
+	}
 	if err != nil {
 		logger.Error("error getting user by phone number", logError(err))
 		return sql.AuthUser{}, ErrInternalServerError
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	signInMethodAnonymous       = "anonymous"
+	signInMethodEmailPassword   = "email-password"
+	signInMethodMfaTotp         = "mfa-totp"
+	signInMethodOTPEmail        = "otp-email"
+	signInMethodPasswordlessSMS = "passwordless-sms"
+	signInMethodPAT             = "pat"
+	signInMethodPhonePassword   = "phone-password"
+	signInMethodProvider        = "provider"
+	signInMethodTicket          = "ticket"
+	signInMethodWebauthn        = "webauthn"
+)
+
+func auditRequestInfo(ctx context.Context) (pgtype.Text, pgtype.Text, pgtype.Text) {
+	var ip, userAgent pgtype.Text
+	if ginCtx, ok := ctx.(*gin.Context); ok && ginCtx.Request != nil {
+		ip = sql.Text(ginCtx.ClientIP())
+		userAgent = sql.Text(ginCtx.Request.UserAgent())
+	}
+
+	var traceID pgtype.Text
+	if trace := middleware.TraceFromContext(ctx); trace.TraceID != "" {
+		traceID = sql.Text(trace.TraceID)
+	}
+
+	return ip, userAgent, traceID
+}
+
+// RecordAuditEvent stores a security event for the user in auth.audit_events if the
+// audit log is enabled. Errors are logged but never fail the ongoing request.
+func (wf *Workflows) RecordAuditEvent(
+	ctx context.Context,
+	userID uuid.UUID,
+	event api.AuditEventType,
+	metadata map[string]any,
+	logger *slog.Logger,
+) {
+	if !wf.config.AuditLogEnabled {
+		return
+	}
+
+	var b []byte
+	if metadata != nil {
+		var err error
+		b, err = json.Marshal(metadata)
+		if err != nil {
+			logger.Error("error marshalling audit event metadata", logError(err))
+			return
+		}
+	}
+
+	ip, userAgent, traceID := auditRequestInfo(ctx)
+
+	if err := wf.db.InsertAuditEvent(ctx, sql.InsertAuditEventParams{
+		UserID:    userID,
+		Event:     string(event),
+		IpAddress: ip,
+		UserAgent: userAgent,
+		TraceID:   traceID,
+		Metadata:  b,
+	}); err != nil {
+		logger.Error(
+			"error recording audit event", slog.String("event", string(event)), logError(err),
+		)
+	}
+}
+
+func (wf *Workflows) RecordSignIn(
+	ctx context.Context, userID uuid.UUID, method string, logger *slog.Logger,
+) {
+	wf.recordSignIn(ctx, userID, map[string]any{"method": method}, logger)
+}
+
+// recordSignIn records the sign-in and lets the user know if it comes from a
+// device they never signed in from. The check needs to happen before the
+// sign-in is recorded.
+func (wf *Workflows) recordSignIn(
+	ctx context.Context, userID uuid.UUID, metadata map[string]any, logger *slog.Logger,
+) {
+	method, _ := metadata["method"].(string)
+	provider, _ := metadata["provider"].(string)
+	metrics.SignIn(method, provider)
+
+	newDevice := wf.isNewSignInDevice(ctx, userID, logger)
+
+	wf.RecordAuditEvent(ctx, userID, api.Signin, metadata, logger)
+
+	if newDevice {
+		wf.SendSecurityNotificationByUserID(
+			ctx, userID, notifications.TemplateNameSigninNewDevice, logger,
+		)
+	}
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/hooks"
+)
+
+// BeforeSignUp calls the before-signup hook, if configured, and applies the
+// allowed roles, default role, display name and metadata it returns to options.
+func (wf *Workflows) BeforeSignUp(
+	ctx context.Context, email string, options *api.SignUpOptions, logger *slog.Logger,
+) *APIError {
+	if wf.beforeSignUpHook == nil {
+		return nil
+	}
+
+	resp, err := wf.beforeSignUpHook.BeforeSignUp(
+		ctx, hooks.BeforeSignUpRequest{Email: email, Options: deptr(options)}, logger,
+	)
+	if errors.Is(err, hooks.ErrRejected) {
+		logger.Warn("signup rejected by hook", logError(err))
+		return ErrHookRejected
+	}
+	if err != nil {
+		logger.Error("error calling before-signup hook", logError(err))
+		return ErrInternalServerError
+	}
+
+	if resp.Options == nil {
+		return nil
+	}
+
+	if resp.Options.AllowedRoles != nil {
+		options.AllowedRoles = resp.Options.AllowedRoles
+	}
+	if resp.Options.DefaultRole != nil {
+		options.DefaultRole = resp.Options.DefaultRole
+	}
+	if resp.Options.DisplayName != nil {
+		options.DisplayName = resp.Options.DisplayName
+	}
+	if resp.Options.Metadata != nil {
+		options.Metadata = resp.Options.Metadata
+	}
+
+	if !slices.Contains(deptr(options.AllowedRoles), deptr(options.DefaultRole)) {
+		logger.Error("before-signup hook returned a default role not in allowed roles")
+		return ErrDefaultRoleMustBeInAllowedRoles
+	}
+
+	return nil
+}
+
+func getTokenError(err error) *APIError {
+	if errors.Is(err, hooks.ErrRejected) {
+		return ErrHookRejected
+	}
+
+	return ErrInternalServerError
+}
@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	mfaOTPMaxAttempts = 5
+	// how many email or SMS codes a user can have pending at the same time,
+	// codes stay pending until they are used or expire after 5 minutes
+	mfaOTPMaxActiveChallenges = 3
+)
+
+func signInMethodMfa(mfaType string) string {
+	return "mfa-" + mfaType
+}
+
+func (wf *Workflows) sendMfaOTPEmail(
+	ctx context.Context,
+	user sql.AuthUser,
+	ticket string,
+	logger *slog.Logger,
+) *APIError {
+	otp, otpHash, err := GenerateOTP()
+	if err != nil {
+		logger.Error("error generating OTP", logError(err))
+		return ErrInternalServerError
+	}
+
+	if apiErr := wf.insertMfaChallenge(
+		ctx, user.ID, ticket, api.Email, otpHash, logger,
+	); apiErr != nil {
+		return apiErr
+	}
+
+	return wf.SendEmail(
+		ctx,
+		user.Email.String,
+		user.Locale,
+		LinkTypeNone,
+		otp,
+		wf.config.ClientURL.String(),
+		notifications.TemplateNameSigninOTP,
+		user.DisplayName,
+		user.Email.String,
+		"",
+		logger,
+	)
+}
+
+func (wf *Workflows) sendMfaOTPSms(
+	ctx context.Context,
+	user sql.AuthUser,
+	ticket string,
+	logger *slog.Logger,
+) *APIError {
+	if wf.sms == nil {
+		logger.Error("sms mfa is active but no SMS provider is configured")
+		return ErrCannotSendSMS
+	}
+
+	otpHash, _, err := wf.sms.SendVerificationCode(user.PhoneNumber.String, user.Locale)
+	if err != nil {
+		logger.Error("error sending SMS verification code", logError(err))
+		return ErrCannotSendSMS
+	}
+
+	return wf.insertMfaChallenge(ctx, user.ID, ticket, api.Sms, otpHash, logger)
+}
+
+func (wf *Workflows) insertMfaChallenge(
+	ctx context.Context,
+	userID uuid.UUID,
+	ticket string,
+	mfaType api.UserMfaRequestActiveMfaType,
+	otpHash string,
+	logger *slog.Logger,
+) *APIError {
+	if err := wf.db.InsertMfaChallenge(ctx, sql.InsertMfaChallengeParams{
+		UserID:    userID,
+		Ticket:    ticket,
+		MfaType:   string(mfaType),
+		OtpHash:   otpHash,
+		ExpiresAt: sql.TimestampTz(time.Now().Add(In5Minutes)),
+	}); err != nil {
+		logger.Error("error inserting mfa challenge", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+const (
+	mfaTicketPrefixSignIn = "mfa"
+	mfaTicketPrefixChange = "mfaChange"
+)
+
+// SendMfaOTPChallenge sends a one-time password to the email address or phone
+// number of a user with email or sms MFA active and returns the ticket that
+// has to be presented alongside it.
+func (wf *Workflows) SendMfaOTPChallenge(
+	ctx context.Context,
+	user sql.AuthUser,
+	logger *slog.Logger,
+) (string, *APIError) {
+	return wf.sendMfaOTPChallenge(ctx, user, mfaTicketPrefixSignIn, logger)
+}
+
+// SendMfaChangeOTPChallenge is like SendMfaOTPChallenge but the ticket can only
+// be used to deactivate or change the MFA method of the user.
+func (wf *Workflows) SendMfaChangeOTPChallenge(
+	ctx context.Context,
+	user sql.AuthUser,
+	logger *slog.Logger,
+) (string, *APIError) {
+	return wf.sendMfaOTPChallenge(ctx, user, mfaTicketPrefixChange, logger)
+}
+
+func (wf *Workflows) sendMfaOTPChallenge(
+	ctx context.Context,
+	user sql.AuthUser,
+	prefix string,
+	logger *slog.Logger,
+) (string, *APIError) {
+	if apiErr := wf.checkMfaOTPChallengeLimit(ctx, user.ID, logger); apiErr != nil {
+		return "", apiErr
+	}
+
+	var apiErr *APIError
+	var ticket string
+	switch api.UserMfaRequestActiveMfaType(user.ActiveMfaType.String) { //nolint:exhaustive
+	case api.Email:
+		ticket = prefix + "Email:" + uuid.NewString()
+		apiErr = wf.sendMfaOTPEmail(ctx, user, ticket, logger)
+	case api.Sms:
+		ticket = prefix + "Sms:" + uuid.NewString()
+		apiErr = wf.sendMfaOTPSms(ctx, user, ticket, logger)
+	default:
+		logger.Error("unsupported mfa type", slog.String("mfaType", user.ActiveMfaType.String))
+		return "", ErrMfaTypeNotFound
+	}
+
+	if apiErr != nil {
+		return "", apiErr
+	}
+
+	return ticket, nil
+}
+
+// checkMfaOTPChallengeLimit stops users from requesting more email or SMS codes
+// while mfaOTPMaxActiveChallenges of them are still pending, this applies to
+// sign-ins as well as MFA changes.
+func (wf *Workflows) checkMfaOTPChallengeLimit(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) *APIError {
+	count, err := wf.db.CountActiveMfaChallenges(ctx, userID)
+	if err != nil {
+		logger.Error("error counting mfa challenges", logError(err))
+		return ErrInternalServerError
+	}
+
+	if count >= mfaOTPMaxActiveChallenges {
+		logger.Warn("too many pending mfa challenges")
+		return ErrTooManyRequests
+	}
+
+	return nil
+}
+
+// VerifyMfaOTPChallenge checks the one-time password of an email or sms MFA
+// challenge. Every call counts as an attempt and the ticket stops being valid
+// after mfaOTPMaxAttempts. The user is returned alongside ErrInvalidOTP so
+// the failed attempt can be recorded.
+func (wf *Workflows) VerifyMfaOTPChallenge(
+	ctx context.Context,
+	ticket string,
+	otp string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	challenge, err := wf.db.GetMfaChallengeByTicket(ctx, sql.GetMfaChallengeByTicketParams{
+		Ticket:      ticket,
+		MaxAttempts: mfaOTPMaxAttempts,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("mfa challenge not found, expired or out of attempts")
+		return sql.AuthUser{}, ErrInvalidTicket
+	}
+	if err != nil {
+		logger.Error("error getting mfa challenge", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError
+	}
+
+	user, apiErr := wf.GetUser(ctx, challenge.UserID, logger)
+	if apiErr != nil {
+		return sql.AuthUser{}, apiErr
+	}
+
+	if user.ActiveMfaType.String != challenge.MfaType {
+		logger.Warn("mfa type changed since the challenge was issued")
+		return sql.AuthUser{}, ErrInvalidTicket
+	}
+
+	if !wf.verifyMfaOTP(ctx, challenge, user, otp) {
+		logger.Warn("invalid mfa otp")
+		return user, ErrInvalidOTP
+	}
+
+	if err := wf.db.DeleteMfaChallenge(ctx, challenge.ID); err != nil {
+		logger.Error("error deleting mfa challenge", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError
+	}
+
+	return user, nil
+}
+
+func (wf *Workflows) verifyMfaOTP(
+	ctx context.Context,
+	challenge sql.AuthMfaChallenge,
+	user sql.AuthUser,
+	otp string,
+) bool {
+	if challenge.OtpHash != "" {
+		return verifyHashPassword(otp, challenge.OtpHash)
+	}
+
+	return wf.checkSMSProviderCode(ctx, user, otp)
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	organizationOwnerRole           = "owner"
+	organizationInvitationExpiresIn = 7 * 24 * time.Hour
+
+	claimOrganizationID           = "x-hasura-org-id"
+	claimOrganizationAllowedRoles = "x-hasura-org-allowed-roles"
+)
+
+func (wf *Workflows) GetOrganizationMember(
+	ctx context.Context,
+	organizationID uuid.UUID,
+	userID uuid.UUID,
+	logger *slog.Logger,
+) (sql.AuthOrganizationMember, *APIError) {
+	member, err := wf.db.GetOrganizationMember(ctx, sql.GetOrganizationMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("user is not a member of the organization")
+		return sql.AuthOrganizationMember{}, ErrForbiddenOrganization
+	}
+	if err != nil {
+		logger.Error("error getting organization member", logError(err))
+		return sql.AuthOrganizationMember{}, ErrInternalServerError
+	}
+
+	return member, nil
+}
+
+// organizationClaims returns the claims scoping an access token to the
+// organization stored in the refresh token. If the user is no longer a member
+// of the organization no claims are returned so the token is issued unscoped.
+func (wf *Workflows) organizationClaims(
+	ctx context.Context,
+	organizationID pgtype.UUID,
+	userID uuid.UUID,
+	logger *slog.Logger,
+) (map[string]any, *APIError) {
+	if !organizationID.Valid {
+		return nil, nil
+	}
+
+	member, apiErr := wf.GetOrganizationMember(
+		ctx, uuid.UUID(organizationID.Bytes), userID, logger,
+	)
+	switch {
+	case errors.Is(apiErr, ErrForbiddenOrganization):
+		return nil, nil
+	case apiErr != nil:
+		return nil, apiErr
+	}
+
+	return map[string]any{
+		claimOrganizationID:           member.OrganizationID.String(),
+		claimOrganizationAllowedRoles: member.Roles,
+	}, nil
+}
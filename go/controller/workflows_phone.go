@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	otpMethodPhoneVerification = "phone-verification"
+	otpMethodPasswordResetSms  = "password-reset-sms"
+
+	phoneOTPMaxAttempts = 5
+)
+
+// ValidatePhoneNumber checks the phone number is valid and its country is
+// allowed and returns it in E.164 format, which is how it must be looked up
+// and stored.
+func (wf *Workflows) ValidatePhoneNumber(
+	phoneNumber string,
+	logger *slog.Logger,
+) (string, *APIError) {
+	normalized, err := wf.normalizePhoneNumber(phoneNumber)
+	switch {
+	case errors.Is(err, errPhoneNumberNotAllowed):
+		logger.Warn("phone number country not allowed")
+		return "", ErrPhoneNumberNotAllowed
+	case err != nil:
+		logger.Warn("invalid phone number", logError(err))
+		return "", ErrInvalidPhoneNumber
+	}
+
+	return normalized, nil
+}
+
+var errAmbiguousPhoneNumber = errors.New("several users have the same legacy phone number")
+
+// lookupUserByPhoneNumber gets the user with the given E.164 phone number.
+// Numbers stored before they were normalized are matched by their digits and
+// rewritten in E.164 format so the following lookups find them directly. If
+// several legacy numbers have the same digits none of them is matched and
+// errAmbiguousPhoneNumber is returned, as in the normalization migration.
+func (wf *Workflows) lookupUserByPhoneNumber(
+	ctx context.Context,
+	phoneNumber string,
+	logger *slog.Logger,
+) (sql.AuthUser, error) {
+	user, err := wf.db.GetUserByPhoneNumber(ctx, sql.Text(phoneNumber))
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return user, err //nolint:wrapcheck
+	}
+
+	users, err := wf.db.GetUsersByPhoneNumberLegacy(ctx, strings.TrimPrefix(phoneNumber, "+"))
+	switch {
+	case err != nil:
+		return sql.AuthUser{}, err //nolint:wrapcheck
+	case len(users) == 0:
+		return sql.AuthUser{}, pgx.ErrNoRows
+	case len(users) > 1:
+		return sql.AuthUser{}, errAmbiguousPhoneNumber
+	}
+	user = users[0]
+
+	logger.Info("normalizing legacy phone number", slog.String("user_id", user.ID.String()))
+	if err := wf.db.UpdateUserPhoneNumber(ctx, sql.UpdateUserPhoneNumberParams{
+		ID:          user.ID,
+		PhoneNumber: sql.Text(phoneNumber),
+	}); err != nil {
+		return sql.AuthUser{}, fmt.Errorf("error normalizing phone number: %w", err)
+	}
+	user.PhoneNumber = sql.Text(phoneNumber)
+
+	return user, nil
+}
+
+// SendPhoneNumberOTP sends a one-time password to the user's phone number and
+// stores it so it can only be used for the given method.
+func (wf *Workflows) SendPhoneNumberOTP(
+	ctx context.Context,
+	user sql.AuthUser,
+	method string,
+	logger *slog.Logger,
+) *APIError {
+	if wf.sms == nil {
+		logger.Error("no SMS provider is configured")
+		return ErrCannotSendSMS
+	}
+
+	otpHash, expiresAt, err := wf.sms.SendVerificationCode(user.PhoneNumber.String, user.Locale)
+	if err != nil {
+		logger.Error("error sending SMS verification code", logError(err))
+		return ErrCannotSendSMS
+	}
+
+	var hash pgtype.Text
+	if otpHash != "" {
+		hash = sql.Text(otpHash)
+	}
+
+	if _, err := wf.db.UpdateUserOTPHash(ctx, sql.UpdateUserOTPHashParams{
+		ID:                user.ID,
+		OtpHash:           hash,
+		OtpHashExpiresAt:  sql.TimestampTz(expiresAt),
+		OtpMethodLastUsed: sql.Text(method),
+	}); err != nil {
+		logger.Error("error updating user OTP hash", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// VerifyPhoneNumberOTP checks a one-time password sent with SendPhoneNumberOTP
+// and, if valid, clears it and marks the phone number as verified. Every call
+// counts as an attempt and the code stops being valid after
+// phoneOTPMaxAttempts, a new code resets the counter.
+func (wf *Workflows) VerifyPhoneNumberOTP(
+	ctx context.Context,
+	user sql.AuthUser,
+	otp string,
+	method string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	_, err := wf.db.IncrementUserOTPHashAttempts(ctx, sql.IncrementUserOTPHashAttemptsParams{
+		ID:          user.ID,
+		MaxAttempts: phoneOTPMaxAttempts,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("too many attempts to verify the OTP")
+		return sql.AuthUser{}, ErrInvalidOTP
+	}
+	if err != nil {
+		logger.Error("error counting OTP attempt", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError
+	}
+
+	if user.OtpHash.String != "" {
+		if user.OtpMethodLastUsed.String != method ||
+			user.OtpHashExpiresAt.Time.Before(time.Now()) ||
+			!verifyHashPassword(otp, user.OtpHash.String) {
+			logger.Warn("invalid OTP")
+			return sql.AuthUser{}, ErrInvalidOTP
+		}
+	} else if user.OtpMethodLastUsed.String != method ||
+		!wf.checkSMSProviderCode(ctx, user, otp) {
+		logger.Warn("invalid OTP")
+		return sql.AuthUser{}, ErrInvalidOTP
+	}
+
+	user, err = wf.db.UpdateUserVerifyPhoneNumber(ctx, user.ID)
+	if err != nil {
+		logger.Error("error verifying phone number", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError
+	}
+
+	return user, nil
+}
+
+// checkSMSProviderCode verifies codes that are kept by the SMS provider
+// instead of in the database, like with Twilio Verify.
+func (wf *Workflows) checkSMSProviderCode(
+	ctx context.Context,
+	user sql.AuthUser,
+	otp string,
+) bool {
+	if wf.sms == nil {
+		return false
+	}
+
+	smsUser, err := wf.sms.CheckVerificationCode(ctx, user.PhoneNumber.String, otp)
+	return err == nil && smsUser.ID == user.ID
+}
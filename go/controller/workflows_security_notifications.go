@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (wf *Workflows) securityNotificationEnabled(templateName notifications.TemplateName) bool {
+	return wf.email != nil &&
+		slices.Contains(wf.config.SecurityNotifications, string(templateName))
+}
+
+// SendSecurityNotification emails the user to let them know something
+// security-sensitive happened on their account if the notification is enabled.
+// Errors are logged but never fail the ongoing request.
+func (wf *Workflows) SendSecurityNotification(
+	ctx context.Context,
+	user sql.AuthUser,
+	templateName notifications.TemplateName,
+	logger *slog.Logger,
+) {
+	if !wf.securityNotificationEnabled(templateName) || !user.Email.Valid {
+		return
+	}
+
+	ip, userAgent, _ := auditRequestInfo(ctx)
+
+	if err := wf.email.SendEmail(
+		ctx,
+		user.Email.String,
+		user.Locale,
+		templateName,
+		notifications.TemplateData{
+			Link:        "",
+			DisplayName: user.DisplayName,
+			Email:       user.Email.String,
+			NewEmail:    user.NewEmail.String,
+			Ticket:      "",
+			RedirectTo:  "",
+			Locale:      user.Locale,
+			ServerURL:   wf.config.ServerURL.String(),
+			ClientURL:   wf.config.ClientURL.String(),
+			IPAddress:   ip.String,
+			UserAgent:   userAgent.String,
+		},
+	); err != nil {
+		logger.Error(
+			"error sending security notification",
+			slog.String("template", string(templateName)),
+			logError(err),
+		)
+	}
+}
+
+// SendSecurityNotificationByUserID is like SendSecurityNotification for the
+// paths that only know the ID of the user. The user is only fetched if the
+// notification is enabled.
+func (wf *Workflows) SendSecurityNotificationByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+	templateName notifications.TemplateName,
+	logger *slog.Logger,
+) {
+	if !wf.securityNotificationEnabled(templateName) {
+		return
+	}
+
+	user, err := wf.db.GetUser(ctx, userID)
+	if err != nil {
+		logger.Error("error getting user for security notification", logError(err))
+		return
+	}
+
+	wf.SendSecurityNotification(ctx, user, templateName, logger)
+}
+
+// isNewSignInDevice returns true if the user signed in before but never from
+// the IP address and user agent of the ongoing request. It relies on the
+// sign-ins recorded in the audit log.
+func (wf *Workflows) isNewSignInDevice(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) bool {
+	if !wf.securityNotificationEnabled(notifications.TemplateNameSigninNewDevice) {
+		return false
+	}
+
+	// sign-ins are only recorded in the audit log
+	if !wf.config.AuditLogEnabled {
+		logger.Warn("signin-new-device notification needs the audit log, skipping")
+		return false
+	}
+
+	ip, userAgent, _ := auditRequestInfo(ctx)
+	if !ip.Valid {
+		return false
+	}
+
+	history, err := wf.db.GetUserSignInHistory(ctx, sql.GetUserSignInHistoryParams{
+		UserID:    userID,
+		IpAddress: ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		logger.Error("error getting sign-in history", logError(err))
+		return false
+	}
+
+	return history.HasSignedIn && !history.FromKnownDevice
+}
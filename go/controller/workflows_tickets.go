@@ -23,6 +23,7 @@ const (
 	TicketTypeVerifyEmail        TicketType = "verifyEmail"
 	TicketTypePasswordReset      TicketType = "passwordReset"
 	TicketTypeOTP                TicketType = "otp"
+	TicketTypeUserInvite         TicketType = "userInvite"
 )
 
 func generateTicket(ticketType TicketType) string {
@@ -88,6 +89,7 @@ const (
 	LinkTypeEmailConfirmChange LinkType = "emailConfirmChange"
 	LinkTypePasswordlessEmail  LinkType = "signinPasswordless"
 	LinkTypePasswordReset      LinkType = "passwordReset"
+	LinkTypeUserInvite         LinkType = "userInvite"
 )
 
 func GenLink(serverURL url.URL, typ LinkType, ticket, redirectTo string) (string, error) {
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// EmitWebhookEvent stores a delivery in auth.webhook_outbox for every subscription
+// interested in the event. Deliveries are sent asynchronously by the webhooks
+// dispatcher so errors are logged but never fail the ongoing request.
+func (wf *Workflows) EmitWebhookEvent(
+	ctx context.Context,
+	event webhooks.Event,
+	data map[string]any,
+	logger *slog.Logger,
+) {
+	if len(wf.config.WebhookSubscriptions) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(webhooks.NewPayload(event, data))
+	if err != nil {
+		logger.Error("error marshalling webhook payload", logError(err))
+		return
+	}
+
+	for _, sub := range wf.config.WebhookSubscriptions {
+		if !sub.Matches(event) {
+			continue
+		}
+
+		if err := wf.db.InsertWebhookOutbox(ctx, sql.InsertWebhookOutboxParams{
+			Event:   string(event),
+			Url:     sub.URL,
+			Payload: payload,
+		}); err != nil {
+			logger.Error(
+				"error storing webhook delivery",
+				slog.String("event", string(event)),
+				slog.String("url", sub.URL),
+				logError(err),
+			)
+		}
+	}
+}
+
+func (wf *Workflows) EmitUserWebhookEvent(
+	ctx context.Context, event webhooks.Event, userID uuid.UUID, email string, logger *slog.Logger,
+) {
+	data := map[string]any{"userId": userID.String()}
+	if email != "" {
+		data["email"] = email
+	}
+
+	wf.EmitWebhookEvent(ctx, event, data, logger)
+}
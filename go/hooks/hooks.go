@@ -0,0 +1,198 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+const (
+	HeaderHook = "X-Hasura-Auth-Hook"
+
+	HookBeforeSignUp = "before-signup"
+	HookBeforeToken  = "before-token"
+	HookCustomClaims = "custom-claims"
+
+	DefaultTimeout = 5 * time.Second
+
+	maxErrorBodyLength = 512
+)
+
+var (
+	ErrRejected   = errors.New("rejected by hook")
+	ErrHookFailed = errors.New("hook failed")
+)
+
+// Client calls a blocking hook. Requests are signed in the same way as webhooks so
+// receivers can share the verification code. If FailOpen is set, errors calling the
+// hook are logged and the operation proceeds as if the hook had allowed it.
+type Client struct {
+	url        string
+	secret     string
+	failOpen   bool
+	httpClient *http.Client
+}
+
+func NewClient(url, secret string, timeout time.Duration, failOpen bool) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Client{
+		url:        url,
+		secret:     secret,
+		failOpen:   failOpen,
+		httpClient: &http.Client{Timeout: timeout}, //nolint:exhaustruct
+	}
+}
+
+type BeforeSignUpRequest struct {
+	Email   string            `json:"email,omitempty"`
+	Options api.SignUpOptions `json:"options"`
+}
+
+// BeforeSignUpResponse is returned by the before-signup hook. Options that are set
+// replace the allowed roles, default role, display name and metadata of the signup.
+type BeforeSignUpResponse struct {
+	Reject  bool               `json:"reject"`
+	Reason  string             `json:"reason,omitempty"`
+	Options *api.SignUpOptions `json:"options,omitempty"`
+}
+
+type BeforeTokenRequest struct {
+	UserID       string         `json:"userId"`
+	IsAnonymous  bool           `json:"isAnonymous"`
+	DefaultRole  string         `json:"defaultRole"`
+	AllowedRoles []string       `json:"allowedRoles"`
+	Claims       map[string]any `json:"claims"`
+}
+
+// BeforeTokenResponse is returned by the before-token hook. Claims are added to the
+// token and RemoveClaims are removed from it, neither can modify the default claims.
+type BeforeTokenResponse struct {
+	Reject       bool           `json:"reject"`
+	Reason       string         `json:"reason,omitempty"`
+	Claims       map[string]any `json:"claims,omitempty"`
+	RemoveClaims []string       `json:"removeClaims,omitempty"`
+}
+
+type CustomClaimsRequest struct {
+	UserID       string   `json:"userId"`
+	IsAnonymous  bool     `json:"isAnonymous"`
+	DefaultRole  string   `json:"defaultRole"`
+	AllowedRoles []string `json:"allowedRoles"`
+	IP           string   `json:"ip,omitempty"`
+	UserAgent    string   `json:"userAgent,omitempty"`
+}
+
+// CustomClaimsResponse is returned by the custom-claims hook. Claims are merged
+// into the token like the ones resolved from AUTH_JWT_CUSTOM_CLAIMS.
+type CustomClaimsResponse struct {
+	Claims map[string]any `json:"claims"`
+}
+
+func (c *Client) BeforeSignUp(
+	ctx context.Context, req BeforeSignUpRequest, logger *slog.Logger,
+) (BeforeSignUpResponse, error) {
+	var resp BeforeSignUpResponse
+	if err := c.call(ctx, HookBeforeSignUp, req, &resp, logger); err != nil {
+		return BeforeSignUpResponse{}, err //nolint:exhaustruct
+	}
+
+	if resp.Reject {
+		return resp, fmt.Errorf("%w: %s", ErrRejected, resp.Reason)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) BeforeToken(
+	ctx context.Context, req BeforeTokenRequest, logger *slog.Logger,
+) (BeforeTokenResponse, error) {
+	var resp BeforeTokenResponse
+	if err := c.call(ctx, HookBeforeToken, req, &resp, logger); err != nil {
+		return BeforeTokenResponse{}, err //nolint:exhaustruct
+	}
+
+	if resp.Reject {
+		return resp, fmt.Errorf("%w: %s", ErrRejected, resp.Reason)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) CustomClaims(
+	ctx context.Context, req CustomClaimsRequest, logger *slog.Logger,
+) (CustomClaimsResponse, error) {
+	var resp CustomClaimsResponse
+	if err := c.call(ctx, HookCustomClaims, req, &resp, logger); err != nil {
+		return CustomClaimsResponse{}, err //nolint:exhaustruct
+	}
+
+	return resp, nil
+}
+
+func (c *Client) call(
+	ctx context.Context, hook string, req any, resp any, logger *slog.Logger,
+) error {
+	err := c.do(ctx, hook, req, resp)
+	if err == nil {
+		return nil
+	}
+
+	if c.failOpen {
+		logger.Warn(
+			"error calling hook, proceeding as fail-open is enabled",
+			slog.String("hook", hook),
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s: %w", ErrHookFailed, hook, err)
+}
+
+func (c *Client) do(ctx context.Context, hook string, req any, resp any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(HeaderHook, hook)
+	httpReq.Header.Set(webhooks.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	httpReq.Header.Set(webhooks.HeaderSignature, webhooks.Sign(c.secret, timestamp, body))
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(httpResp.Body, maxErrorBodyLength))
+		return fmt.Errorf("unexpected status code %d: %s", httpResp.StatusCode, b) //nolint:err113
+	}
+
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package hooks_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/hooks"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+func ptr[T any](x T) *T {
+	return &x
+}
+
+func stub(t *testing.T, statusCode int, body string, delay time.Duration) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("error reading body: %v", err)
+		}
+
+		timestamp, err := strconv.ParseInt(r.Header.Get(webhooks.HeaderTimestamp), 10, 64)
+		if err != nil {
+			t.Errorf("invalid timestamp header: %v", err)
+		}
+		if !webhooks.Verify("secret", timestamp, b, r.Header.Get(webhooks.HeaderSignature)) {
+			t.Errorf("invalid signature")
+		}
+
+		time.Sleep(delay)
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestBeforeSignUp(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		statusCode  int
+		body        string
+		delay       time.Duration
+		failOpen    bool
+		expected    hooks.BeforeSignUpResponse
+		expectedErr error
+	}{
+		{
+			name:       "allowed",
+			statusCode: http.StatusOK,
+			body:       `{}`,
+			delay:      0,
+			failOpen:   false,
+			expected: hooks.BeforeSignUpResponse{
+				Reject:  false,
+				Reason:  "",
+				Options: nil,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:       "allowed with options",
+			statusCode: http.StatusOK,
+			body:       `{"options": {"displayName": "Jane", "defaultRole": "editor"}}`,
+			delay:      0,
+			failOpen:   false,
+			expected: hooks.BeforeSignUpResponse{
+				Reject: false,
+				Reason: "",
+				Options: &api.SignUpOptions{ //nolint:exhaustruct
+					DisplayName: ptr("Jane"),
+					DefaultRole: ptr("editor"),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:       "rejected",
+			statusCode: http.StatusOK,
+			body:       `{"reject": true, "reason": "domain not allowed"}`,
+			delay:      0,
+			failOpen:   true,
+			expected: hooks.BeforeSignUpResponse{
+				Reject:  true,
+				Reason:  "domain not allowed",
+				Options: nil,
+			},
+			expectedErr: hooks.ErrRejected,
+		},
+		{
+			name:        "hook fails, fail closed",
+			statusCode:  http.StatusInternalServerError,
+			body:        `oops`,
+			delay:       0,
+			failOpen:    false,
+			expected:    hooks.BeforeSignUpResponse{}, //nolint:exhaustruct
+			expectedErr: hooks.ErrHookFailed,
+		},
+		{
+			name:        "hook fails, fail open",
+			statusCode:  http.StatusInternalServerError,
+			body:        `oops`,
+			delay:       0,
+			failOpen:    true,
+			expected:    hooks.BeforeSignUpResponse{}, //nolint:exhaustruct
+			expectedErr: nil,
+		},
+		{
+			name:        "hook times out",
+			statusCode:  http.StatusOK,
+			body:        `{}`,
+			delay:       200 * time.Millisecond,
+			failOpen:    false,
+			expected:    hooks.BeforeSignUpResponse{}, //nolint:exhaustruct
+			expectedErr: hooks.ErrHookFailed,
+		},
+		{
+			name:        "invalid response",
+			statusCode:  http.StatusOK,
+			body:        `not json`,
+			delay:       0,
+			failOpen:    false,
+			expected:    hooks.BeforeSignUpResponse{}, //nolint:exhaustruct
+			expectedErr: hooks.ErrHookFailed,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := stub(t, tc.statusCode, tc.body, tc.delay)
+			client := hooks.NewClient(server.URL, "secret", 100*time.Millisecond, tc.failOpen)
+
+			got, err := client.BeforeSignUp(
+				t.Context(),
+				hooks.BeforeSignUpRequest{
+					Email:   "jane@acme.com",
+					Options: api.SignUpOptions{}, //nolint:exhaustruct
+				},
+				slog.Default(),
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBeforeToken(t *testing.T) {
+	t.Parallel()
+
+	var received hooks.BeforeTokenRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(hooks.HeaderHook) != hooks.HookBeforeToken {
+			t.Errorf("unexpected hook header: %s", r.Header.Get(hooks.HeaderHook))
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("error decoding request: %v", err)
+		}
+
+		_, _ = w.Write([]byte(`{"claims": {"org-id": "acme"}, "removeClaims": ["plan"]}`))
+	}))
+	defer server.Close()
+
+	client := hooks.NewClient(server.URL, "secret", time.Second, false)
+
+	req := hooks.BeforeTokenRequest{
+		UserID:       "585e21fc-3664-4d03-8539-69945342a4f4",
+		IsAnonymous:  false,
+		DefaultRole:  "user",
+		AllowedRoles: []string{"user"},
+		Claims:       map[string]any{"x-hasura-plan": "free"},
+	}
+
+	got, err := client.BeforeToken(t.Context(), req, slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(req, received); diff != "" {
+		t.Errorf("unexpected request (-want +got):\n%s", diff)
+	}
+
+	expected := hooks.BeforeTokenResponse{
+		Reject:       false,
+		Reason:       "",
+		Claims:       map[string]any{"org-id": "acme"},
+		RemoveClaims: []string{"plan"},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,149 @@
+// Package metrics exposes Prometheus metrics about the service. Metrics are
+// always collected and only served if the metrics server is enabled.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace = "hasura_auth"
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+var (
+	registry = newRegistry()           //nolint:gochecknoglobals
+	factory  = promauto.With(registry) //nolint:gochecknoglobals
+
+	requestsTotal = factory.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of requests per operation and status code",
+		},
+		[]string{"operation", "status"},
+	)
+	requestDuration = factory.NewHistogramVec( //nolint:gochecknoglobals
+		prometheus.HistogramOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the requests per operation",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"operation"},
+	)
+	signInsTotal = factory.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "sign_ins_total",
+			Help:      "Number of successful sign-ins per method and provider",
+		},
+		[]string{"method", "provider"},
+	)
+	signUpsTotal = factory.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "sign_ups_total",
+			Help:      "Number of users signed up per operation",
+		},
+		[]string{"operation"},
+	)
+	errorsTotal = factory.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "errors_total",
+			Help:      "Number of error responses per error code",
+		},
+		[]string{"error"},
+	)
+	rateLimitRejectionsTotal = factory.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "rate_limit_rejections_total",
+			Help:      "Number of requests rejected per rate limit rule",
+		},
+		[]string{"limiter"},
+	)
+	emailsSentTotal = factory.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "emails_sent_total",
+			Help:      "Number of emails sent per template and result",
+		},
+		[]string{"template", "result"},
+	)
+	smsSentTotal = factory.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "sms_sent_total",
+			Help:      "Number of SMS sent per result",
+		},
+		[]string{"result"},
+	)
+	customClaimsDuration = factory.NewHistogramVec( //nolint:gochecknoglobals
+		prometheus.HistogramOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "custom_claims_duration_seconds",
+			Help:      "Latency of getting the custom claims of a user per result",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"result"},
+	)
+)
+
+func newRegistry() *prometheus.Registry {
+	r := prometheus.NewRegistry()
+	r.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}), //nolint:exhaustruct
+	)
+	return r
+}
+
+func result(err error) string {
+	if err != nil {
+		return resultFailure
+	}
+	return resultSuccess
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{}) //nolint:exhaustruct
+}
+
+// Register adds extra collectors, i.e. the database pool stats.
+func Register(c prometheus.Collector) error {
+	return registry.Register(c) //nolint:wrapcheck
+}
+
+func SignIn(method, provider string) {
+	signInsTotal.WithLabelValues(method, provider).Inc()
+}
+
+// SignUp counts a new user, the operation is taken from the request context.
+func SignUp(ctx context.Context) {
+	signUpsTotal.WithLabelValues(OperationFromContext(ctx)).Inc()
+}
+
+func Error(code string) {
+	errorsTotal.WithLabelValues(code).Inc()
+}
+
+func RateLimitRejected(limiter string) {
+	rateLimitRejectionsTotal.WithLabelValues(limiter).Inc()
+}
+
+// ObserveCustomClaims records how long it took to get the custom claims.
+func ObserveCustomClaims(start time.Time, err error) {
+	customClaimsDuration.WithLabelValues(result(err)).Observe(time.Since(start).Seconds())
+}
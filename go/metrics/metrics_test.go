@@ -0,0 +1,142 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/outbox"
+)
+
+var errSend = errors.New("send failed")
+
+func scrape(t *testing.T) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	b, err := io.ReadAll(w.Body)
+	if err != nil {
+		t.Fatalf("error reading metrics: %v", err)
+	}
+
+	return string(b)
+}
+
+func assertContains(t *testing.T, body string, expected ...string) {
+	t.Helper()
+
+	for _, e := range expected {
+		if !strings.Contains(body, e) {
+			t.Errorf("expected metrics to contain %q", e)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	doc := &openapi3.T{ //nolint:exhaustruct
+		Paths: openapi3.NewPaths(
+			openapi3.WithPath("/test/{id}", &openapi3.PathItem{ //nolint:exhaustruct
+				Get: &openapi3.Operation{OperationID: "testGetItem"}, //nolint:exhaustruct
+			}),
+		),
+	}
+
+	router := gin.New()
+	router.Use(metrics.Middleware(doc, "/v1"))
+	router.GET("/v1/test/:id", func(ctx *gin.Context) {
+		metrics.SignUp(ctx)
+		ctx.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/v1/test/a", "/v1/test/b", "/v1/missing"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assertContains(
+		t,
+		scrape(t),
+		`hasura_auth_requests_total{operation="testGetItem",status="200"} 2`,
+		`hasura_auth_requests_total{operation="unknown",status="404"} 1`,
+		`hasura_auth_request_duration_seconds_count{operation="testGetItem"} 2`,
+		`hasura_auth_sign_ups_total{operation="testGetItem"} 2`,
+	)
+}
+
+type emailer struct {
+	err error
+}
+
+func (e emailer) SendEmail(
+	context.Context, string, string, notifications.TemplateName, notifications.TemplateData,
+) error {
+	return e.err
+}
+
+type smsProvider struct {
+	err error
+}
+
+func (s smsProvider) SendSMS(string, string) error {
+	return s.err
+}
+
+func TestNotifications(t *testing.T) {
+	t.Parallel()
+
+	data := notifications.TemplateData{} //nolint:exhaustruct
+
+	_ = metrics.NewEmailer(emailer{err: nil}).
+		SendEmail(context.Background(), "jane@acme.com", "en", "test-template", data)
+	if err := metrics.NewEmailer(emailer{err: errSend}).
+		SendEmail(context.Background(), "jane@acme.com", "en", "test-template", data); !errors.Is(
+		err, errSend,
+	) {
+		t.Errorf("expected error %v, got %v", errSend, err)
+	}
+
+	_ = metrics.NewSMSProvider(smsProvider{err: errSend}).SendSMS("+15550100", "code")
+
+	assertContains(
+		t,
+		scrape(t),
+		`hasura_auth_emails_sent_total{result="success",template="test-template"} 1`,
+		`hasura_auth_emails_sent_total{result="failure",template="test-template"} 1`,
+		`hasura_auth_sms_sent_total{result="failure"} 1`,
+	)
+}
+
+type outboxDepth []outbox.Depth
+
+func (d outboxDepth) Depth() []outbox.Depth {
+	return d
+}
+
+func TestOutboxCollector(t *testing.T) {
+	t.Parallel()
+
+	if err := metrics.Register(metrics.NewOutboxCollector(outboxDepth{
+		{Kind: outbox.KindEmail, Status: outbox.StatusPending, Count: 3},
+		{Kind: outbox.KindSMS, Status: outbox.StatusDead, Count: 1},
+	})); err != nil {
+		t.Fatalf("error registering collector: %v", err)
+	}
+
+	assertContains(
+		t,
+		scrape(t),
+		`hasura_auth_notification_outbox_depth{kind="email",status="pending"} 3`,
+		`hasura_auth_notification_outbox_depth{kind="sms",status="dead"} 1`,
+	)
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	operationContextKey = "metrics-operation"
+	operationUnknown    = "unknown"
+)
+
+func routeKey(method, path string) string {
+	return method + " " + path
+}
+
+// operations maps the gin routes, e.g. `GET /v1/user/:id`, to the operationId
+// of the OpenAPI document.
+func operations(doc *openapi3.T, prefix string) map[string]string {
+	ops := make(map[string]string)
+	for path, item := range doc.Paths.Map() {
+		segments := strings.Split(path, "/")
+		for i, s := range segments {
+			if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+				segments[i] = ":" + s[1:len(s)-1]
+			}
+		}
+		route := prefix + strings.Join(segments, "/")
+
+		for method, op := range item.Operations() {
+			ops[routeKey(method, route)] = op.OperationID
+		}
+	}
+	return ops
+}
+
+// OperationFromContext returns the operationId of the ongoing request.
+func OperationFromContext(ctx context.Context) string {
+	if op, ok := ctx.Value(operationContextKey).(string); ok {
+		return op
+	}
+	return operationUnknown
+}
+
+// Middleware counts the requests and measures their latency per operationId.
+// Requests that don't match any operation are reported as "unknown".
+func Middleware(doc *openapi3.T, prefix string) gin.HandlerFunc {
+	ops := operations(doc, prefix)
+
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		op, ok := ops[routeKey(ctx.Request.Method, ctx.FullPath())]
+		if !ok {
+			op = operationUnknown
+		}
+		ctx.Set(operationContextKey, op)
+
+		ctx.Next()
+
+		requestsTotal.WithLabelValues(op, strconv.Itoa(ctx.Writer.Status())).Inc()
+		requestDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+type Emailer interface {
+	SendEmail(
+		ctx context.Context,
+		to string,
+		locale string,
+		templateName notifications.TemplateName,
+		data notifications.TemplateData,
+	) error
+}
+
+type SMSProvider interface {
+	SendSMS(to string, body string) error
+}
+
+// InstrumentedEmailer counts the emails sent by the wrapped emailer.
+type InstrumentedEmailer struct {
+	emailer Emailer
+}
+
+func NewEmailer(emailer Emailer) *InstrumentedEmailer {
+	return &InstrumentedEmailer{emailer: emailer}
+}
+
+func (e *InstrumentedEmailer) SendEmail(
+	ctx context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	err := e.emailer.SendEmail(ctx, to, locale, templateName, data)
+	emailsSentTotal.WithLabelValues(string(templateName), result(err)).Inc()
+	return err //nolint:wrapcheck
+}
+
+// InstrumentedSMSProvider counts the SMS sent by the wrapped provider.
+type InstrumentedSMSProvider struct {
+	provider SMSProvider
+}
+
+func NewSMSProvider(provider SMSProvider) *InstrumentedSMSProvider {
+	return &InstrumentedSMSProvider{provider: provider}
+}
+
+func (s *InstrumentedSMSProvider) SendSMS(to string, body string) error {
+	err := s.provider.SendSMS(to, body)
+	smsSentTotal.WithLabelValues(result(err)).Inc()
+	return err //nolint:wrapcheck
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/nhost/hasura-auth/go/notifications/outbox"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type OutboxDepther interface {
+	Depth() []outbox.Depth
+}
+
+// OutboxCollector reports the number of notifications waiting in the outbox
+// when scraped. The depth is the one observed the last time the outbox ran.
+type OutboxCollector struct {
+	outbox OutboxDepther
+	depth  *prometheus.Desc
+}
+
+func NewOutboxCollector(outbox OutboxDepther) *OutboxCollector {
+	return &OutboxCollector{
+		outbox: outbox,
+		depth: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "notification_outbox", "depth"),
+			"Notifications pending or dead in the outbox per kind and status",
+			[]string{"kind", "status"},
+			nil,
+		),
+	}
+}
+
+func (c *OutboxCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.depth
+}
+
+func (c *OutboxCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, d := range c.outbox.Depth() {
+		ch <- prometheus.MustNewConstMetric(
+			c.depth, prometheus.GaugeValue, float64(d.Count), d.Kind, d.Status,
+		)
+	}
+}
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func poolDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil,
+	)
+}
+
+// PoolCollector reports the stats of a pgx pool when scraped.
+type PoolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+}
+
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	return &PoolCollector{
+		pool:          pool,
+		acquiredConns: poolDesc("acquired_connections", "Connections currently in use"),
+		idleConns:     poolDesc("idle_connections", "Connections currently idle"),
+		totalConns:    poolDesc("connections", "Connections currently open"),
+		maxConns:      poolDesc("max_connections", "Maximum size of the pool"),
+		acquireCount: poolDesc(
+			"acquires_total", "Number of connections acquired from the pool",
+		),
+		acquireDuration: poolDesc(
+			"acquire_duration_seconds_total", "Time spent acquiring connections",
+		),
+		canceledAcquireCount: poolDesc(
+			"canceled_acquires_total", "Number of acquires canceled by their context",
+		),
+		emptyAcquireCount: poolDesc(
+			"empty_acquires_total", "Number of acquires that had to wait for a connection",
+		),
+	}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.canceledAcquireCount
+	ch <- c.emptyAcquireCount
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(
+		c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds(),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()),
+	)
+}
@@ -53,7 +53,7 @@ func Logger(logger *slog.Logger) gin.HandlerFunc {
 			),
 		)
 		ctx.Request = ctx.Request.WithContext(
-			LoggerToContext(ctx.Request.Context(), logger),
+			TraceToContext(LoggerToContext(ctx.Request.Context(), logger), trace),
 		)
 		ctx.Next()
 
@@ -1,121 +1,221 @@
 package ratelimit
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
 	"net/http"
-	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/metrics"
 )
 
-// endpints that send emails.
-func sendsEmail(path string, verifyEmailEnabled bool) bool {
-	if verifyEmailEnabled {
-		return slices.Contains([]string{
-			"/signin/passwordless/email",
-			"/user/email/change",
-			"/user/email/send-verification-email",
-			"/user/password/reset",
-			"/signup/email-password",
-			"/user/deanonymize",
-		}, path)
+// maxBodySize is the largest JSON body inspected to get the email or phone
+// number of a request, larger bodies are passed through without a key.
+const maxBodySize = 64 << 10
+
+const bodyContextKey = "ratelimit.body"
+
+// UserIDFunc returns the ID of the user making the request, if authenticated.
+type UserIDFunc func(r *http.Request) (string, bool)
+
+// PhoneNumberFunc returns the phone number in E.164 format.
+type PhoneNumberFunc func(phoneNumber string) (string, error)
+
+type Option func(*options)
+
+type options struct {
+	userID      UserIDFunc
+	phoneNumber PhoneNumberFunc
+}
+
+// WithUserIDFunc sets how the user ID is obtained for rules keyed by user ID.
+// Without it, those rules fall back to the client IP.
+func WithUserIDFunc(f UserIDFunc) Option {
+	return func(o *options) {
+		o.userID = f
 	}
-	return slices.Contains([]string{
-		"/signin/passwordless/email",
-		"/user/email/change",
-		"/user/password/reset",
-	}, path)
 }
 
-// endpoints that send SMS.
-func sendsSMS(path string) bool {
-	return slices.Contains([]string{
-		"/signin/passwordless/sms",
-	}, path)
+// WithPhoneNumberFunc sets how phone numbers are normalized so the same number
+// written differently shares its limit. It should match the normalization
+// used by the handlers. Without it, only spaces are removed.
+func WithPhoneNumberFunc(f PhoneNumberFunc) Option {
+	return func(o *options) {
+		o.phoneNumber = f
+	}
+}
+
+type limiter struct {
+	rule   Rule
+	window *SlidingWindow
+}
+
+// readBody parses the JSON body once per request and caches it in the context.
+// At most maxBodySize bytes are read, the body is restored so it can still be
+// read by the handlers.
+func readBody(ctx *gin.Context) map[string]any {
+	if v, ok := ctx.Get(bodyContextKey); ok {
+		body, _ := v.(map[string]any)
+		return body
+	}
+
+	var body map[string]any
+	defer func() { ctx.Set(bodyContextKey, body) }()
+
+	if ctx.Request.Body == nil {
+		return nil
+	}
+
+	b, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxBodySize+1))
+	ctx.Request.Body = readCloser{
+		Reader: io.MultiReader(bytes.NewReader(b), ctx.Request.Body),
+		Closer: ctx.Request.Body,
+	}
+	if err != nil || len(b) > maxBodySize {
+		return nil
+	}
+
+	if err := json.Unmarshal(b, &body); err != nil {
+		return nil
+	}
+
+	return body
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// fromBody returns the string field of the JSON body.
+func fromBody(ctx *gin.Context, field string) string {
+	v, _ := readBody(ctx)[field].(string)
+	return strings.TrimSpace(v)
 }
 
-// endpnits that can be brute forced.
-func bruteForceProtected(path string) bool {
-	return strings.HasPrefix(path, "/signin") ||
-		strings.HasSuffix(path, "/verify") ||
-		strings.HasSuffix(path, "/otp")
+func (o *options) normalizePhoneNumber(phoneNumber string) string {
+	if o.phoneNumber != nil {
+		if normalized, err := o.phoneNumber(phoneNumber); err == nil {
+			return normalized
+		}
+	}
+	return strings.ReplaceAll(phoneNumber, " ", "")
 }
 
-// signups.
-func isSignup(path string) bool {
-	return strings.HasPrefix(path, "/signup")
+func (o *options) key(ctx *gin.Context, source KeySource) string {
+	switch source {
+	case KeySourceIP:
+		return ctx.ClientIP()
+	case KeySourceEmail:
+		return strings.ToLower(fromBody(ctx, "email"))
+	case KeySourcePhoneNumber:
+		phoneNumber := fromBody(ctx, "phoneNumber")
+		if phoneNumber == "" {
+			return ""
+		}
+		return o.normalizePhoneNumber(phoneNumber)
+	case KeySourceUserID:
+		if o.userID != nil {
+			if userID, ok := o.userID(ctx.Request); ok {
+				return userID
+			}
+		}
+		return ctx.ClientIP()
+	case KeySourceGlobal:
+		return "global"
+	}
+	return ""
 }
 
-func RateLimit( //nolint:cyclop,funlen
+// RateLimit limits the requests according to the rules. Every rule matching the
+// request is checked, requests without a key for a rule (i.e. no email in the
+// body) are not limited by it.
+func RateLimit(
 	ignorePrefix string,
-	globalLimit int,
-	globalInterval time.Duration,
-	emailLimit int,
-	emailInterval time.Duration,
-	emailIsGlobal bool,
-	emailVerifyEnabled bool,
-	smsLimit int,
-	smsInterval time.Duration,
-	bruteForceLimit int,
-	bruteForceInterval time.Duration,
-	signupsLimit int,
-	signupsInterval time.Duration,
+	rules []Rule,
 	store Store,
-) gin.HandlerFunc {
-	perUserRL := NewSlidingWindow("user-global", globalLimit, globalInterval, store)
-
-	var globalEmailRL *SlidingWindow
-	var perUserEmailRL *SlidingWindow
-	if emailIsGlobal {
-		globalEmailRL = NewSlidingWindow("global-email", emailLimit, emailInterval, store)
-	} else {
-		perUserEmailRL = NewSlidingWindow("user-email", emailLimit, emailInterval, store)
+	opts ...Option,
+) (gin.HandlerFunc, error) {
+	o := &options{
+		userID:      nil,
+		phoneNumber: nil,
+	}
+	for _, opt := range opts {
+		opt(o)
 	}
 
-	globalSMSRL := NewSlidingWindow("user-sms", smsLimit, smsInterval, store)
-	perUserBruteForceRL := NewSlidingWindow(
-		"user-bruteforce", bruteForceLimit, bruteForceInterval, store,
-	)
-	perUserSignupsRL := NewSlidingWindow("user-bruteforce", signupsLimit, signupsInterval, store)
-
-	return func(ctx *gin.Context) {
-		clientIP := ctx.ClientIP()
-		if !perUserRL.Allow(clientIP) {
-			ctx.AbortWithStatus(http.StatusTooManyRequests)
-			return
+	limiters := make([]limiter, len(rules))
+	for i, rule := range rules {
+		if err := rule.compile(); err != nil {
+			return nil, err
+		}
+		limiters[i] = limiter{
+			rule:   rule,
+			window: NewSlidingWindow(rule.Name+":", rule.Burst, rule.Interval, store),
 		}
+	}
 
+	return func(ctx *gin.Context) {
 		path := strings.TrimPrefix(ctx.Request.URL.Path, ignorePrefix)
 
-		if sendsEmail(path, emailVerifyEnabled) {
-			if globalEmailRL != nil && !globalEmailRL.Allow("global") {
-				ctx.AbortWithStatus(http.StatusTooManyRequests)
+		var tightest *Result
+		for _, l := range limiters {
+			if !l.rule.matches(ctx.Request.Method, path) {
+				continue
 			}
 
-			if perUserEmailRL != nil && !perUserEmailRL.Allow(clientIP) {
-				ctx.AbortWithStatus(http.StatusTooManyRequests)
+			key := o.key(ctx, l.rule.Key)
+			if key == "" {
+				continue
 			}
-		}
 
-		if sendsSMS(path) {
-			if !globalSMSRL.Allow(clientIP) {
-				ctx.AbortWithStatus(http.StatusTooManyRequests)
+			res := l.window.Check(key)
+			if !res.Allowed {
+				metrics.RateLimitRejected(l.rule.Name)
+				tooManyRequests(ctx, res)
+				return
 			}
-		}
 
-		if bruteForceProtected(path) {
-			if !perUserBruteForceRL.Allow(clientIP) {
-				ctx.AbortWithStatus(http.StatusTooManyRequests)
+			if tightest == nil || res.Remaining < tightest.Remaining {
+				tightest = &res
 			}
 		}
 
-		if isSignup(path) {
-			if !perUserSignupsRL.Allow(clientIP) {
-				ctx.AbortWithStatus(http.StatusTooManyRequests)
-			}
+		if tightest != nil {
+			setHeaders(ctx, *tightest)
 		}
 
 		ctx.Next()
-	}
+	}, nil
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
+
+// setHeaders sets the RateLimit headers from
+// draft-ietf-httpapi-ratelimit-headers.
+func setHeaders(ctx *gin.Context, res Result) {
+	ctx.Header("RateLimit-Limit", strconv.Itoa(res.Limit))
+	ctx.Header("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+	ctx.Header("RateLimit-Reset", seconds(res.Reset))
+}
+
+func tooManyRequests(ctx *gin.Context, res Result) {
+	setHeaders(ctx, res)
+	ctx.Header("Retry-After", seconds(max(res.RetryAfter, time.Second)))
+	ctx.AbortWithStatusJSON(
+		http.StatusTooManyRequests,
+		api.ErrorResponse{
+			Error:   api.TooManyRequests,
+			Message: "Too many requests, please try again later",
+			Status:  http.StatusTooManyRequests,
+		},
+	)
 }
@@ -0,0 +1,281 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRule = errors.New("invalid rate limit rule")
+
+// KeySource is what requests are grouped by when counting them.
+type KeySource string
+
+const (
+	// KeySourceIP counts requests per client IP.
+	KeySourceIP KeySource = "ip"
+	// KeySourceEmail counts requests per email address found in the JSON body.
+	KeySourceEmail KeySource = "email"
+	// KeySourcePhoneNumber counts requests per phone number found in the JSON
+	// body.
+	KeySourcePhoneNumber KeySource = "phone-number"
+	// KeySourceUserID counts requests per user ID of the access token.
+	KeySourceUserID KeySource = "user-id"
+	// KeySourceGlobal counts all requests together.
+	KeySourceGlobal KeySource = "global"
+)
+
+// Rule limits the requests to the paths matching any of Paths to Burst every
+// Interval. Rules with the same Name share their counters. In paths `*`
+// matches anything but `/` and `**` matches anything.
+type Rule struct {
+	Name     string        `json:"name"`
+	Paths    []string      `json:"paths"`
+	Method   string        `json:"method,omitempty"`
+	Key      KeySource     `json:"key"`
+	Burst    int           `json:"burst"`
+	Interval time.Duration `json:"interval"`
+
+	patterns []*regexp.Regexp
+}
+
+func (r *Rule) UnmarshalJSON(b []byte) error {
+	type Alias Rule
+	aux := &struct { //nolint:exhaustruct
+		Interval string `json:"interval"`
+		*Alias
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return fmt.Errorf("error unmarshalling rule: %w", err)
+	}
+
+	interval, err := time.ParseDuration(aux.Interval)
+	if err != nil {
+		return fmt.Errorf("%w: %s: error parsing interval: %w", ErrInvalidRule, r.Name, err)
+	}
+	r.Interval = interval
+
+	return nil
+}
+
+func globToRegexp(glob string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			b.WriteString("[^/]*")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(glob[i])))
+		}
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("error compiling path %s: %w", glob, err)
+	}
+	return re, nil
+}
+
+func (r *Rule) compile() error {
+	switch {
+	case r.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidRule)
+	case len(r.Paths) == 0:
+		return fmt.Errorf("%w: %s: paths are required", ErrInvalidRule, r.Name)
+	case r.Burst <= 0:
+		return fmt.Errorf("%w: %s: burst must be positive", ErrInvalidRule, r.Name)
+	case r.Interval <= 0:
+		return fmt.Errorf("%w: %s: interval must be positive", ErrInvalidRule, r.Name)
+	}
+
+	switch r.Key {
+	case KeySourceIP, KeySourceEmail, KeySourcePhoneNumber, KeySourceUserID, KeySourceGlobal:
+	default:
+		return fmt.Errorf("%w: %s: unknown key %q", ErrInvalidRule, r.Name, r.Key)
+	}
+
+	r.patterns = make([]*regexp.Regexp, len(r.Paths))
+	for i, p := range r.Paths {
+		re, err := globToRegexp(p)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrInvalidRule, r.Name, err)
+		}
+		r.patterns[i] = re
+	}
+
+	return nil
+}
+
+func (r *Rule) matches(method, path string) bool {
+	if r.Method != "" && r.Method != "*" && !strings.EqualFold(r.Method, method) {
+		return false
+	}
+
+	for _, re := range r.patterns {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRules parses a JSON array of rules, intervals are durations like "5m".
+func ParseRules(s string) ([]Rule, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var rules []Rule
+	if err := json.Unmarshal([]byte(s), &rules); err != nil {
+		return nil, fmt.Errorf("error parsing rate limit rules: %w", err)
+	}
+
+	for i := range rules {
+		if err := rules[i].compile(); err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
+
+type DefaultRulesConfig struct {
+	GlobalBurst        int
+	GlobalInterval     time.Duration
+	EmailBurst         int
+	EmailInterval      time.Duration
+	EmailIsGlobal      bool
+	EmailVerifyEnabled bool
+	SMSBurst           int
+	SMSInterval        time.Duration
+	BruteForceBurst    int
+	BruteForceInterval time.Duration
+	SignupsBurst       int
+	SignupsInterval    time.Duration
+}
+
+// DefaultRules returns the rules applied unless they are overridden.
+func DefaultRules(cfg DefaultRulesConfig) []Rule {
+	// endpoints that send emails
+	emailPaths := []string{
+		"/signin/passwordless/email",
+		"/user/email/change",
+		"/user/password/reset",
+	}
+	if cfg.EmailVerifyEnabled {
+		emailPaths = append(emailPaths,
+			"/user/email/send-verification-email",
+			"/signup/email-password",
+			"/user/deanonymize",
+		)
+	}
+
+	emailKey := KeySourceIP
+	if cfg.EmailIsGlobal {
+		emailKey = KeySourceGlobal
+	}
+
+	return []Rule{
+		{
+			Name:     "global",
+			Paths:    []string{"**"},
+			Method:   "",
+			Key:      KeySourceIP,
+			Burst:    cfg.GlobalBurst,
+			Interval: cfg.GlobalInterval,
+			patterns: nil,
+		},
+		{
+			Name:     "email",
+			Paths:    emailPaths,
+			Method:   "",
+			Key:      emailKey,
+			Burst:    cfg.EmailBurst,
+			Interval: cfg.EmailInterval,
+			patterns: nil,
+		},
+		{
+			Name: "sms",
+			Paths: []string{
+				"/signin/passwordless/sms",
+				"/signup/phone-password",
+				"/user/password/reset/sms",
+			},
+			Method:   "",
+			Key:      KeySourceIP,
+			Burst:    cfg.SMSBurst,
+			Interval: cfg.SMSInterval,
+			patterns: nil,
+		},
+		{
+			// limits the emails sent to the same address regardless of the IP
+			Name: "email-target",
+			Paths: []string{
+				"/signin/passwordless/email",
+				"/signin/otp/email",
+				"/user/password/reset",
+			},
+			Method:   "",
+			Key:      KeySourceEmail,
+			Burst:    cfg.EmailBurst,
+			Interval: cfg.EmailInterval,
+			patterns: nil,
+		},
+		{
+			// limits the SMS sent to the same phone number regardless of the IP
+			Name: "sms-target",
+			Paths: []string{
+				"/signin/passwordless/sms",
+				"/user/password/reset/sms",
+			},
+			Method:   "",
+			Key:      KeySourcePhoneNumber,
+			Burst:    cfg.SMSBurst,
+			Interval: cfg.SMSInterval,
+			patterns: nil,
+		},
+		{
+			// endpoints that can be brute forced
+			Name:     "brute-force",
+			Paths:    []string{"/signin**", "**/verify", "**/otp"},
+			Method:   "",
+			Key:      KeySourceIP,
+			Burst:    cfg.BruteForceBurst,
+			Interval: cfg.BruteForceInterval,
+			patterns: nil,
+		},
+		{
+			Name:     "signups",
+			Paths:    []string{"/signup**"},
+			Method:   "",
+			Key:      KeySourceIP,
+			Burst:    cfg.SignupsBurst,
+			Interval: cfg.SignupsInterval,
+			patterns: nil,
+		},
+	}
+}
+
+// MergeRules adds rules to the defaults. Rules named like a default rule
+// replace it.
+func MergeRules(defaults []Rule, rules []Rule) []Rule {
+	merged := make([]Rule, 0, len(defaults)+len(rules))
+	for _, d := range defaults {
+		if !slices.ContainsFunc(rules, func(r Rule) bool { return r.Name == d.Name }) {
+			merged = append(merged, d)
+		}
+	}
+
+	return append(merged, rules...)
+}
@@ -0,0 +1,463 @@
+package ratelimit_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/middleware/ratelimit"
+)
+
+func TestParseRules(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		rules       string
+		expected    []ratelimit.Rule
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			rules:       "",
+			expected:    nil,
+			expectedErr: nil,
+		},
+		{
+			name: "valid",
+			rules: `[{"name": "pat", "paths": ["/signin/pat"], "method": "POST",
+				"key": "email", "burst": 5, "interval": "1m"}]`,
+			expected: []ratelimit.Rule{
+				{
+					Name:     "pat",
+					Paths:    []string{"/signin/pat"},
+					Method:   "POST",
+					Key:      ratelimit.KeySourceEmail,
+					Burst:    5,
+					Interval: time.Minute,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "unknown key",
+			rules:       `[{"name": "a", "paths": ["/a"], "key": "cookie", "burst": 5, "interval": "1m"}]`,
+			expected:    nil,
+			expectedErr: ratelimit.ErrInvalidRule,
+		},
+		{
+			name:        "missing paths",
+			rules:       `[{"name": "a", "key": "ip", "burst": 5, "interval": "1m"}]`,
+			expected:    nil,
+			expectedErr: ratelimit.ErrInvalidRule,
+		},
+		{
+			name:        "invalid interval",
+			rules:       `[{"name": "a", "paths": ["/a"], "key": "ip", "burst": 5, "interval": "1"}]`,
+			expected:    nil,
+			expectedErr: ratelimit.ErrInvalidRule,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ratelimit.ParseRules(tc.rules)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if diff := cmp.Diff(
+				tc.expected, got, cmpopts.IgnoreUnexported(ratelimit.Rule{}),
+			); diff != "" {
+				t.Errorf("unexpected rules (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeRules(t *testing.T) {
+	t.Parallel()
+
+	defaults := ratelimit.DefaultRules(ratelimit.DefaultRulesConfig{
+		GlobalBurst:        100,
+		GlobalInterval:     time.Minute,
+		EmailBurst:         10,
+		EmailInterval:      time.Hour,
+		EmailIsGlobal:      false,
+		EmailVerifyEnabled: false,
+		SMSBurst:           10,
+		SMSInterval:        time.Hour,
+		BruteForceBurst:    10,
+		BruteForceInterval: 5 * time.Minute,
+		SignupsBurst:       10,
+		SignupsInterval:    5 * time.Minute,
+	})
+
+	rules := ratelimit.MergeRules(defaults, []ratelimit.Rule{
+		{Name: "email", Paths: []string{"/user/email/change"}, Key: ratelimit.KeySourceUserID}, //nolint:exhaustruct,lll
+		{Name: "custom", Paths: []string{"/token"}, Key: ratelimit.KeySourceIP},                //nolint:exhaustruct,lll
+	})
+
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.Name
+	}
+
+	expected := []string{
+		"global", "sms", "email-target", "sms-target", "brute-force", "signups", "email", "custom",
+	}
+	if diff := cmp.Diff(expected, names); diff != "" {
+		t.Errorf("unexpected rules (-want +got):\n%s", diff)
+	}
+}
+
+func TestRateLimit(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		rule     ratelimit.Rule
+		requests []*http.Request
+		expected []int
+	}{
+		{
+			name: "glob",
+			rule: ratelimit.Rule{ //nolint:exhaustruct
+				Name:     "glob",
+				Paths:    []string{"/user/*/verify"},
+				Key:      ratelimit.KeySourceIP,
+				Burst:    1,
+				Interval: time.Hour,
+			},
+			requests: []*http.Request{
+				httptest.NewRequest(http.MethodPost, "/v1/user/mfa/verify", nil),
+				httptest.NewRequest(http.MethodPost, "/v1/user/email/verify", nil),
+				httptest.NewRequest(http.MethodPost, "/v1/user/a/b/verify", nil),
+			},
+			expected: []int{http.StatusOK, http.StatusTooManyRequests, http.StatusOK},
+		},
+		{
+			name: "method",
+			rule: ratelimit.Rule{ //nolint:exhaustruct
+				Name:     "method",
+				Paths:    []string{"/token"},
+				Method:   http.MethodPost,
+				Key:      ratelimit.KeySourceGlobal,
+				Burst:    1,
+				Interval: time.Hour,
+			},
+			requests: []*http.Request{
+				httptest.NewRequest(http.MethodPost, "/v1/token", nil),
+				httptest.NewRequest(http.MethodGet, "/v1/token", nil),
+				httptest.NewRequest(http.MethodPost, "/v1/token", nil),
+			},
+			expected: []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name: "email",
+			rule: ratelimit.Rule{ //nolint:exhaustruct
+				Name:     "email",
+				Paths:    []string{"/signin/passwordless/email"},
+				Key:      ratelimit.KeySourceEmail,
+				Burst:    1,
+				Interval: time.Hour,
+			},
+			requests: []*http.Request{
+				httptest.NewRequest(
+					http.MethodPost, "/v1/signin/passwordless/email",
+					strings.NewReader(`{"email": "jane@acme.com"}`),
+				),
+				httptest.NewRequest(
+					http.MethodPost, "/v1/signin/passwordless/email",
+					strings.NewReader(`{"email": "John@acme.com"}`),
+				),
+				httptest.NewRequest(
+					http.MethodPost, "/v1/signin/passwordless/email",
+					strings.NewReader(`{"email": "JANE@acme.com"}`),
+				),
+				httptest.NewRequest(
+					http.MethodPost, "/v1/signin/passwordless/email",
+					strings.NewReader(`{}`),
+				),
+			},
+			expected: []int{
+				http.StatusOK, http.StatusOK, http.StatusTooManyRequests, http.StatusOK,
+			},
+		},
+		{
+			name: "phone number",
+			rule: ratelimit.Rule{ //nolint:exhaustruct
+				Name:     "sms-target",
+				Paths:    []string{"/signin/passwordless/sms"},
+				Key:      ratelimit.KeySourcePhoneNumber,
+				Burst:    1,
+				Interval: time.Hour,
+			},
+			requests: []*http.Request{
+				httptest.NewRequest(
+					http.MethodPost, "/v1/signin/passwordless/sms",
+					strings.NewReader(`{"phoneNumber": "+1 (415) 555-2671"}`),
+				),
+				httptest.NewRequest(
+					http.MethodPost, "/v1/signin/passwordless/sms",
+					strings.NewReader(`{"phoneNumber": "+14155552671"}`),
+				),
+				httptest.NewRequest(
+					http.MethodPost, "/v1/signin/passwordless/sms",
+					strings.NewReader(`{"phoneNumber": "+14155552672"}`),
+				),
+				httptest.NewRequest(
+					http.MethodPost, "/v1/signin/passwordless/sms",
+					strings.NewReader(`{"phoneNumber": "+1 415 555 2672"}`),
+				),
+			},
+			expected: []int{
+				http.StatusOK, http.StatusTooManyRequests, http.StatusOK, http.StatusTooManyRequests,
+			},
+		},
+		{
+			name: "user id",
+			rule: ratelimit.Rule{ //nolint:exhaustruct
+				Name:     "user-id",
+				Paths:    []string{"/user/**"},
+				Key:      ratelimit.KeySourceUserID,
+				Burst:    1,
+				Interval: time.Hour,
+			},
+			requests: func() []*http.Request {
+				a := httptest.NewRequest(http.MethodPost, "/v1/user/mfa", nil)
+				a.Header.Set("X-User", "a")
+				b := httptest.NewRequest(http.MethodPost, "/v1/user/mfa", nil)
+				b.Header.Set("X-User", "b")
+				c := httptest.NewRequest(http.MethodPost, "/v1/user/email/change", nil)
+				c.Header.Set("X-User", "a")
+				return []*http.Request{a, b, c}
+			}(),
+			expected: []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rl, err := ratelimit.RateLimit(
+				"/v1",
+				[]ratelimit.Rule{tc.rule},
+				ratelimit.NewInMemoryStore(),
+				ratelimit.WithUserIDFunc(func(r *http.Request) (string, bool) {
+					userID := r.Header.Get("X-User")
+					return userID, userID != ""
+				}),
+				ratelimit.WithPhoneNumberFunc(controller.NormalizePhoneNumber(nil, nil)),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for i, req := range tc.requests {
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = req
+
+				rl(ctx)
+
+				if ctx.Writer.Status() != tc.expected[i] {
+					t.Errorf("request %d: expected %d, got %d", i, tc.expected[i], ctx.Writer.Status())
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitResponse(t *testing.T) {
+	t.Parallel()
+
+	rl, err := ratelimit.RateLimit(
+		"/v1",
+		[]ratelimit.Rule{
+			{ //nolint:exhaustruct
+				Name:     "global",
+				Paths:    []string{"**"},
+				Key:      ratelimit.KeySourceIP,
+				Burst:    10,
+				Interval: time.Hour,
+			},
+			{ //nolint:exhaustruct
+				Name:     "token",
+				Paths:    []string{"/token"},
+				Key:      ratelimit.KeySourceIP,
+				Burst:    1,
+				Interval: time.Hour,
+			},
+		},
+		ratelimit.NewInMemoryStore(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	serve := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/v1/token", nil)
+		rl(ctx)
+		ctx.Writer.WriteHeaderNow()
+		return w
+	}
+
+	w := serve()
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, w.Code)
+	}
+	// the headers describe the most restrictive rule
+	if diff := cmp.Diff(
+		[]string{"1", "0", ""},
+		[]string{
+			w.Header().Get("RateLimit-Limit"),
+			w.Header().Get("RateLimit-Remaining"),
+			w.Header().Get("Retry-After"),
+		},
+	); diff != "" {
+		t.Errorf("unexpected headers (-want +got):\n%s", diff)
+	}
+
+	w = serve()
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected %d, got %d", http.StatusTooManyRequests, w.Code)
+	}
+	if w.Header().Get("Retry-After") == "" || w.Header().Get("RateLimit-Reset") == "" {
+		t.Errorf("expected Retry-After and RateLimit-Reset headers, got %v", w.Header())
+	}
+
+	var body api.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(
+		api.ErrorResponse{
+			Error:   api.TooManyRequests,
+			Message: "Too many requests, please try again later",
+			Status:  http.StatusTooManyRequests,
+		},
+		body,
+	); diff != "" {
+		t.Errorf("unexpected body (-want +got):\n%s", diff)
+	}
+}
+
+func TestRateLimitTarget(t *testing.T) {
+	t.Parallel()
+
+	rules := ratelimit.DefaultRules(ratelimit.DefaultRulesConfig{
+		GlobalBurst:        100,
+		GlobalInterval:     time.Minute,
+		EmailBurst:         2,
+		EmailInterval:      time.Hour,
+		EmailIsGlobal:      false,
+		EmailVerifyEnabled: false,
+		SMSBurst:           2,
+		SMSInterval:        time.Hour,
+		BruteForceBurst:    100,
+		BruteForceInterval: time.Minute,
+		SignupsBurst:       100,
+		SignupsInterval:    time.Minute,
+	})
+
+	rl, err := ratelimit.RateLimit("/v1", rules, ratelimit.NewInMemoryStore())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the same email is targeted from different IPs
+	expected := []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}
+	for i, ip := range []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"} {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(
+			http.MethodPost, "/v1/signin/otp/email",
+			strings.NewReader(`{"email": "jane@acme.com"}`),
+		)
+		ctx.Request.RemoteAddr = ip + ":1234"
+
+		rl(ctx)
+
+		if ctx.Writer.Status() != expected[i] {
+			t.Errorf("request %d: expected %d, got %d", i, expected[i], ctx.Writer.Status())
+		}
+	}
+}
+
+func TestRateLimitBody(t *testing.T) {
+	t.Parallel()
+
+	rl, err := ratelimit.RateLimit(
+		"/v1",
+		[]ratelimit.Rule{
+			{ //nolint:exhaustruct
+				Name:     "email",
+				Paths:    []string{"/signin/passwordless/email"},
+				Key:      ratelimit.KeySourceEmail,
+				Burst:    1,
+				Interval: time.Hour,
+			},
+			{ //nolint:exhaustruct
+				Name:     "email-global",
+				Paths:    []string{"/signin/passwordless/email"},
+				Key:      ratelimit.KeySourceEmail,
+				Burst:    10,
+				Interval: time.Hour,
+			},
+		},
+		ratelimit.NewInMemoryStore(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	large := `{"email": "jane@acme.com", "padding": "` + strings.Repeat("a", 128<<10) + `"}`
+
+	cases := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{name: "small body", body: `{"email": "jane@acme.com"}`, expectedStatus: http.StatusOK},
+		{name: "large body is not inspected", body: large, expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(
+			http.MethodPost, "/v1/signin/passwordless/email", strings.NewReader(tc.body),
+		)
+
+		rl(ctx)
+
+		if ctx.Writer.Status() != tc.expectedStatus {
+			t.Errorf("%s: expected %d, got %d", tc.name, tc.expectedStatus, ctx.Writer.Status())
+		}
+
+		b, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			t.Fatalf("%s: error reading body: %v", tc.name, err)
+		}
+
+		if string(b) != tc.body {
+			t.Errorf("%s: body was not restored for the handlers", tc.name)
+		}
+	}
+}
@@ -42,23 +42,62 @@ func (r *SlidingWindow) getRate(key string) float64 {
 	return float64(count) / float64(r.window.Milliseconds())
 }
 
-func (r *SlidingWindow) Allow(key string) bool {
+// Result describes the state of a key after a request was checked.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is the time left until the current window ends.
+	Reset time.Duration
+	// RetryAfter is how long to wait before a denied request could be allowed.
+	RetryAfter time.Duration
+}
+
+func (r *SlidingWindow) Check(key string) Result {
 	now := time.Now()
 	windowKey := r.windowKey(now, key)
 	remainingTime := float64(r.window.Milliseconds() - now.UnixMilli()%r.window.Milliseconds())
+	reset := time.Duration(remainingTime) * time.Millisecond
+
+	denied := Result{
+		Allowed:    false,
+		Limit:      r.limit,
+		Remaining:  0,
+		Reset:      reset,
+		RetryAfter: reset,
+	}
 
 	count := r.store.Get(windowKey)
 	if count >= r.limit {
-		return false
+		return denied
 	}
 
 	prevWindowKey := r.windowKey(now.Add(-r.window), key)
 	prevRate := r.getRate(prevWindowKey)
 
-	estimatedRate := int(math.Floor(prevRate*remainingTime)) + count
-	if estimatedRate >= r.limit {
-		return false
+	prevEstimate := int(math.Floor(prevRate * remainingTime))
+	if prevEstimate+count >= r.limit {
+		// the estimate drops below the limit once enough of the previous
+		// window slides out
+		wait := remainingTime - float64(r.limit-count)/prevRate
+		denied.RetryAfter = max(time.Duration(math.Ceil(wait))*time.Millisecond, 0)
+		return denied
 	}
 
-	return r.store.Increment(windowKey, r.window*2) <= r.limit //nolint:mnd
+	count = r.store.Increment(windowKey, r.window*2) //nolint:mnd
+	if count > r.limit {
+		return denied
+	}
+
+	return Result{
+		Allowed:    true,
+		Limit:      r.limit,
+		Remaining:  max(r.limit-prevEstimate-count, 0),
+		Reset:      reset,
+		RetryAfter: 0,
+	}
+}
+
+func (r *SlidingWindow) Allow(key string) bool {
+	return r.Check(key).Allowed
 }
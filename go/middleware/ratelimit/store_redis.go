@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisTimeout = time.Second
+
+// incrementScript increments the key and sets its expiration when it is
+// created so both happen atomically. It only touches the key it is given so it
+// can run against a Redis cluster.
+var incrementScript = redis.NewScript(`local v = redis.call("INCR", KEYS[1])
+if v == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return v
+`) //nolint:gochecknoglobals
+
+// RedisStore keeps the rate limit counters in Redis. The client can be a
+// regular client or a cluster client.
+type RedisStore struct {
+	client redis.UniversalClient
+	prefix string
+	logger *slog.Logger
+}
+
+func NewRedisStore(
+	client redis.UniversalClient,
+	prefix string,
+	logger *slog.Logger,
+) *RedisStore {
+	return &RedisStore{
+		client: client,
+		prefix: prefix,
+		logger: logger,
+	}
+}
+
+func (r *RedisStore) key(key string) string {
+	return r.prefix + key
+}
+
+func (r *RedisStore) Get(key string) int {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	v, err := r.client.Get(ctx, r.key(key)).Int()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return 0
+	case err != nil:
+		r.logger.Error("error getting key", slog.String("error", err.Error()))
+		return 0
+	}
+
+	return v
+}
+
+func (r *RedisStore) Increment(key string, expire time.Duration) int {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	v, err := incrementScript.Run(
+		ctx, r.client, []string{r.key(key)}, expire.Milliseconds(),
+	).Int()
+	if err != nil {
+		r.logger.Error("error incrementing key", slog.String("error", err.Error()))
+		return 0
+	}
+
+	return v
+}
@@ -0,0 +1,78 @@
+package ratelimit_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/nhost/hasura-auth/go/middleware/ratelimit"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisStore(t *testing.T) {
+	t.Parallel()
+
+	srv := miniredis.RunT(t)
+	cl := redis.NewClient(&redis.Options{Addr: srv.Addr()}) //nolint:exhaustruct
+	t.Cleanup(func() { _ = cl.Close() })
+
+	store := ratelimit.NewRedisStore(cl, "test:", slog.Default())
+
+	if e := store.Get("key"); e != 0 {
+		t.Errorf("Expected 0, got %d", e)
+	}
+
+	if e := store.Increment("key", time.Second); e != 1 {
+		t.Errorf("Expected 1, got %d", e)
+	}
+
+	if e := store.Get("key"); e != 1 {
+		t.Errorf("Expected 1, got %d", e)
+	}
+
+	if e := store.Increment("key", time.Second); e != 2 {
+		t.Errorf("Expected 2, got %d", e)
+	}
+
+	if e := store.Get("key"); e != 2 {
+		t.Errorf("Expected 2, got %d", e)
+	}
+
+	if !srv.Exists("test:key") {
+		t.Errorf("Expected key to be prefixed")
+	}
+
+	// the expiration is only set when the key is created
+	if ttl := srv.TTL("test:key"); ttl != time.Second {
+		t.Errorf("Expected ttl of 1s, got %s", ttl)
+	}
+
+	srv.FastForward(time.Second)
+	if e := store.Get("key"); e != 0 {
+		t.Errorf("Expected 0, got %d", e)
+	}
+
+	if e := store.Increment("key", time.Second); e != 1 {
+		t.Errorf("Expected 1, got %d", e)
+	}
+}
+
+func TestRedisStoreUnavailable(t *testing.T) {
+	t.Parallel()
+
+	srv := miniredis.RunT(t)
+	cl := redis.NewClient(&redis.Options{Addr: srv.Addr()}) //nolint:exhaustruct
+	t.Cleanup(func() { _ = cl.Close() })
+	srv.Close()
+
+	store := ratelimit.NewRedisStore(cl, "test:", slog.Default())
+
+	if e := store.Increment("key", time.Second); e != 0 {
+		t.Errorf("Expected 0, got %d", e)
+	}
+
+	if e := store.Get("key"); e != 0 {
+		t.Errorf("Expected 0, got %d", e)
+	}
+}
@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
@@ -12,6 +14,8 @@ const (
 	headerParentSpanID = "X-B3-ParentSpanId"
 )
 
+type traceCtxKey struct{}
+
 type Trace struct {
 	TraceID      string
 	ParentSpanID string
@@ -61,3 +65,22 @@ func TraceToHTTPHeaders(trace Trace, header http.Header) {
 	header.Set(headerParentSpanID, trace.ParentSpanID)
 	header.Set(headerSpanID, trace.SpanID)
 }
+
+// Stores the trace in the context.
+func TraceToContext(ctx context.Context, trace Trace) context.Context {
+	return context.WithValue(ctx, traceCtxKey{}, trace)
+}
+
+// Retrieves the trace from the context. It returns an empty trace if it can't be found.
+func TraceFromContext(ctx context.Context) Trace { //nolint:contextcheck
+	ginCtx, ok := ctx.(*gin.Context)
+	if ok {
+		ctx = ginCtx.Request.Context()
+	}
+
+	trace, ok := ctx.Value(traceCtxKey{}).(Trace)
+	if !ok {
+		return Trace{} //nolint:exhaustruct
+	}
+	return trace
+}
@@ -0,0 +1,11 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS auth.user_impersonations (
+    id uuid DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    impersonator text NOT NULL,
+    reason text NOT NULL
+);
+CREATE INDEX IF NOT EXISTS user_impersonations_user_id_idx ON auth.user_impersonations (user_id);
+COMMENT ON TABLE auth.user_impersonations IS 'Audit log of admin impersonation sessions. Rows are kept after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
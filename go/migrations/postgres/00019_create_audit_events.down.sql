@@ -0,0 +1 @@
+DROP TABLE IF EXISTS auth.audit_events;
@@ -0,0 +1,14 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS auth.audit_events (
+    id uuid DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    event text NOT NULL,
+    ip_address text,
+    user_agent text,
+    trace_id text,
+    metadata jsonb
+);
+CREATE INDEX IF NOT EXISTS audit_events_user_id_created_at_idx ON auth.audit_events (user_id, created_at DESC);
+COMMENT ON TABLE auth.audit_events IS 'Security events of users such as sign-ins, password changes or MFA changes. Rows are kept after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
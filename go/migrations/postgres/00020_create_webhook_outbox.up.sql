@@ -0,0 +1,16 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS auth.webhook_outbox (
+    id uuid DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    event text NOT NULL,
+    url text NOT NULL,
+    payload jsonb NOT NULL,
+    status text DEFAULT 'pending' NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+    delivered_at timestamp with time zone,
+    last_error text
+);
+CREATE INDEX IF NOT EXISTS webhook_outbox_status_next_attempt_at_idx ON auth.webhook_outbox (status, next_attempt_at);
+COMMENT ON TABLE auth.webhook_outbox IS 'Outbound webhook deliveries pending or already sent to subscribers. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
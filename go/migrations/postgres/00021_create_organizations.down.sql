@@ -0,0 +1,6 @@
+BEGIN;
+ALTER TABLE auth.refresh_tokens DROP COLUMN IF EXISTS organization_id;
+DROP TABLE IF EXISTS auth.organization_invitations;
+DROP TABLE IF EXISTS auth.organization_members;
+DROP TABLE IF EXISTS auth.organizations;
+COMMIT;
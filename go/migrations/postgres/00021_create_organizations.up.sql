@@ -0,0 +1,36 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS auth.organizations (
+    id uuid DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    name text NOT NULL,
+    slug text NOT NULL UNIQUE,
+    metadata jsonb
+);
+COMMENT ON TABLE auth.organizations IS 'Organizations users can be members of. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+CREATE TABLE IF NOT EXISTS auth.organization_members (
+    id uuid DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    organization_id uuid NOT NULL REFERENCES auth.organizations (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    roles text[] DEFAULT '{}' NOT NULL,
+    UNIQUE (organization_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS organization_members_user_id_idx ON auth.organization_members (user_id);
+COMMENT ON TABLE auth.organization_members IS 'Membership of users in organizations and their roles within them. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+CREATE TABLE IF NOT EXISTS auth.organization_invitations (
+    id uuid DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    organization_id uuid NOT NULL REFERENCES auth.organizations (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    email text NOT NULL,
+    roles text[] DEFAULT '{}' NOT NULL,
+    invited_by uuid REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    expires_at timestamp with time zone NOT NULL,
+    accepted_at timestamp with time zone
+);
+CREATE INDEX IF NOT EXISTS organization_invitations_email_idx ON auth.organization_invitations (email);
+COMMENT ON TABLE auth.organization_invitations IS 'Pending and accepted invitations to join organizations. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+ALTER TABLE auth.refresh_tokens ADD COLUMN IF NOT EXISTS organization_id uuid REFERENCES auth.organizations (id) ON UPDATE CASCADE ON DELETE SET NULL;
+COMMIT;
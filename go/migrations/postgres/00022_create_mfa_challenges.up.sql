@@ -0,0 +1,14 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS auth.mfa_challenges (
+    id uuid DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    ticket text NOT NULL UNIQUE,
+    mfa_type text NOT NULL,
+    otp_hash text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    expires_at timestamp with time zone NOT NULL
+);
+CREATE INDEX IF NOT EXISTS mfa_challenges_user_id_idx ON auth.mfa_challenges (user_id);
+COMMENT ON TABLE auth.mfa_challenges IS 'Pending email and SMS multi-factor authentication challenges. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS auth.notification_outbox (
+    id uuid DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    kind text NOT NULL,
+    recipient text NOT NULL,
+    payload jsonb NOT NULL,
+    status text DEFAULT 'pending' NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+    sent_at timestamp with time zone,
+    last_error text
+);
+CREATE INDEX IF NOT EXISTS notification_outbox_status_next_attempt_at_idx ON auth.notification_outbox (status, next_attempt_at);
+COMMENT ON TABLE auth.notification_outbox IS 'Emails and SMS pending or already sent to users. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
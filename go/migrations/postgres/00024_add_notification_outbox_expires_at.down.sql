@@ -0,0 +1 @@
+ALTER TABLE auth.notification_outbox DROP COLUMN IF EXISTS expires_at;
@@ -0,0 +1,4 @@
+BEGIN;
+ALTER TABLE auth.notification_outbox ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+UPDATE auth.notification_outbox SET payload = '{}' WHERE status IN ('sent', 'dead');
+COMMIT;
@@ -0,0 +1 @@
+ALTER TABLE auth.users ADD COLUMN IF NOT EXISTS otp_hash_attempts integer DEFAULT 0 NOT NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS auth.users_phone_number_digits_idx;
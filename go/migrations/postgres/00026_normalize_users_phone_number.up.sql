@@ -0,0 +1,16 @@
+BEGIN;
+-- numbers stored with their country code only need the formatting removed to
+-- be in E.164 format, unless that would make them clash with another user
+UPDATE auth.users AS u
+SET phone_number = '+' || regexp_replace(u.phone_number, '[^0-9]', '', 'g')
+WHERE u.phone_number ~ '^\s*\+'
+    AND u.phone_number !~ '^\+[0-9]+$'
+    AND u.phone_number !~ '\(0\)'
+    AND (
+        SELECT COUNT(*) FROM auth.users AS o
+        WHERE regexp_replace(o.phone_number, '[^0-9]', '', 'g') = regexp_replace(u.phone_number, '[^0-9]', '', 'g')
+    ) = 1;
+-- the remaining numbers are looked up by their digits and normalized on use
+CREATE INDEX IF NOT EXISTS users_phone_number_digits_idx ON auth.users (regexp_replace(phone_number, '[^0-9]', '', 'g'))
+WHERE phone_number !~ '^\+[0-9]+$';
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+COMMENT ON TABLE auth.audit_events IS 'Security events of users such as sign-ins, password changes or MFA changes. Rows are kept after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMENT ON TABLE auth.user_impersonations IS 'Audit log of admin impersonation sessions. Rows are kept after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+UPDATE auth.audit_events SET ip_address = NULL, user_agent = NULL, metadata = NULL
+WHERE NOT EXISTS (SELECT 1 FROM auth.users WHERE users.id = audit_events.user_id);
+UPDATE auth.user_impersonations SET reason = ''
+WHERE NOT EXISTS (SELECT 1 FROM auth.users WHERE users.id = user_impersonations.user_id);
+COMMENT ON TABLE auth.audit_events IS 'Security events of users such as sign-ins, password changes or MFA changes. Rows are kept but anonymized after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMENT ON TABLE auth.user_impersonations IS 'Audit log of admin impersonation sessions. Rows are kept but anonymized after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
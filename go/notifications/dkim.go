@@ -0,0 +1,277 @@
+package notifications
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrDKIMInvalidKey       = errors.New("invalid DKIM private key")
+	ErrDKIMUnsupportedKey   = errors.New("unsupported DKIM private key type, expected RSA or Ed25519")
+	ErrDKIMMissingParameter = errors.New("DKIM domain and selector are required")
+)
+
+// DefaultDKIMHeaders are the headers signed when none are configured.
+var DefaultDKIMHeaders = []string{ //nolint:gochecknoglobals
+	"From", "To", "Subject", "Date", "Content-Type",
+}
+
+const dkimLineLength = 76
+
+var (
+	wspRegexp     = regexp.MustCompile(`[ \t]+`)
+	foldingRegexp = regexp.MustCompile(`\r\n([ \t])`)
+)
+
+// DKIMSigner signs messages following RFC 6376 with relaxed canonicalization
+// for both headers and body. RSA keys sign with rsa-sha256 and Ed25519 keys
+// with ed25519-sha256 (RFC 8463).
+type DKIMSigner struct {
+	domain    string
+	selector  string
+	headers   []string
+	signer    crypto.Signer
+	algorithm string
+	now       func() time.Time
+}
+
+// NewDKIMSigner creates a signer from a PEM encoded private key in PKCS#1 or
+// PKCS#8 format. If headers is empty DefaultDKIMHeaders are signed, From is
+// always signed as required by the RFC.
+func NewDKIMSigner(
+	domain string,
+	selector string,
+	privateKeyPEM string,
+	headers []string,
+) (*DKIMSigner, error) {
+	if domain == "" || selector == "" {
+		return nil, ErrDKIMMissingParameter
+	}
+
+	signer, algorithm, err := parseDKIMPrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(headers) == 0 {
+		headers = DefaultDKIMHeaders
+	}
+
+	signed := make([]string, 0, len(headers)+1)
+	hasFrom := false
+	for _, h := range headers {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			continue
+		}
+		if strings.EqualFold(h, "From") {
+			hasFrom = true
+		}
+		signed = append(signed, h)
+	}
+	if !hasFrom {
+		signed = append([]string{"From"}, signed...)
+	}
+
+	return &DKIMSigner{
+		domain:    domain,
+		selector:  selector,
+		headers:   signed,
+		signer:    signer,
+		algorithm: algorithm,
+		now:       time.Now,
+	}, nil
+}
+
+func parseDKIMPrivateKey(privateKeyPEM string) (crypto.Signer, string, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, "", fmt.Errorf("%w: no PEM block found", ErrDKIMInvalidKey)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, "rsa-sha256", nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrDKIMInvalidKey, err)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return key, "rsa-sha256", nil
+	case ed25519.PrivateKey:
+		return key, "ed25519-sha256", nil
+	default:
+		return nil, "", ErrDKIMUnsupportedKey
+	}
+}
+
+// Sign returns the message with CRLF line endings and a DKIM-Signature header
+// prepended.
+func (s *DKIMSigner) Sign(msg []byte) ([]byte, error) {
+	msg = bytes.ReplaceAll(msg, []byte("\r\n"), []byte("\n"))
+	msg = bytes.ReplaceAll(msg, []byte("\n"), []byte("\r\n"))
+
+	header, body, ok := bytes.Cut(msg, []byte("\r\n\r\n"))
+	if !ok {
+		header, body = bytes.TrimSuffix(msg, []byte("\r\n")), nil
+	}
+
+	bodyHash := sha256.Sum256(dkimCanonicalizeBody(body))
+
+	fields := dkimParseHeaders(header)
+
+	var signedHeaders strings.Builder
+	for _, field := range dkimSelectHeaders(fields, s.headers) {
+		signedHeaders.WriteString(dkimCanonicalizeHeader(field))
+		signedHeaders.WriteString("\r\n")
+	}
+
+	value := fmt.Sprintf(
+		"v=1; a=%s; c=relaxed/relaxed; d=%s; s=%s; t=%s; h=%s; bh=%s; b=",
+		s.algorithm,
+		s.domain,
+		s.selector,
+		strconv.FormatInt(s.now().Unix(), 10),
+		strings.Join(s.headers, ":"),
+		base64.StdEncoding.EncodeToString(bodyHash[:]),
+	)
+	signedHeaders.WriteString(dkimCanonicalizeHeader("DKIM-Signature: " + value))
+
+	digest := sha256.Sum256([]byte(signedHeaders.String()))
+
+	var opts crypto.SignerOpts = crypto.SHA256
+	if s.algorithm == "ed25519-sha256" {
+		opts = crypto.Hash(0)
+	}
+
+	signature, err := s.signer.Sign(rand.Reader, digest[:], opts)
+	if err != nil {
+		return nil, fmt.Errorf("error signing message: %w", err)
+	}
+
+	out := bytes.NewBufferString("DKIM-Signature: ")
+	out.WriteString(foldDKIMValue(value + base64.StdEncoding.EncodeToString(signature)))
+	out.WriteString("\r\n")
+	out.Write(msg)
+
+	return out.Bytes(), nil
+}
+
+// foldDKIMValue folds the signature between tags so lines stay under
+// dkimLineLength, the b= value is split as whitespace is ignored inside it.
+func foldDKIMValue(value string) string {
+	var b strings.Builder
+	lineLength := len("DKIM-Signature: ")
+	tags := strings.Split(value, " ")
+	for i, tag := range tags {
+		if i > 0 {
+			if lineLength+1+len(tag) > dkimLineLength {
+				b.WriteString("\r\n\t")
+				lineLength = 1
+			} else {
+				b.WriteString(" ")
+				lineLength++
+			}
+		}
+
+		if i < len(tags)-1 {
+			b.WriteString(tag)
+			lineLength += len(tag)
+			continue
+		}
+
+		for len(tag) > 0 {
+			n := min(len(tag), max(dkimLineLength-lineLength, 1))
+			b.WriteString(tag[:n])
+			tag = tag[n:]
+			lineLength += n
+			if len(tag) > 0 {
+				b.WriteString("\r\n\t")
+				lineLength = 1
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// dkimParseHeaders splits a header block into fields, keeping folded lines
+// together.
+func dkimParseHeaders(header []byte) []string {
+	lines := strings.Split(string(header), "\r\n")
+	fields := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(fields) > 0 {
+			fields[len(fields)-1] += "\r\n" + line
+			continue
+		}
+		fields = append(fields, line)
+	}
+
+	return fields
+}
+
+// dkimSelectHeaders returns the fields to sign in the order given by names.
+// Each name selects the last field with that name not selected yet, names
+// without a field are skipped.
+func dkimSelectHeaders(fields []string, names []string) []string {
+	used := make([]bool, len(fields))
+	selected := make([]string, 0, len(names))
+	for _, name := range names {
+		for i := len(fields) - 1; i >= 0; i-- {
+			k, _, _ := strings.Cut(fields[i], ":")
+			if !used[i] && strings.EqualFold(strings.TrimSpace(k), name) {
+				used[i] = true
+				selected = append(selected, fields[i])
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// dkimCanonicalizeHeader applies the relaxed header canonicalization
+// algorithm (RFC 6376, section 3.4.2) to a single field.
+func dkimCanonicalizeHeader(field string) string {
+	k, v, _ := strings.Cut(field, ":")
+	v = foldingRegexp.ReplaceAllString(v, "$1")
+	v = wspRegexp.ReplaceAllString(v, " ")
+
+	return strings.ToLower(strings.TrimSpace(k)) + ":" + strings.TrimSpace(v)
+}
+
+// dkimCanonicalizeBody applies the relaxed body canonicalization algorithm
+// (RFC 6376, section 3.4.4).
+func dkimCanonicalizeBody(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(wspRegexp.ReplaceAllString(line, " "), " ")
+	}
+
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
@@ -0,0 +1,75 @@
+package notifications
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc8463Seed is the Ed25519 private key of RFC 8463 appendix A.
+const rfc8463Seed = "nWGxne/9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A="
+
+// rfc8463Message is the example message of RFC 8463 appendix A.
+const rfc8463Message = "From: Joe SixPack <joe@football.example.com>\r\n" +
+	"To: Suzie Q <suzie@shopping.example.net>\r\n" +
+	"Subject: Is dinner ready?\r\n" +
+	"Date: Fri, 11 Jul 2003 21:00:37 -0700 (PDT)\r\n" +
+	"Message-ID: <20030712040037.46341.5F8J@football.example.com>\r\n" +
+	"\r\n" +
+	"Hi.\r\n" +
+	"\r\n" +
+	"We lost the game.  Are you hungry yet?\r\n" +
+	"\r\n" +
+	"Joe.\r\n"
+
+// TestDKIMSignVector pins the signature of a fixed message. Ed25519 signatures
+// are deterministic so the expected header doesn't change between runs, it
+// was verified with github.com/emersion/go-msgauth/dkim v0.7.0 and bh matches
+// the one in RFC 8463 appendix A.
+func TestDKIMSignVector(t *testing.T) {
+	t.Parallel()
+
+	seed, err := base64.StdEncoding.DecodeString(rfc8463Seed)
+	if err != nil {
+		t.Fatalf("failed to decode seed: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(ed25519.NewKeyFromSeed(seed))
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	signer, err := NewDKIMSigner(
+		"football.example.com",
+		"brisbane",
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	signer.now = func() time.Time { return time.Unix(1528637909, 0) } //nolint:mnd
+
+	got, err := signer.Sign([]byte(rfc8463Message))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "DKIM-Signature: v=1; a=ed25519-sha256; c=relaxed/relaxed;\r\n" +
+		"\td=football.example.com; s=brisbane; t=1528637909;\r\n" +
+		"\th=From:To:Subject:Date:Content-Type;\r\n" +
+		"\tbh=2jUSOH9NhtVGCQWNr9BrIAPreKQjO6Sn7XIkfJVOzv8=;\r\n" +
+		"\tb=Uc53tj/NdGyIisnuTB40YIrpdQYT2qJnnJdecSHuWCcXXvqz0B0RhrbJGn79krOV+8BTbS+lM\r\n" +
+		"\tJGOtLUZbquNCA==\r\n"
+
+	header, msg, _ := strings.Cut(string(got), "\r\nFrom:")
+	if header+"\r\n" != expected {
+		t.Errorf("unexpected signature:\n%s\nexpected:\n%s", header, expected)
+	}
+	if "From:"+msg != rfc8463Message {
+		t.Errorf("message was modified:\n%s", "From:"+msg)
+	}
+}
@@ -17,6 +17,7 @@ type Email struct {
 	extraHeaders     map[string]string
 	auth             smtp.Auth
 	templates        *Templates
+	dkim             *DKIMSigner
 }
 
 func NewEmail(
@@ -27,6 +28,7 @@ func NewEmail(
 	from string,
 	extraHeaders map[string]string,
 	templates *Templates,
+	dkim *DKIMSigner,
 ) *Email {
 	return &Email{
 		from:             from,
@@ -36,6 +38,7 @@ func NewEmail(
 		extraHeaders:     extraHeaders,
 		auth:             auth,
 		templates:        templates,
+		dkim:             dkim,
 	}
 }
 
@@ -77,6 +80,15 @@ func (sm *Email) Send(to, subject, contents string, headers map[string]string) e
 	buf.WriteString("\r\n")
 	buf.WriteString(contents + "\r\n")
 
+	msg := buf.Bytes()
+	if sm.dkim != nil {
+		var err error
+		msg, err = sm.dkim.Sign(msg)
+		if err != nil {
+			return fmt.Errorf("error signing email: %w", err)
+		}
+	}
+
 	// Extract plain email addresses for SMTP envelope
 	fromEmail := ExtractEmail(sm.from)
 	toEmail := ExtractEmail(to)
@@ -88,7 +100,7 @@ func (sm *Email) Send(to, subject, contents string, headers map[string]string) e
 		sm.auth,
 		fromEmail,
 		[]string{toEmail},
-		buf.Bytes(),
+		msg,
 	); err != nil {
 		return fmt.Errorf("error sending email: %w", err)
 	}
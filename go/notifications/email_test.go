@@ -128,6 +128,7 @@ func TestEmailSend(t *testing.T) {
 					"x-something": "asd",
 				},
 				nil,
+				nil,
 			)
 			headers := map[string]string{
 				"x-custom-header": "custom-value",
@@ -163,6 +164,7 @@ func TestEmailSendEmailVerify(t *testing.T) {
 			"x-something": "asd",
 		},
 		templates,
+		nil,
 	)
 
 	cases := []struct {
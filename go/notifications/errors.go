@@ -1,5 +1,37 @@
 package notifications
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
 
-var ErrTemplateNotFound = errors.New("template not found")
+var (
+	ErrTemplateNotFound = errors.New("template not found")
+	ErrMissingTemplates = errors.New("missing templates")
+)
+
+var (
+	ErrProviderUnauthorized = errors.New("email provider rejected the credentials")
+	ErrProviderRateLimited  = errors.New("email provider rate limit exceeded")
+	ErrProviderRejected     = errors.New("email provider rejected the message")
+	ErrProviderUnavailable  = errors.New("email provider is unavailable")
+)
+
+// ErrorFromStatus maps the status code of a failed request to an email
+// provider's HTTP API to one of the ErrProvider errors.
+func ErrorFromStatus(status int, body string) error {
+	var err error
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		err = ErrProviderUnauthorized
+	case status == http.StatusTooManyRequests:
+		err = ErrProviderRateLimited
+	case status >= http.StatusInternalServerError:
+		err = ErrProviderUnavailable
+	default:
+		err = ErrProviderRejected
+	}
+
+	return fmt.Errorf("%w: %d: %s", err, status, body)
+}
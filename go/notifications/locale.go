@@ -0,0 +1,109 @@
+package notifications
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const templateNameSMS = "signin-passwordless-sms"
+
+// requiredTemplates are the files every allowed locale needs to be able to
+// send every email and SMS. Security notifications are only required when
+// enabled so they are passed to CheckLocales instead.
+var requiredTemplates = map[string][]string{ //nolint:gochecknoglobals
+	string(TemplateNameEmailVerify):        {"body.html", "subject.txt"},
+	string(TemplateNameEmailConfirmChange): {"body.html", "subject.txt"},
+	string(TemplateNameSigninPasswordless): {"body.html", "subject.txt"},
+	string(TemplateNameSigninOTP):          {"body.html", "subject.txt"},
+	string(TemplateNamePasswordReset):      {"body.html", "subject.txt"},
+	string(TemplateNameUserInvite):         {"body.html", "subject.txt"},
+	templateNameSMS:                        {"body.txt"},
+}
+
+func templateFiles(templateName string) []string {
+	if files, ok := requiredTemplates[templateName]; ok {
+		return files
+	}
+	return []string{"body.html", "subject.txt"}
+}
+
+// LocaleFallbacks returns the locales to try, in order, to find a template for
+// locale: the locale itself, the locale without its region or script subtags
+// (pt-BR → pt) and finally defaultLocale and its own fallbacks.
+func LocaleFallbacks(locale string, defaultLocale string) []string {
+	chain := make([]string, 0, 4) //nolint:mnd
+	add := func(l string) {
+		if l != "" && !slices.Contains(chain, l) {
+			chain = append(chain, l)
+		}
+	}
+
+	for _, l := range []string{locale, defaultLocale} {
+		add(l)
+		l = strings.ReplaceAll(l, "_", "-")
+		add(l)
+		for i := strings.LastIndex(l, "-"); i > 0; i = strings.LastIndex(l, "-") {
+			l = l[:i]
+			add(l)
+		}
+	}
+
+	return chain
+}
+
+func (t *Templates) hasTemplate(locale string, templateName string) bool {
+	for _, file := range templateFiles(templateName) {
+		if _, ok := t.templates[filepath.Join(locale, templateName, file)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveLocale returns the first locale in the fallback chain of locale that
+// has templateName.
+func (t *Templates) resolveLocale(locale string, templateName string) (string, error) {
+	for _, l := range LocaleFallbacks(locale, t.defaultLocale) {
+		if t.hasTemplate(l, templateName) {
+			return l, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s/%s", ErrTemplateNotFound, locale, templateName)
+}
+
+// CheckLocales verifies every locale has all the templates needed to send
+// emails and SMS, as well as the extra templates, without falling back to the
+// default locale.
+func (t *Templates) CheckLocales(locales []string, extra ...TemplateName) error {
+	names := make([]string, 0, len(requiredTemplates)+len(extra))
+	for name := range requiredTemplates {
+		names = append(names, name)
+	}
+	for _, name := range extra {
+		names = append(names, string(name))
+	}
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	var missing []string
+	for _, locale := range locales {
+		for _, name := range names {
+			found := slices.ContainsFunc(
+				LocaleFallbacks(locale, ""),
+				func(l string) bool { return t.hasTemplate(l, name) },
+			)
+			if !found {
+				missing = append(missing, locale+"/"+name)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingTemplates, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package notifications_test
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+func TestLocaleFallbacks(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		locale        string
+		defaultLocale string
+		expected      []string
+	}{
+		{
+			name:          "language",
+			locale:        "fr",
+			defaultLocale: "en",
+			expected:      []string{"fr", "en"},
+		},
+		{
+			name:          "region",
+			locale:        "pt-BR",
+			defaultLocale: "en",
+			expected:      []string{"pt-BR", "pt", "en"},
+		},
+		{
+			name:          "script and region with underscores",
+			locale:        "zh_Hant_TW",
+			defaultLocale: "en-US",
+			expected:      []string{"zh_Hant_TW", "zh-Hant-TW", "zh-Hant", "zh", "en-US", "en"},
+		},
+		{
+			name:          "default locale",
+			locale:        "en",
+			defaultLocale: "en",
+			expected:      []string{"en"},
+		},
+		{
+			name:          "empty",
+			locale:        "",
+			defaultLocale: "en",
+			expected:      []string{"en"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := notifications.LocaleFallbacks(tc.locale, tc.defaultLocale)
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected fallbacks (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderFallback(t *testing.T) {
+	t.Parallel()
+
+	templates, err := notifications.NewTemplatesFromFilesystem(
+		"../../email-templates/", "en", slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		name            string
+		locale          string
+		expectedSubject string
+	}{
+		{
+			name:            "region falls back to language",
+			locale:          "fr-CA",
+			expectedSubject: "Réinitialiser votre mot de passe",
+		},
+		{
+			name:            "unknown locale falls back to the requested template",
+			locale:          "non-existent",
+			expectedSubject: "Reset your password",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, subject, err := templates.Render(
+				tc.locale, notifications.TemplateNamePasswordReset, notifications.TemplateData{}, //nolint:exhaustruct
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedSubject, strings.TrimSpace(subject)); diff != "" {
+				t.Errorf("unexpected subject (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckLocales(t *testing.T) {
+	t.Parallel()
+
+	templates, err := notifications.NewTemplatesFromFilesystem(
+		"../../email-templates/", "en", slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := templates.CheckLocales([]string{"en", "fr", "pt-BR"}); !errors.Is(
+		err, notifications.ErrMissingTemplates,
+	) {
+		t.Fatalf("expected missing templates, got %v", err)
+	} else if !strings.Contains(err.Error(), "pt-BR/password-reset") ||
+		strings.Contains(err.Error(), "fr/") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := templates.CheckLocales([]string{"en", "es", "fr-CA"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := templates.CheckLocales(
+		[]string{"bg", "cs", "en", "es", "fr"}, notifications.SecurityNotifications()...,
+	); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
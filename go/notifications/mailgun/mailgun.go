@@ -0,0 +1,95 @@
+package mailgun
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+const (
+	URL   = "https://api.mailgun.net"
+	URLEU = "https://api.eu.mailgun.net"
+)
+
+type Mailgun struct {
+	url       string
+	apiKey    string
+	from      string
+	tags      map[string]string
+	templates *notifications.Templates
+	cl        *http.Client
+}
+
+// New creates a Mailgun emailer for the given sending domain. The template
+// name is sent as tag and tags are sent as user variables.
+func New(
+	baseURL string,
+	domain string,
+	apiKey string,
+	from string,
+	tags map[string]string,
+	templates *notifications.Templates,
+) *Mailgun {
+	return &Mailgun{
+		url:       fmt.Sprintf("%s/v3/%s/messages", strings.TrimSuffix(baseURL, "/"), domain),
+		apiKey:    apiKey,
+		from:      from,
+		tags:      tags,
+		templates: templates,
+		cl:        &http.Client{}, //nolint:exhaustruct
+	}
+}
+
+func (m *Mailgun) SendEmail(
+	ctx context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	body, subject, err := m.templates.Render(locale, templateName, data)
+	if err != nil {
+		return fmt.Errorf("mailgun: error rendering email template: %w", err)
+	}
+
+	form := url.Values{
+		"from":    []string{m.from},
+		"to":      []string{to},
+		"subject": []string{strings.TrimSpace(subject)},
+		"html":    []string{body},
+		"o:tag":   []string{string(templateName)},
+	}
+	for k, v := range m.tags {
+		form.Set("v:"+k, v)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, m.url, strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return fmt.Errorf("mailgun: failed to create request: %w", err)
+	}
+	req.SetBasicAuth("api", m.apiKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := m.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf("mailgun: failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf(
+			"mailgun: failed to send email with template `%s`: %w",
+			templateName, notifications.ErrorFromStatus(resp.StatusCode, string(b)),
+		)
+	}
+
+	return nil
+}
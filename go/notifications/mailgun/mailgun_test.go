@@ -0,0 +1,119 @@
+package mailgun_test
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/mailgun"
+)
+
+func TestSendEmail(t *testing.T) {
+	t.Parallel()
+
+	templates, err := notifications.NewTemplatesFromFilesystem(
+		"../../../email-templates/", "en", slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		name         string
+		status       int
+		expectedForm url.Values
+		expectedErr  error
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			expectedForm: url.Values{
+				"from":    []string{"admin@example.com"},
+				"to":      []string{"user@example.com"},
+				"subject": []string{"One-time password for https://example.com"},
+				"o:tag":   []string{"signin-otp"},
+				"v:env":   []string{"test"},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "rejected",
+			status:       http.StatusBadRequest,
+			expectedForm: nil,
+			expectedErr:  notifications.ErrProviderRejected,
+		},
+		{
+			name:         "unavailable",
+			status:       http.StatusBadGateway,
+			expectedForm: nil,
+			expectedErr:  notifications.ErrProviderUnavailable,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				path           string
+				user, password string
+				form           url.Values
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				user, password, _ = r.BasicAuth()
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("failed to parse form: %v", err)
+				}
+				form = r.PostForm
+				w.WriteHeader(tc.status)
+			}))
+			t.Cleanup(srv.Close)
+
+			m := mailgun.New(
+				srv.URL+"/", "mg.example.com", "secret", "admin@example.com",
+				map[string]string{"env": "test"}, templates,
+			)
+
+			err := m.SendEmail(
+				t.Context(),
+				"user@example.com",
+				"en",
+				notifications.TemplateNameSigninOTP,
+				notifications.TemplateData{ //nolint:exhaustruct
+					Ticket:     "123456",
+					RedirectTo: "https://example.com",
+				},
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if diff := cmp.Diff(
+				[]string{"/v3/mg.example.com/messages", "api", "secret"},
+				[]string{path, user, password},
+			); diff != "" {
+				t.Errorf("unexpected request (-want +got):\n%s", diff)
+			}
+
+			if tc.expectedErr != nil {
+				return
+			}
+
+			if !strings.Contains(form.Get("html"), "123456") {
+				t.Errorf("expected the rendered body to contain the ticket")
+			}
+			form.Del("html")
+
+			if diff := cmp.Diff(tc.expectedForm, form); diff != "" {
+				t.Errorf("unexpected form (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
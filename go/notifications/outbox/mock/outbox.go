@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination mock/outbox.go --source=outbox.go
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	notifications "github.com/nhost/hasura-auth/go/notifications"
+	sql "github.com/nhost/hasura-auth/go/sql"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDBClient is a mock of DBClient interface.
+type MockDBClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBClientMockRecorder
+	isgomock struct{}
+}
+
+// MockDBClientMockRecorder is the mock recorder for MockDBClient.
+type MockDBClientMockRecorder struct {
+	mock *MockDBClient
+}
+
+// NewMockDBClient creates a new mock instance.
+func NewMockDBClient(ctrl *gomock.Controller) *MockDBClient {
+	mock := &MockDBClient{ctrl: ctrl}
+	mock.recorder = &MockDBClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBClient) EXPECT() *MockDBClientMockRecorder {
+	return m.recorder
+}
+
+// ClaimNotificationOutbox mocks base method.
+func (m *MockDBClient) ClaimNotificationOutbox(ctx context.Context, arg sql.ClaimNotificationOutboxParams) ([]sql.AuthNotificationOutbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNotificationOutbox", ctx, arg)
+	ret0, _ := ret[0].([]sql.AuthNotificationOutbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNotificationOutbox indicates an expected call of ClaimNotificationOutbox.
+func (mr *MockDBClientMockRecorder) ClaimNotificationOutbox(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNotificationOutbox", reflect.TypeOf((*MockDBClient)(nil).ClaimNotificationOutbox), ctx, arg)
+}
+
+// CountNotificationOutboxByStatus mocks base method.
+func (m *MockDBClient) CountNotificationOutboxByStatus(ctx context.Context) ([]sql.CountNotificationOutboxByStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNotificationOutboxByStatus", ctx)
+	ret0, _ := ret[0].([]sql.CountNotificationOutboxByStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNotificationOutboxByStatus indicates an expected call of CountNotificationOutboxByStatus.
+func (mr *MockDBClientMockRecorder) CountNotificationOutboxByStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotificationOutboxByStatus", reflect.TypeOf((*MockDBClient)(nil).CountNotificationOutboxByStatus), ctx)
+}
+
+// DeleteNotificationOutboxExpired mocks base method.
+func (m *MockDBClient) DeleteNotificationOutboxExpired(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationOutboxExpired", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotificationOutboxExpired indicates an expected call of DeleteNotificationOutboxExpired.
+func (mr *MockDBClientMockRecorder) DeleteNotificationOutboxExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationOutboxExpired", reflect.TypeOf((*MockDBClient)(nil).DeleteNotificationOutboxExpired), ctx)
+}
+
+// InsertNotificationOutbox mocks base method.
+func (m *MockDBClient) InsertNotificationOutbox(ctx context.Context, arg sql.InsertNotificationOutboxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertNotificationOutbox", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertNotificationOutbox indicates an expected call of InsertNotificationOutbox.
+func (mr *MockDBClientMockRecorder) InsertNotificationOutbox(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertNotificationOutbox", reflect.TypeOf((*MockDBClient)(nil).InsertNotificationOutbox), ctx, arg)
+}
+
+// UpdateNotificationOutboxFailed mocks base method.
+func (m *MockDBClient) UpdateNotificationOutboxFailed(ctx context.Context, arg sql.UpdateNotificationOutboxFailedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationOutboxFailed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotificationOutboxFailed indicates an expected call of UpdateNotificationOutboxFailed.
+func (mr *MockDBClientMockRecorder) UpdateNotificationOutboxFailed(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationOutboxFailed", reflect.TypeOf((*MockDBClient)(nil).UpdateNotificationOutboxFailed), ctx, arg)
+}
+
+// UpdateNotificationOutboxSent mocks base method.
+func (m *MockDBClient) UpdateNotificationOutboxSent(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationOutboxSent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotificationOutboxSent indicates an expected call of UpdateNotificationOutboxSent.
+func (mr *MockDBClientMockRecorder) UpdateNotificationOutboxSent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationOutboxSent", reflect.TypeOf((*MockDBClient)(nil).UpdateNotificationOutboxSent), ctx, id)
+}
+
+// MockEmailer is a mock of Emailer interface.
+type MockEmailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailerMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailerMockRecorder is the mock recorder for MockEmailer.
+type MockEmailerMockRecorder struct {
+	mock *MockEmailer
+}
+
+// NewMockEmailer creates a new mock instance.
+func NewMockEmailer(ctrl *gomock.Controller) *MockEmailer {
+	mock := &MockEmailer{ctrl: ctrl}
+	mock.recorder = &MockEmailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailer) EXPECT() *MockEmailerMockRecorder {
+	return m.recorder
+}
+
+// SendEmail mocks base method.
+func (m *MockEmailer) SendEmail(ctx context.Context, to, locale string, templateName notifications.TemplateName, data notifications.TemplateData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmail", ctx, to, locale, templateName, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmail indicates an expected call of SendEmail.
+func (mr *MockEmailerMockRecorder) SendEmail(ctx, to, locale, templateName, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmail", reflect.TypeOf((*MockEmailer)(nil).SendEmail), ctx, to, locale, templateName, data)
+}
+
+// MockSMSProvider is a mock of SMSProvider interface.
+type MockSMSProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockSMSProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockSMSProviderMockRecorder is the mock recorder for MockSMSProvider.
+type MockSMSProviderMockRecorder struct {
+	mock *MockSMSProvider
+}
+
+// NewMockSMSProvider creates a new mock instance.
+func NewMockSMSProvider(ctrl *gomock.Controller) *MockSMSProvider {
+	mock := &MockSMSProvider{ctrl: ctrl}
+	mock.recorder = &MockSMSProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSMSProvider) EXPECT() *MockSMSProviderMockRecorder {
+	return m.recorder
+}
+
+// SendSMS mocks base method.
+func (m *MockSMSProvider) SendSMS(to, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendSMS", to, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendSMS indicates an expected call of SendSMS.
+func (mr *MockSMSProviderMockRecorder) SendSMS(to, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSMS", reflect.TypeOf((*MockSMSProvider)(nil).SendSMS), to, body)
+}
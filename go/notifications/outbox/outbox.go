@@ -0,0 +1,395 @@
+//go:generate mockgen -package mock -destination mock/outbox.go --source=outbox.go
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	KindEmail = "email"
+	KindSMS   = "sms"
+
+	StatusPending = "pending"
+	StatusSent    = "sent"
+	StatusDead    = "dead"
+
+	defaultBatchSize   = 50
+	defaultInterval    = 2 * time.Second
+	defaultMaxAttempts = 5
+	defaultLease       = time.Minute
+	backoffBase        = 10 * time.Second
+	backoffMax         = 10 * time.Minute
+	maxBackoffExponent = 20
+	enqueueTimeout     = 5 * time.Second
+
+	// SMS only carry verification codes, they expire after 5 minutes
+	smsCodeLifetime = 5 * time.Minute
+)
+
+var errUnknownKind = errors.New("unknown notification kind")
+
+type DBClient interface {
+	InsertNotificationOutbox(ctx context.Context, arg sql.InsertNotificationOutboxParams) error
+	DeleteNotificationOutboxExpired(ctx context.Context) (int64, error)
+	ClaimNotificationOutbox(
+		ctx context.Context, arg sql.ClaimNotificationOutboxParams,
+	) ([]sql.AuthNotificationOutbox, error)
+	UpdateNotificationOutboxSent(ctx context.Context, id uuid.UUID) error
+	UpdateNotificationOutboxFailed(
+		ctx context.Context, arg sql.UpdateNotificationOutboxFailedParams,
+	) error
+	CountNotificationOutboxByStatus(
+		ctx context.Context,
+	) ([]sql.CountNotificationOutboxByStatusRow, error)
+}
+
+type Emailer interface {
+	SendEmail(
+		ctx context.Context,
+		to string,
+		locale string,
+		templateName notifications.TemplateName,
+		data notifications.TemplateData,
+	) error
+}
+
+type SMSProvider interface {
+	SendSMS(to string, body string) error
+}
+
+type emailPayload struct {
+	Locale       string                     `json:"locale"`
+	TemplateName notifications.TemplateName `json:"templateName"`
+	Data         notifications.TemplateData `json:"data"`
+}
+
+type smsPayload struct {
+	Body string `json:"body"`
+}
+
+// Depth is the number of messages of a kind in a given status that are
+// waiting in the outbox.
+type Depth struct {
+	Kind   string
+	Status string
+	Count  int64
+}
+
+// Outbox stores emails and SMS in auth.notification_outbox and sends them in
+// the background so a provider outage doesn't fail the ongoing request. Rows
+// are claimed with a lease so several instances can run concurrently, failed
+// messages are retried with exponential backoff and moved to the dead status
+// once MaxAttempts is reached or the provider rejects them. The payload is
+// cleared once a message is sent or dead, and pending messages are dropped
+// once the code they carry expires.
+//
+// With failFast, messages users are waiting on to sign in are sent right away
+// instead and errors are returned to the caller.
+type Outbox struct {
+	db          DBClient
+	emailer     Emailer
+	sms         SMSProvider
+	failFast    bool
+	logger      *slog.Logger
+	now         func() time.Time
+	mu          sync.RWMutex
+	depth       []Depth
+	Interval    time.Duration
+	BatchSize   int32
+	MaxAttempts int32
+}
+
+func New(db DBClient, failFast bool, logger *slog.Logger) *Outbox {
+	return &Outbox{
+		db:          db,
+		emailer:     nil,
+		sms:         nil,
+		failFast:    failFast,
+		logger:      logger,
+		now:         time.Now,
+		mu:          sync.RWMutex{},
+		depth:       nil,
+		Interval:    defaultInterval,
+		BatchSize:   defaultBatchSize,
+		MaxAttempts: defaultMaxAttempts,
+	}
+}
+
+// Emailer returns an emailer that queues emails in the outbox. They are
+// sent with the given emailer.
+func (o *Outbox) Emailer(emailer Emailer) *QueuedEmailer {
+	o.emailer = emailer
+	return &QueuedEmailer{outbox: o}
+}
+
+// SMSProvider returns an SMS provider that queues SMS in the outbox. They are
+// sent with the given provider.
+func (o *Outbox) SMSProvider(provider SMSProvider) *QueuedSMSProvider {
+	o.sms = provider
+	return &QueuedSMSProvider{outbox: o}
+}
+
+// enqueue stores the message in the outbox. Messages carrying a code or link
+// are given the lifetime of the code so they are dropped once it is useless.
+func (o *Outbox) enqueue(
+	ctx context.Context, kind string, to string, payload any, lifetime time.Duration,
+) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error marshalling %s payload: %w", kind, err)
+	}
+
+	var expiresAt pgtype.Timestamptz
+	if lifetime > 0 {
+		expiresAt = sql.TimestampTz(o.now().Add(lifetime))
+	}
+
+	if err := o.db.InsertNotificationOutbox(ctx, sql.InsertNotificationOutboxParams{
+		Kind:      kind,
+		Recipient: to,
+		Payload:   b,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return fmt.Errorf("error queueing %s: %w", kind, err)
+	}
+
+	return nil
+}
+
+// Run sends pending messages every Interval until ctx is cancelled.
+func (o *Outbox) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := o.Process(ctx); err != nil {
+			o.logger.Error("error processing notification outbox", slog.String("error", err.Error()))
+		}
+
+		if err := o.refreshDepth(ctx); err != nil {
+			o.logger.Error(
+				"error getting notification outbox depth", slog.String("error", err.Error()),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Process drops the pending messages whose codes expired, then claims a batch
+// of pending messages and sends them.
+func (o *Outbox) Process(ctx context.Context) error {
+	expired, err := o.db.DeleteNotificationOutboxExpired(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting expired notifications: %w", err)
+	}
+	if expired > 0 {
+		o.logger.Warn("dropped expired notifications", slog.Int64("count", expired))
+	}
+
+	rows, err := o.db.ClaimNotificationOutbox(ctx, sql.ClaimNotificationOutboxParams{
+		LeaseUntil: sql.TimestampTz(o.now().Add(defaultLease)),
+		BatchSize:  o.BatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error claiming notifications: %w", err)
+	}
+
+	for _, row := range rows {
+		o.deliver(ctx, row)
+	}
+
+	return nil
+}
+
+func (o *Outbox) deliver(ctx context.Context, row sql.AuthNotificationOutbox) {
+	logger := o.logger.With(
+		slog.String("notification", row.ID.String()),
+		slog.String("kind", row.Kind),
+	)
+
+	if err := o.send(ctx, row); err != nil {
+		logger.Warn("error sending notification", slog.String("error", err.Error()))
+
+		status := StatusPending
+		if row.Attempts+1 >= o.MaxAttempts ||
+			errors.Is(err, notifications.ErrProviderRejected) ||
+			errors.Is(err, errUnknownKind) {
+			status = StatusDead
+		}
+		o.markFailed(ctx, row, status, err.Error(), logger)
+
+		return
+	}
+
+	if err := o.db.UpdateNotificationOutboxSent(ctx, row.ID); err != nil {
+		logger.Error("error marking notification as sent", slog.String("error", err.Error()))
+	}
+}
+
+func (o *Outbox) send(ctx context.Context, row sql.AuthNotificationOutbox) error {
+	switch row.Kind {
+	case KindEmail:
+		var payload emailPayload
+		if err := json.Unmarshal(row.Payload, &payload); err != nil {
+			return fmt.Errorf("error unmarshalling email payload: %w", err)
+		}
+
+		return o.emailer.SendEmail( //nolint:wrapcheck
+			ctx, row.Recipient, payload.Locale, payload.TemplateName, payload.Data,
+		)
+	case KindSMS:
+		var payload smsPayload
+		if err := json.Unmarshal(row.Payload, &payload); err != nil {
+			return fmt.Errorf("error unmarshalling sms payload: %w", err)
+		}
+
+		return o.sms.SendSMS(row.Recipient, payload.Body) //nolint:wrapcheck
+	default:
+		return fmt.Errorf("%w: %s", errUnknownKind, row.Kind)
+	}
+}
+
+func (o *Outbox) markFailed(
+	ctx context.Context,
+	row sql.AuthNotificationOutbox,
+	status string,
+	msg string,
+	logger *slog.Logger,
+) {
+	// dead messages are never sent so the codes and links they hold aren't kept
+	if err := o.db.UpdateNotificationOutboxFailed(ctx, sql.UpdateNotificationOutboxFailedParams{
+		Status:        status,
+		NextAttemptAt: sql.TimestampTz(o.now().Add(Backoff(row.Attempts))),
+		LastError:     sql.Text(msg),
+		ClearPayload:  status == StatusDead,
+		ID:            row.ID,
+	}); err != nil {
+		logger.Error("error marking notification as failed", slog.String("error", err.Error()))
+	}
+}
+
+func (o *Outbox) refreshDepth(ctx context.Context) error {
+	rows, err := o.db.CountNotificationOutboxByStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("error counting notifications: %w", err)
+	}
+
+	depth := make([]Depth, len(rows))
+	for i, row := range rows {
+		depth[i] = Depth{Kind: row.Kind, Status: row.Status, Count: row.Count}
+	}
+	slices.SortFunc(depth, func(a, b Depth) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Status, b.Status))
+	})
+
+	o.mu.Lock()
+	changed := !slices.Equal(o.depth, depth)
+	o.depth = depth
+	o.mu.Unlock()
+
+	if changed {
+		args := make([]any, len(depth))
+		for i, d := range depth {
+			args[i] = slog.Int64(d.Kind+"_"+d.Status, d.Count)
+		}
+		o.logger.Info("notification outbox depth changed", args...)
+	}
+
+	return nil
+}
+
+// Depth returns the number of messages waiting to be sent or dead, by kind
+// and status, as of the last time the outbox was processed.
+func (o *Outbox) Depth() []Depth {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return slices.Clone(o.depth)
+}
+
+// Backoff returns the time to wait before the next attempt after the given number
+// of previous attempts.
+func Backoff(attempts int32) time.Duration {
+	if attempts > maxBackoffExponent {
+		return backoffMax
+	}
+
+	return min(backoffBase<<attempts, backoffMax)
+}
+
+var interactiveTemplates = []notifications.TemplateName{ //nolint:gochecknoglobals
+	notifications.TemplateNameSigninOTP,
+	notifications.TemplateNameSigninPasswordless,
+	notifications.TemplateNamePasswordReset,
+}
+
+// codeLifetimes is how long the tickets sent with each template are valid for,
+// templates without a ticket are kept until they are sent.
+var codeLifetimes = map[notifications.TemplateName]time.Duration{ //nolint:gochecknoglobals
+	notifications.TemplateNameSigninOTP:          time.Hour,
+	notifications.TemplateNameSigninPasswordless: time.Hour,
+	notifications.TemplateNamePasswordReset:      time.Hour,
+	notifications.TemplateNameEmailConfirmChange: time.Hour,
+	notifications.TemplateNameEmailVerify:        30 * 24 * time.Hour,
+	notifications.TemplateNameUserInvite:         30 * 24 * time.Hour,
+}
+
+// QueuedEmailer implements the emailer expected by the controller on top of
+// the outbox.
+type QueuedEmailer struct {
+	outbox *Outbox
+}
+
+func (e *QueuedEmailer) SendEmail(
+	ctx context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	if e.outbox.failFast && slices.Contains(interactiveTemplates, templateName) {
+		return e.outbox.emailer.SendEmail(ctx, to, locale, templateName, data) //nolint:wrapcheck
+	}
+
+	return e.outbox.enqueue(ctx, KindEmail, to, emailPayload{
+		Locale:       locale,
+		TemplateName: templateName,
+		Data:         data,
+	}, codeLifetimes[templateName])
+}
+
+// QueuedSMSProvider implements a generic SMS provider on top of the outbox.
+// SMS only carry verification codes so they are always sent right away with
+// failFast.
+type QueuedSMSProvider struct {
+	outbox *Outbox
+}
+
+func (s *QueuedSMSProvider) SendSMS(to string, body string) error {
+	if s.outbox.failFast {
+		return s.outbox.sms.SendSMS(to, body) //nolint:wrapcheck
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), enqueueTimeout)
+	defer cancel()
+
+	return s.outbox.enqueue(ctx, KindSMS, to, smsPayload{Body: body}, smsCodeLifetime)
+}
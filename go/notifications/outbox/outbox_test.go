@@ -0,0 +1,407 @@
+package outbox_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/outbox"
+	"github.com/nhost/hasura-auth/go/notifications/outbox/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func emailPayload(t *testing.T) []byte {
+	t.Helper()
+
+	b, err := json.Marshal(map[string]any{
+		"locale":       "en",
+		"templateName": notifications.TemplateNameEmailVerify,
+		"data": notifications.TemplateData{ //nolint:exhaustruct
+			Link:  "https://example.com/verify",
+			Email: "user@example.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal payload: %v", err)
+	}
+
+	return b
+}
+
+func TestOutboxProcess(t *testing.T) { //nolint:funlen,maintidx
+	t.Parallel()
+
+	notificationID := uuid.MustParse("4d6b3b8e-5f0b-4c1e-9a8d-3f2b1c0e9d7a")
+	errTransient := fmt.Errorf("smtp: %w", notifications.ErrProviderUnavailable)
+	errRejected := fmt.Errorf("sendgrid: %w", notifications.ErrProviderRejected)
+
+	cases := []struct {
+		name    string
+		dbFn    func(ctrl *gomock.Controller, payload []byte) *mock.MockDBClient
+		emailFn func(ctrl *gomock.Controller) *mock.MockEmailer
+		smsFn   func(ctrl *gomock.Controller) *mock.MockSMSProvider
+	}{
+		{
+			name: "email sent",
+			dbFn: func(ctrl *gomock.Controller, payload []byte) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().DeleteNotificationOutboxExpired(gomock.Any()).Return(int64(0), nil)
+				mock.EXPECT().ClaimNotificationOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthNotificationOutbox{
+						{ //nolint:exhaustruct
+							ID:        notificationID,
+							Kind:      outbox.KindEmail,
+							Recipient: "user@example.com",
+							Payload:   payload,
+							Status:    outbox.StatusPending,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateNotificationOutboxSent(gomock.Any(), notificationID).Return(nil)
+				return mock
+			},
+			emailFn: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"user@example.com",
+					"en",
+					notifications.TemplateNameEmailVerify,
+					notifications.TemplateData{ //nolint:exhaustruct
+						Link:  "https://example.com/verify",
+						Email: "user@example.com",
+					},
+				).Return(nil)
+				return mock
+			},
+			smsFn: mock.NewMockSMSProvider,
+		},
+		{
+			name: "sms sent",
+			dbFn: func(ctrl *gomock.Controller, _ []byte) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().DeleteNotificationOutboxExpired(gomock.Any()).Return(int64(0), nil)
+				mock.EXPECT().ClaimNotificationOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthNotificationOutbox{
+						{ //nolint:exhaustruct
+							ID:        notificationID,
+							Kind:      outbox.KindSMS,
+							Recipient: "+14155552671",
+							Payload:   []byte(`{"body":"Your code is 123456"}`),
+							Status:    outbox.StatusPending,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateNotificationOutboxSent(gomock.Any(), notificationID).Return(nil)
+				return mock
+			},
+			emailFn: mock.NewMockEmailer,
+			smsFn: func(ctrl *gomock.Controller) *mock.MockSMSProvider {
+				mock := mock.NewMockSMSProvider(ctrl)
+				mock.EXPECT().SendSMS("+14155552671", "Your code is 123456").Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "provider unavailable, retried",
+			dbFn: func(ctrl *gomock.Controller, payload []byte) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().DeleteNotificationOutboxExpired(gomock.Any()).Return(int64(0), nil)
+				mock.EXPECT().ClaimNotificationOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthNotificationOutbox{
+						{ //nolint:exhaustruct
+							ID:        notificationID,
+							Kind:      outbox.KindEmail,
+							Recipient: "user@example.com",
+							Payload:   payload,
+							Status:    outbox.StatusPending,
+							Attempts:  1,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateNotificationOutboxFailed(
+					gomock.Any(),
+					gomock.Cond(func(arg sql.UpdateNotificationOutboxFailedParams) bool {
+						return arg.ID == notificationID &&
+							arg.Status == outbox.StatusPending &&
+							!arg.ClearPayload &&
+							arg.LastError.String == errTransient.Error() &&
+							arg.NextAttemptAt.Time.After(time.Now().Add(15*time.Second))
+					}),
+				).Return(nil)
+				return mock
+			},
+			emailFn: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				mock.EXPECT().SendEmail(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(errTransient)
+				return mock
+			},
+			smsFn: mock.NewMockSMSProvider,
+		},
+		{
+			name: "provider unavailable, max attempts reached, payload cleared",
+			dbFn: func(ctrl *gomock.Controller, payload []byte) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().DeleteNotificationOutboxExpired(gomock.Any()).Return(int64(0), nil)
+				mock.EXPECT().ClaimNotificationOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthNotificationOutbox{
+						{ //nolint:exhaustruct
+							ID:        notificationID,
+							Kind:      outbox.KindEmail,
+							Recipient: "user@example.com",
+							Payload:   payload,
+							Status:    outbox.StatusPending,
+							Attempts:  4,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateNotificationOutboxFailed(
+					gomock.Any(),
+					gomock.Cond(func(arg sql.UpdateNotificationOutboxFailedParams) bool {
+						return arg.ID == notificationID &&
+							arg.Status == outbox.StatusDead &&
+							arg.ClearPayload
+					}),
+				).Return(nil)
+				return mock
+			},
+			emailFn: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				mock.EXPECT().SendEmail(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(errTransient)
+				return mock
+			},
+			smsFn: mock.NewMockSMSProvider,
+		},
+		{
+			name: "provider rejects the message, not retried, payload cleared",
+			dbFn: func(ctrl *gomock.Controller, payload []byte) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().DeleteNotificationOutboxExpired(gomock.Any()).Return(int64(0), nil)
+				mock.EXPECT().ClaimNotificationOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthNotificationOutbox{
+						{ //nolint:exhaustruct
+							ID:        notificationID,
+							Kind:      outbox.KindEmail,
+							Recipient: "user@example.com",
+							Payload:   payload,
+							Status:    outbox.StatusPending,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateNotificationOutboxFailed(
+					gomock.Any(),
+					gomock.Cond(func(arg sql.UpdateNotificationOutboxFailedParams) bool {
+						return arg.ID == notificationID &&
+							arg.Status == outbox.StatusDead &&
+							arg.ClearPayload
+					}),
+				).Return(nil)
+				return mock
+			},
+			emailFn: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				mock.EXPECT().SendEmail(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(errRejected)
+				return mock
+			},
+			smsFn: mock.NewMockSMSProvider,
+		},
+		{
+			name: "expired messages are dropped",
+			dbFn: func(ctrl *gomock.Controller, _ []byte) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().DeleteNotificationOutboxExpired(gomock.Any()).Return(int64(2), nil)
+				mock.EXPECT().ClaimNotificationOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthNotificationOutbox{}, nil,
+				)
+				return mock
+			},
+			emailFn: mock.NewMockEmailer,
+			smsFn:   mock.NewMockSMSProvider,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ob := outbox.New(tc.dbFn(ctrl, emailPayload(t)), false, slog.Default())
+			ob.Emailer(tc.emailFn(ctrl))
+			ob.SMSProvider(tc.smsFn(ctrl))
+
+			if err := ob.Process(t.Context()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestQueuedEmailerSendEmail(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	errSMTP := errors.New("smtp error") //nolint:err113
+
+	cases := []struct {
+		name         string
+		failFast     bool
+		templateName notifications.TemplateName
+		dbFn         func(ctrl *gomock.Controller) *mock.MockDBClient
+		emailFn      func(ctrl *gomock.Controller) *mock.MockEmailer
+		expectedErr  error
+	}{
+		{
+			name:         "queued",
+			failFast:     false,
+			templateName: notifications.TemplateNameSigninPasswordless,
+			dbFn: func(ctrl *gomock.Controller) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertNotificationOutbox(
+					gomock.Any(),
+					gomock.Cond(func(arg sql.InsertNotificationOutboxParams) bool {
+						var payload map[string]any
+						if err := json.Unmarshal(arg.Payload, &payload); err != nil {
+							return false
+						}
+						return arg.Kind == outbox.KindEmail &&
+							arg.Recipient == "user@example.com" &&
+							arg.ExpiresAt.Valid &&
+							time.Until(arg.ExpiresAt.Time) > 59*time.Minute &&
+							payload["templateName"] == "signin-passwordless" &&
+							payload["locale"] == "en"
+					}),
+				).Return(nil)
+				return mock
+			},
+			emailFn:     mock.NewMockEmailer,
+			expectedErr: nil,
+		},
+		{
+			name:         "fail fast, interactive template is sent right away",
+			failFast:     true,
+			templateName: notifications.TemplateNameSigninPasswordless,
+			dbFn:         mock.NewMockDBClient,
+			emailFn: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"user@example.com",
+					"en",
+					notifications.TemplateNameSigninPasswordless,
+					gomock.Any(),
+				).Return(errSMTP)
+				return mock
+			},
+			expectedErr: errSMTP,
+		},
+		{
+			name:         "fail fast, other templates are queued",
+			failFast:     true,
+			templateName: notifications.TemplateNameEmailVerify,
+			dbFn: func(ctrl *gomock.Controller) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertNotificationOutbox(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			emailFn:     mock.NewMockEmailer,
+			expectedErr: nil,
+		},
+		{
+			name:         "security notification, no expiry",
+			failFast:     false,
+			templateName: notifications.TemplateNamePasswordChanged,
+			dbFn: func(ctrl *gomock.Controller) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertNotificationOutbox(
+					gomock.Any(),
+					gomock.Cond(func(arg sql.InsertNotificationOutboxParams) bool {
+						return !arg.ExpiresAt.Valid
+					}),
+				).Return(nil)
+				return mock
+			},
+			emailFn:     mock.NewMockEmailer,
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ob := outbox.New(tc.dbFn(ctrl), tc.failFast, slog.Default())
+			emailer := ob.Emailer(tc.emailFn(ctrl))
+
+			err := emailer.SendEmail(
+				t.Context(),
+				"user@example.com",
+				"en",
+				tc.templateName,
+				notifications.TemplateData{}, //nolint:exhaustruct
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestQueuedSMSProviderSendSMS(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	db := mock.NewMockDBClient(ctrl)
+	db.EXPECT().InsertNotificationOutbox(
+		gomock.Any(),
+		gomock.Cond(func(arg sql.InsertNotificationOutboxParams) bool {
+			return arg.Kind == outbox.KindSMS &&
+				arg.Recipient == "+14155552671" &&
+				string(arg.Payload) == `{"body":"Your code is 123456"}` &&
+				arg.ExpiresAt.Valid &&
+				time.Until(arg.ExpiresAt.Time) <= 5*time.Minute
+		}),
+	).Return(nil)
+
+	ob := outbox.New(db, false, slog.Default())
+	provider := ob.SMSProvider(mock.NewMockSMSProvider(ctrl))
+
+	if err := provider.SendSMS("+14155552671", "Your code is 123456"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		attempts int32
+		expected time.Duration
+	}{
+		{attempts: 0, expected: 10 * time.Second},
+		{attempts: 1, expected: 20 * time.Second},
+		{attempts: 4, expected: 160 * time.Second},
+		{attempts: 6, expected: 10 * time.Minute},
+		{attempts: 30, expected: 10 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		if got := outbox.Backoff(tc.attempts); got != tc.expected {
+			t.Errorf("Backoff(%d) = %s, want %s", tc.attempts, got, tc.expected)
+		}
+	}
+}
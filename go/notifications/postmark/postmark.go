@@ -15,16 +15,20 @@ import (
 const url = "https://api.postmarkapp.com/email/withTemplate"
 
 type Postmark struct {
-	from        string
-	serverToken string
-	cl          *http.Client
+	from          string
+	serverToken   string
+	messageStream string
+	cl            *http.Client
 }
 
-func New(from string, serverToken string) *Postmark {
+// New creates a Postmark emailer. If messageStream is empty Postmark's default
+// transactional stream is used.
+func New(from string, serverToken string, messageStream string) *Postmark {
 	return &Postmark{
-		from:        from,
-		serverToken: serverToken,
-		cl:          &http.Client{}, //nolint:exhaustruct
+		from:          from,
+		serverToken:   serverToken,
+		messageStream: messageStream,
+		cl:            &http.Client{}, //nolint:exhaustruct
 	}
 }
 
@@ -34,6 +38,7 @@ type SendWithTemplateRequest struct {
 	TemplateModel any    `json:"TemplateModel"`
 	From          string `json:"From"`
 	To            string `json:"To"`
+	MessageStream string `json:"MessageStream,omitempty"`
 }
 
 func (p *Postmark) request(
@@ -73,9 +78,7 @@ func (p *Postmark) request(
 
 	if resp.StatusCode != http.StatusOK {
 		b, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf( //nolint:goerr113
-			"%s: %s", resp.Status, string(b),
-		)
+		return notifications.ErrorFromStatus(resp.StatusCode, string(b))
 	}
 
 	return nil
@@ -96,6 +99,7 @@ func (p *Postmark) SendEmail(
 		TemplateModel: templateModel,
 		From:          p.from,
 		To:            to,
+		MessageStream: p.messageStream,
 	}); err != nil {
 		return fmt.Errorf("postmark: failed to send email with template `%s`: %w", template, err)
 	}
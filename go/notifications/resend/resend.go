@@ -0,0 +1,111 @@
+package resend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+const URL = "https://api.resend.com/emails"
+
+type Resend struct {
+	url       string
+	apiKey    string
+	from      string
+	tags      map[string]string
+	templates *notifications.Templates
+	cl        *http.Client
+}
+
+// New creates a Resend emailer. Tags are sent alongside a `template` tag with
+// the template name.
+func New(
+	url string,
+	apiKey string,
+	from string,
+	tags map[string]string,
+	templates *notifications.Templates,
+) *Resend {
+	return &Resend{
+		url:       url,
+		apiKey:    apiKey,
+		from:      from,
+		tags:      tags,
+		templates: templates,
+		cl:        &http.Client{}, //nolint:exhaustruct
+	}
+}
+
+type Tag struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type SendRequest struct {
+	From    string   `json:"from"`
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+	HTML    string   `json:"html"`
+	Tags    []Tag    `json:"tags,omitempty"`
+}
+
+func (r *Resend) SendEmail(
+	ctx context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	body, subject, err := r.templates.Render(locale, templateName, data)
+	if err != nil {
+		return fmt.Errorf("resend: error rendering email template: %w", err)
+	}
+
+	tags := make([]Tag, 0, len(r.tags)+1)
+	tags = append(tags, Tag{Name: "template", Value: string(templateName)})
+	for _, k := range slices.Sorted(maps.Keys(r.tags)) {
+		tags = append(tags, Tag{Name: k, Value: r.tags[k]})
+	}
+
+	b, err := json.Marshal(SendRequest{
+		From:    r.from,
+		To:      []string{to},
+		Subject: strings.TrimSpace(subject),
+		HTML:    body,
+		Tags:    tags,
+	})
+	if err != nil {
+		return fmt.Errorf("resend: failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("resend: failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+r.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf("resend: failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf(
+			"resend: failed to send email with template `%s`: %w",
+			templateName, notifications.ErrorFromStatus(resp.StatusCode, string(b)),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package resend_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/resend"
+)
+
+func TestSendEmail(t *testing.T) {
+	t.Parallel()
+
+	templates, err := notifications.NewTemplatesFromFilesystem(
+		"../../../email-templates/", "en", slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		name            string
+		status          int
+		expectedRequest resend.SendRequest
+		expectedErr     error
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			expectedRequest: resend.SendRequest{ //nolint:exhaustruct
+				From:    "admin@example.com",
+				To:      []string{"user@example.com"},
+				Subject: "One-time password for https://example.com",
+				Tags: []resend.Tag{
+					{Name: "template", Value: "signin-otp"},
+					{Name: "env", Value: "test"},
+					{Name: "region", Value: "eu"},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:            "forbidden",
+			status:          http.StatusForbidden,
+			expectedRequest: resend.SendRequest{}, //nolint:exhaustruct
+			expectedErr:     notifications.ErrProviderUnauthorized,
+		},
+		{
+			name:            "unprocessable",
+			status:          http.StatusUnprocessableEntity,
+			expectedRequest: resend.SendRequest{}, //nolint:exhaustruct
+			expectedErr:     notifications.ErrProviderRejected,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				got           resend.SendRequest
+				authorization string
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("failed to decode body: %v", err)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			t.Cleanup(srv.Close)
+
+			r := resend.New(
+				srv.URL, "secret", "admin@example.com",
+				map[string]string{"region": "eu", "env": "test"}, templates,
+			)
+
+			err := r.SendEmail(
+				t.Context(),
+				"user@example.com",
+				"en",
+				notifications.TemplateNameSigninOTP,
+				notifications.TemplateData{ //nolint:exhaustruct
+					Ticket:     "123456",
+					RedirectTo: "https://example.com",
+				},
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if authorization != "Bearer secret" {
+				t.Errorf("unexpected authorization header: %s", authorization)
+			}
+
+			if tc.expectedErr != nil {
+				return
+			}
+
+			if !strings.Contains(got.HTML, "123456") {
+				t.Errorf("expected the rendered body to contain the ticket")
+			}
+
+			if diff := cmp.Diff(
+				tc.expectedRequest, got, cmpopts.IgnoreFields(resend.SendRequest{}, "HTML"),
+			); diff != "" {
+				t.Errorf("unexpected request (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package sendgrid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+const URL = "https://api.sendgrid.com/v3/mail/send"
+
+type SendGrid struct {
+	url       string
+	apiKey    string
+	from      string
+	tags      map[string]string
+	templates *notifications.Templates
+	cl        *http.Client
+}
+
+// New creates a SendGrid emailer. Tags are sent as custom arguments alongside
+// the template name, which is also sent as category.
+func New(
+	url string,
+	apiKey string,
+	from string,
+	tags map[string]string,
+	templates *notifications.Templates,
+) *SendGrid {
+	return &SendGrid{
+		url:       url,
+		apiKey:    apiKey,
+		from:      from,
+		tags:      tags,
+		templates: templates,
+		cl:        &http.Client{}, //nolint:exhaustruct
+	}
+}
+
+type address struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type personalization struct {
+	To []address `json:"to"`
+}
+
+type content struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type SendRequest struct {
+	Personalizations []personalization `json:"personalizations"`
+	From             address           `json:"from"`
+	Subject          string            `json:"subject"`
+	Content          []content         `json:"content"`
+	Categories       []string          `json:"categories,omitempty"`
+	CustomArgs       map[string]string `json:"custom_args,omitempty"` //nolint:tagliatelle
+}
+
+func parseAddress(s string) address {
+	addr, err := mail.ParseAddress(s)
+	if err != nil {
+		return address{Email: s, Name: ""}
+	}
+	return address{Email: addr.Address, Name: addr.Name}
+}
+
+func (s *SendGrid) SendEmail(
+	ctx context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	body, subject, err := s.templates.Render(locale, templateName, data)
+	if err != nil {
+		return fmt.Errorf("sendgrid: error rendering email template: %w", err)
+	}
+
+	b, err := json.Marshal(SendRequest{
+		Personalizations: []personalization{{To: []address{parseAddress(to)}}},
+		From:             parseAddress(s.from),
+		Subject:          strings.TrimSpace(subject),
+		Content:          []content{{Type: "text/html", Value: body}},
+		Categories:       []string{string(templateName)},
+		CustomArgs:       s.tags,
+	})
+	if err != nil {
+		return fmt.Errorf("sendgrid: failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("sendgrid: failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf("sendgrid: failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf(
+			"sendgrid: failed to send email with template `%s`: %w",
+			templateName, notifications.ErrorFromStatus(resp.StatusCode, string(b)),
+		)
+	}
+
+	return nil
+}
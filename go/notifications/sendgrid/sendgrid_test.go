@@ -0,0 +1,123 @@
+package sendgrid_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/sendgrid"
+)
+
+func TestSendEmail(t *testing.T) {
+	t.Parallel()
+
+	templates, err := notifications.NewTemplatesFromFilesystem(
+		"../../../email-templates/", "en", slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		name            string
+		status          int
+		expectedRequest sendgrid.SendRequest
+		expectedErr     error
+	}{
+		{
+			name:   "success",
+			status: http.StatusAccepted,
+			expectedRequest: sendgrid.SendRequest{ //nolint:exhaustruct
+				Subject:    "One-time password for https://example.com",
+				Categories: []string{"signin-otp"},
+				CustomArgs: map[string]string{"env": "test"},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:            "unauthorized",
+			status:          http.StatusUnauthorized,
+			expectedRequest: sendgrid.SendRequest{}, //nolint:exhaustruct
+			expectedErr:     notifications.ErrProviderUnauthorized,
+		},
+		{
+			name:            "rate limited",
+			status:          http.StatusTooManyRequests,
+			expectedRequest: sendgrid.SendRequest{}, //nolint:exhaustruct
+			expectedErr:     notifications.ErrProviderRateLimited,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				got           sendgrid.SendRequest
+				authorization string
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("failed to decode body: %v", err)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			t.Cleanup(srv.Close)
+
+			s := sendgrid.New(
+				srv.URL, "secret", "Admin <admin@example.com>",
+				map[string]string{"env": "test"}, templates,
+			)
+
+			err := s.SendEmail(
+				t.Context(),
+				"user@example.com",
+				"en",
+				notifications.TemplateNameSigninOTP,
+				notifications.TemplateData{ //nolint:exhaustruct
+					Ticket:     "123456",
+					RedirectTo: "https://example.com",
+				},
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if authorization != "Bearer secret" {
+				t.Errorf("unexpected authorization header: %s", authorization)
+			}
+
+			if tc.expectedErr != nil {
+				return
+			}
+
+			if len(got.Content) != 1 || !strings.Contains(got.Content[0].Value, "123456") {
+				t.Errorf("expected the rendered body to contain the ticket: %v", got.Content)
+			}
+
+			if diff := cmp.Diff(
+				tc.expectedRequest, got,
+				cmpopts.IgnoreFields(sendgrid.SendRequest{}, "Personalizations", "From", "Content"),
+			); diff != "" {
+				t.Errorf("unexpected request (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(
+				[]string{"user@example.com", "admin@example.com", "Admin"},
+				[]string{
+					got.Personalizations[0].To[0].Email, got.From.Email, got.From.Name,
+				},
+			); diff != "" {
+				t.Errorf("unexpected addresses (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package ses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+type Client interface {
+	SendEmail(
+		ctx context.Context, params *sesv2.SendEmailInput, optFns ...func(*sesv2.Options),
+	) (*sesv2.SendEmailOutput, error)
+}
+
+type SES struct {
+	client           Client
+	from             string
+	configurationSet string
+	tags             map[string]string
+	templates        *notifications.Templates
+}
+
+// New creates an Amazon SES emailer using the v2 API. Tags are sent as
+// message tags alongside a `template` tag with the template name and, if set,
+// the configuration set is used to send every email.
+func New(
+	client Client,
+	from string,
+	configurationSet string,
+	tags map[string]string,
+	templates *notifications.Templates,
+) *SES {
+	return &SES{
+		client:           client,
+		from:             from,
+		configurationSet: configurationSet,
+		tags:             tags,
+		templates:        templates,
+	}
+}
+
+func mapError(err error) error {
+	var (
+		tooMany     *types.TooManyRequestsException
+		limit       *types.LimitExceededException
+		rejected    *types.MessageRejected
+		badRequest  *types.BadRequestException
+		notFound    *types.NotFoundException
+		suspended   *types.AccountSuspendedException
+		paused      *types.SendingPausedException
+		notVerified *types.MailFromDomainNotVerifiedException
+	)
+
+	switch {
+	case errors.As(err, &tooMany), errors.As(err, &limit):
+		return fmt.Errorf("%w: %w", notifications.ErrProviderRateLimited, err)
+	case errors.As(err, &suspended), errors.As(err, &paused):
+		return fmt.Errorf("%w: %w", notifications.ErrProviderUnauthorized, err)
+	case errors.As(err, &rejected), errors.As(err, &badRequest),
+		errors.As(err, &notFound), errors.As(err, &notVerified):
+		return fmt.Errorf("%w: %w", notifications.ErrProviderRejected, err)
+	default:
+		return fmt.Errorf("%w: %w", notifications.ErrProviderUnavailable, err)
+	}
+}
+
+func (s *SES) SendEmail(
+	ctx context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	body, subject, err := s.templates.Render(locale, templateName, data)
+	if err != nil {
+		return fmt.Errorf("ses: error rendering email template: %w", err)
+	}
+
+	tags := make([]types.MessageTag, 0, len(s.tags)+1)
+	tags = append(tags, types.MessageTag{
+		Name:  aws.String("template"),
+		Value: aws.String(string(templateName)),
+	})
+	for _, k := range slices.Sorted(maps.Keys(s.tags)) {
+		tags = append(tags, types.MessageTag{Name: aws.String(k), Value: aws.String(s.tags[k])})
+	}
+
+	var configurationSet *string
+	if s.configurationSet != "" {
+		configurationSet = aws.String(s.configurationSet)
+	}
+
+	if _, err := s.client.SendEmail(ctx, &sesv2.SendEmailInput{ //nolint:exhaustruct
+		FromEmailAddress: aws.String(s.from),
+		Destination: &types.Destination{ //nolint:exhaustruct
+			ToAddresses: []string{to},
+		},
+		Content: &types.EmailContent{ //nolint:exhaustruct
+			Simple: &types.Message{ //nolint:exhaustruct
+				Subject: &types.Content{ //nolint:exhaustruct
+					Data:    aws.String(strings.TrimSpace(subject)),
+					Charset: aws.String("UTF-8"),
+				},
+				Body: &types.Body{ //nolint:exhaustruct
+					Html: &types.Content{ //nolint:exhaustruct
+						Data:    aws.String(body),
+						Charset: aws.String("UTF-8"),
+					},
+				},
+			},
+		},
+		ConfigurationSetName: configurationSet,
+		EmailTags:            tags,
+	}); err != nil {
+		return fmt.Errorf(
+			"ses: failed to send email with template `%s`: %w", templateName, mapError(err),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package ses_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/ses"
+)
+
+type fakeClient struct {
+	input *sesv2.SendEmailInput
+	err   error
+}
+
+func (f *fakeClient) SendEmail(
+	_ context.Context, params *sesv2.SendEmailInput, _ ...func(*sesv2.Options),
+) (*sesv2.SendEmailOutput, error) {
+	f.input = params
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sesv2.SendEmailOutput{}, nil //nolint:exhaustruct
+}
+
+func TestSendEmail(t *testing.T) {
+	t.Parallel()
+
+	templates, err := notifications.NewTemplatesFromFilesystem(
+		"../../../email-templates/", "en", slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		name             string
+		configurationSet string
+		clientErr        error
+		expectedErr      error
+	}{
+		{
+			name:             "success",
+			configurationSet: "transactional",
+			clientErr:        nil,
+			expectedErr:      nil,
+		},
+		{
+			name:             "success without configuration set",
+			configurationSet: "",
+			clientErr:        nil,
+			expectedErr:      nil,
+		},
+		{
+			name:             "throttled",
+			configurationSet: "",
+			clientErr:        &types.TooManyRequestsException{}, //nolint:exhaustruct
+			expectedErr:      notifications.ErrProviderRateLimited,
+		},
+		{
+			name:             "rejected",
+			configurationSet: "",
+			clientErr:        &types.MessageRejected{}, //nolint:exhaustruct
+			expectedErr:      notifications.ErrProviderRejected,
+		},
+		{
+			name:             "sending paused",
+			configurationSet: "",
+			clientErr:        &types.SendingPausedException{}, //nolint:exhaustruct
+			expectedErr:      notifications.ErrProviderUnauthorized,
+		},
+		{
+			name:             "unknown error",
+			configurationSet: "",
+			clientErr:        errors.New("connection reset"), //nolint:err113
+			expectedErr:      notifications.ErrProviderUnavailable,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeClient{input: nil, err: tc.clientErr}
+			s := ses.New(
+				client, "admin@example.com", tc.configurationSet,
+				map[string]string{"env": "test"}, templates,
+			)
+
+			err := s.SendEmail(
+				t.Context(),
+				"user@example.com",
+				"en",
+				notifications.TemplateNameSigninOTP,
+				notifications.TemplateData{ //nolint:exhaustruct
+					Ticket:     "123456",
+					RedirectTo: "https://example.com",
+				},
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			input := client.input
+			if !strings.Contains(aws.ToString(input.Content.Simple.Body.Html.Data), "123456") {
+				t.Errorf("expected the rendered body to contain the ticket")
+			}
+
+			tags := make(map[string]string, len(input.EmailTags))
+			for _, tag := range input.EmailTags {
+				tags[aws.ToString(tag.Name)] = aws.ToString(tag.Value)
+			}
+
+			if diff := cmp.Diff(
+				[]any{
+					"admin@example.com",
+					[]string{"user@example.com"},
+					"One-time password for https://example.com",
+					tc.configurationSet,
+					map[string]string{"template": "signin-otp", "env": "test"},
+				},
+				[]any{
+					aws.ToString(input.FromEmailAddress),
+					input.Destination.ToAddresses,
+					aws.ToString(input.Content.Simple.Subject.Data),
+					aws.ToString(input.ConfigurationSetName),
+					tags,
+				},
+			); diff != "" {
+				t.Errorf("unexpected input (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package sms
+
+import (
+	"log/slog"
+)
+
+// Dev doesn't send any SMS, it logs them instead so the codes can be read
+// when developing locally. It must not be used in production.
+type Dev struct {
+	logger *slog.Logger
+}
+
+func NewDev(logger *slog.Logger) *Dev {
+	return &Dev{
+		logger: logger,
+	}
+}
+
+func (d *Dev) SendSMS(to string, body string) error {
+	d.logger.Info("sms not sent, dev provider in use", //nolint:gosec
+		slog.String("to", to),
+		slog.String("body", body),
+	)
+	return nil
+}
@@ -2,4 +2,7 @@ package sms
 
 import "errors"
 
-var ErrInvalidOTP = errors.New("invalid OTP")
+var (
+	ErrInvalidOTP         = errors.New("invalid OTP")
+	ErrUnexpectedResponse = errors.New("unexpected response")
+)
@@ -0,0 +1,35 @@
+package sms
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const httpTimeout = 10 * time.Second
+
+func newHTTPClient() *http.Client {
+	return &http.Client{ //nolint:exhaustruct
+		Timeout: httpTimeout,
+	}
+}
+
+func do(cl *http.Client, req *http.Request, expectedStatus int) ([]byte, error) {
+	resp, err := cl.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("%w: %s: %s", ErrUnexpectedResponse, resp.Status, string(b))
+	}
+
+	return b, nil
+}
@@ -0,0 +1,56 @@
+package sms
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const MessageBirdURL = "https://rest.messagebird.com/messages"
+
+type MessageBird struct {
+	url        string
+	accessKey  string
+	originator string
+	cl         *http.Client
+}
+
+func NewMessageBird(baseURL string, accessKey string, originator string) *MessageBird {
+	return &MessageBird{
+		url:        baseURL,
+		accessKey:  accessKey,
+		originator: originator,
+		cl:         newHTTPClient(),
+	}
+}
+
+type messageBirdRequest struct {
+	Originator string   `json:"originator"`
+	Recipients []string `json:"recipients"`
+	Body       string   `json:"body"`
+}
+
+func (m *MessageBird) SendSMS(to string, body string) error {
+	b, err := json.Marshal(messageBirdRequest{
+		Originator: m.originator,
+		Recipients: []string{to},
+		Body:       body,
+	})
+	if err != nil {
+		return fmt.Errorf("messagebird: failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, m.url, bytes.NewReader(b)) //nolint:noctx
+	if err != nil {
+		return fmt.Errorf("messagebird: failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "AccessKey "+m.accessKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := do(m.cl, req, http.StatusCreated); err != nil {
+		return fmt.Errorf("messagebird: %w", err)
+	}
+
+	return nil
+}
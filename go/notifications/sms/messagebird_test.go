@@ -0,0 +1,58 @@
+package sms_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications/sms"
+)
+
+func TestMessageBirdSendSMS(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      int
+		expectedErr bool
+	}{
+		{
+			name:        "success",
+			status:      http.StatusCreated,
+			expectedErr: false,
+		},
+		{
+			name:        "error response",
+			status:      http.StatusUnauthorized,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, captured := newStub(t, tc.status, "{}", "Authorization", "Content-Type")
+
+			backend := sms.NewMessageBird(srv.URL+"/messages", "access-key", "Acme")
+
+			err := backend.SendSMS("+14155552671", "Your code is 123456")
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			expected := &capturedRequest{
+				Method: http.MethodPost,
+				Path:   "/messages",
+				Headers: map[string]string{
+					"Authorization": "AccessKey access-key",
+					"Content-Type":  "application/json",
+				},
+				Body: `{"originator":"Acme","recipients":["+14155552671"],"body":"Your code is 123456"}`,
+			}
+			if diff := cmp.Diff(expected, captured); diff != "" {
+				t.Errorf("unexpected request (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+type SNSClient interface {
+	Publish(
+		ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options),
+	) (*sns.PublishOutput, error)
+}
+
+type SNS struct {
+	client   SNSClient
+	senderID string
+}
+
+func NewSNS(client SNSClient, senderID string) *SNS {
+	return &SNS{
+		client:   client,
+		senderID: senderID,
+	}
+}
+
+func (s *SNS) SendSMS(to string, body string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), httpTimeout)
+	defer cancel()
+
+	attributes := map[string]types.MessageAttributeValue{
+		"AWS.SNS.SMS.SMSType": { //nolint:exhaustruct
+			DataType:    aws.String("String"),
+			StringValue: aws.String("Transactional"),
+		},
+	}
+	if s.senderID != "" {
+		attributes["AWS.SNS.SMS.SenderID"] = types.MessageAttributeValue{ //nolint:exhaustruct
+			DataType:    aws.String("String"),
+			StringValue: aws.String(s.senderID),
+		}
+	}
+
+	if _, err := s.client.Publish(ctx, &sns.PublishInput{ //nolint:exhaustruct
+		PhoneNumber:       aws.String(to),
+		Message:           aws.String(body),
+		MessageAttributes: attributes,
+	}); err != nil {
+		return fmt.Errorf("sns: failed to publish message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package sms_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications/sms"
+)
+
+const snsPublishResponse = `<PublishResponse xmlns="https://sns.amazonaws.com/doc/2010-03-31/">
+  <PublishResult>
+    <MessageId>94f20ce6-13c5-43a0-9a9e-ca52d816e90b</MessageId>
+  </PublishResult>
+</PublishResponse>`
+
+func TestSNSSendSMS(t *testing.T) {
+	t.Parallel()
+
+	srv, captured := newStub(t, http.StatusOK, snsPublishResponse)
+
+	client := awssns.New(awssns.Options{ //nolint:exhaustruct
+		Region:       "eu-central-1",
+		BaseEndpoint: aws.String(srv.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	})
+
+	backend := sms.NewSNS(client, "Acme")
+	if err := backend.SendSMS("+14155552671", "Your code is 123456"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	form, err := url.ParseQuery(captured.Body)
+	if err != nil {
+		t.Fatalf("failed to parse body: %v", err)
+	}
+
+	expected := url.Values{
+		"Action":                         []string{"Publish"},
+		"Version":                        []string{"2010-03-31"},
+		"PhoneNumber":                    []string{"+14155552671"},
+		"Message":                        []string{"Your code is 123456"},
+		"MessageAttributes.entry.1.Name": []string{"AWS.SNS.SMS.SMSType"},
+		"MessageAttributes.entry.1.Value.DataType":    []string{"String"},
+		"MessageAttributes.entry.1.Value.StringValue": []string{"Transactional"},
+		"MessageAttributes.entry.2.Name":              []string{"AWS.SNS.SMS.SenderID"},
+		"MessageAttributes.entry.2.Value.DataType":    []string{"String"},
+		"MessageAttributes.entry.2.Value.StringValue": []string{"Acme"},
+	}
+	if diff := cmp.Diff(expected, form); diff != "" {
+		t.Errorf("unexpected body (-want +got):\n%s", diff)
+	}
+}
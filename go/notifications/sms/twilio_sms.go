@@ -20,15 +20,8 @@ func NewTwilioSMS(
 	accountSid string, authToken string, messageServiceSid string,
 	db DB,
 ) *SMS {
-	client := twilio.NewRestClientWithParams(twilio.ClientParams{ //nolint:exhaustruct
-		Username: accountSid,
-		Password: authToken,
-	})
 	return NewSMS(
-		&TwilioSMS{
-			client: client,
-			from:   messageServiceSid,
-		},
+		NewTwilioSMSProvider(accountSid, authToken, messageServiceSid),
 		otpGenerator,
 		otpHasher,
 		templates,
@@ -36,6 +29,18 @@ func NewTwilioSMS(
 	)
 }
 
+// NewTwilioSMSProvider creates a generic SMS provider that sends messages
+// with Twilio's messaging API.
+func NewTwilioSMSProvider(accountSid string, authToken string, messageServiceSid string) *TwilioSMS {
+	return &TwilioSMS{
+		client: twilio.NewRestClientWithParams(twilio.ClientParams{ //nolint:exhaustruct
+			Username: accountSid,
+			Password: authToken,
+		}),
+		from: messageServiceSid,
+	}
+}
+
 func (s *TwilioSMS) SendSMS(to string, body string) error {
 	if _, err := s.client.Api.CreateMessage(&twilioApi.CreateMessageParams{ //nolint:exhaustruct
 		To:   &to,
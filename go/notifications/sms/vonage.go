@@ -0,0 +1,74 @@
+package sms
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const VonageURL = "https://rest.nexmo.com/sms/json"
+
+type Vonage struct {
+	url       string
+	apiKey    string
+	apiSecret string
+	from      string
+	cl        *http.Client
+}
+
+func NewVonage(baseURL string, apiKey string, apiSecret string, from string) *Vonage {
+	return &Vonage{
+		url:       baseURL,
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		from:      from,
+		cl:        newHTTPClient(),
+	}
+}
+
+type vonageResponse struct {
+	Messages []struct {
+		Status    string `json:"status"`
+		ErrorText string `json:"error-text"` //nolint:tagliatelle
+	} `json:"messages"`
+}
+
+func (v *Vonage) SendSMS(to string, body string) error {
+	form := url.Values{
+		"api_key":    []string{v.apiKey},
+		"api_secret": []string{v.apiSecret},
+		"from":       []string{v.from},
+		"to":         []string{strings.TrimPrefix(to, "+")},
+		"text":       []string{body},
+	}
+
+	req, err := http.NewRequest( //nolint:noctx
+		http.MethodPost, v.url, strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return fmt.Errorf("vonage: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	b, err := do(v.cl, req, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("vonage: %w", err)
+	}
+
+	var resp vonageResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return fmt.Errorf("vonage: failed to unmarshal response: %w", err)
+	}
+
+	for _, m := range resp.Messages {
+		if m.Status != "0" {
+			return fmt.Errorf(
+				"vonage: %w: status %s: %s", ErrUnexpectedResponse, m.Status, m.ErrorText,
+			)
+		}
+	}
+
+	return nil
+}
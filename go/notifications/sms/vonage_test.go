@@ -0,0 +1,75 @@
+package sms_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications/sms"
+)
+
+func TestVonageSendSMS(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      int
+		response    string
+		expectedErr bool
+	}{
+		{
+			name:        "success",
+			status:      http.StatusOK,
+			response:    `{"message-count":"1","messages":[{"status":"0"}]}`,
+			expectedErr: false,
+		},
+		{
+			name:        "rejected",
+			status:      http.StatusOK,
+			response:    `{"message-count":"1","messages":[{"status":"4","error-text":"Bad Credentials"}]}`,
+			expectedErr: true,
+		},
+		{
+			name:        "error response",
+			status:      http.StatusInternalServerError,
+			response:    "",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, captured := newStub(t, tc.status, tc.response, "Content-Type")
+
+			backend := sms.NewVonage(srv.URL+"/sms/json", "key", "secret", "Acme")
+
+			err := backend.SendSMS("+14155552671", "Your code is 123456")
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			form, err := url.ParseQuery(captured.Body)
+			if err != nil {
+				t.Fatalf("failed to parse body: %v", err)
+			}
+
+			expected := url.Values{
+				"api_key":    []string{"key"},
+				"api_secret": []string{"secret"},
+				"from":       []string{"Acme"},
+				"to":         []string{"14155552671"},
+				"text":       []string{"Your code is 123456"},
+			}
+			if diff := cmp.Diff(expected, form); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+
+			if captured.Headers["Content-Type"] != "application/x-www-form-urlencoded" {
+				t.Errorf("unexpected content type: %s", captured.Headers["Content-Type"])
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package sms
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+)
+
+// DefaultWebhookBodyTemplate is the body sent by the webhook backend when no
+// template is configured.
+const DefaultWebhookBodyTemplate = `{"to":{{ json .To }},"body":{{ json .Body }}}`
+
+type WebhookData struct {
+	To   string
+	Body string
+}
+
+// Webhook sends SMS by making an HTTP POST request to a URL. The request body
+// is rendered from a text/template with WebhookData, the `json` function can
+// be used to quote values.
+type Webhook struct {
+	url      string
+	headers  http.Header
+	template *template.Template
+	cl       *http.Client
+}
+
+// NewWebhook creates a webhook backend. Headers are given in `Name: value`
+// format and are sent with every request.
+func NewWebhook(url string, headers []string, bodyTemplate string) (*Webhook, error) {
+	if bodyTemplate == "" {
+		bodyTemplate = DefaultWebhookBodyTemplate
+	}
+
+	tmpl, err := template.New("sms").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(bodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse webhook body template: %w", err)
+	}
+
+	h := http.Header{}
+	h.Set("Content-Type", "application/json")
+	for _, header := range headers {
+		name, value, ok := strings.Cut(header, ":")
+		if !ok {
+			return nil, fmt.Errorf( //nolint:err113
+				"invalid webhook header %q, expected `Name: value`", header,
+			)
+		}
+		h.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	return &Webhook{
+		url:      url,
+		headers:  h,
+		template: tmpl,
+		cl:       newHTTPClient(),
+	}, nil
+}
+
+func (w *Webhook) SendSMS(to string, body string) error {
+	var buf bytes.Buffer
+	if err := w.template.Execute(&buf, WebhookData{To: to, Body: body}); err != nil {
+		return fmt.Errorf("webhook: failed to render body: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.url, &buf) //nolint:noctx
+	if err != nil {
+		return fmt.Errorf("webhook: failed to create request: %w", err)
+	}
+	req.Header = w.headers.Clone()
+
+	resp, err := w.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: %w: %s", ErrUnexpectedResponse, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package sms_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications/sms"
+)
+
+type capturedRequest struct {
+	Method  string
+	Path    string
+	Headers map[string]string
+	Body    string
+}
+
+func newStub(
+	t *testing.T, status int, response string, headers ...string,
+) (*httptest.Server, *capturedRequest) {
+	t.Helper()
+
+	captured := &capturedRequest{} //nolint:exhaustruct
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read body: %v", err)
+		}
+
+		captured.Method = r.Method
+		captured.Path = r.URL.Path
+		captured.Body = string(b)
+		captured.Headers = make(map[string]string, len(headers))
+		for _, h := range headers {
+			captured.Headers[h] = r.Header.Get(h)
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, captured
+}
+
+func TestWebhookSendSMS(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		headers         []string
+		bodyTemplate    string
+		status          int
+		expectedRequest *capturedRequest
+		expectedErr     bool
+	}{
+		{
+			name:         "default template",
+			headers:      []string{"Authorization: Bearer secret"},
+			bodyTemplate: "",
+			status:       http.StatusOK,
+			expectedRequest: &capturedRequest{
+				Method: http.MethodPost,
+				Path:   "/sms",
+				Headers: map[string]string{
+					"Authorization": "Bearer secret",
+					"Content-Type":  "application/json",
+				},
+				Body: `{"to":"+14155552671","body":"Your code is \"123456\""}`,
+			},
+			expectedErr: false,
+		},
+		{
+			name:         "custom template",
+			headers:      []string{"Content-Type: text/plain"},
+			bodyTemplate: "{{ .To }}: {{ .Body }}",
+			status:       http.StatusAccepted,
+			expectedRequest: &capturedRequest{
+				Method: http.MethodPost,
+				Path:   "/sms",
+				Headers: map[string]string{
+					"Authorization": "",
+					"Content-Type":  "text/plain",
+				},
+				Body: `+14155552671: Your code is "123456"`,
+			},
+			expectedErr: false,
+		},
+		{
+			name:         "error response",
+			headers:      []string{},
+			bodyTemplate: "",
+			status:       http.StatusInternalServerError,
+			expectedRequest: &capturedRequest{
+				Method: http.MethodPost,
+				Path:   "/sms",
+				Headers: map[string]string{
+					"Authorization": "",
+					"Content-Type":  "application/json",
+				},
+				Body: `{"to":"+14155552671","body":"Your code is \"123456\""}`,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, captured := newStub(t, tc.status, "", "Authorization", "Content-Type")
+
+			backend, err := sms.NewWebhook(srv.URL+"/sms", tc.headers, tc.bodyTemplate)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			err = backend.SendSMS("+14155552671", `Your code is "123456"`)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedRequest, captured); diff != "" {
+				t.Errorf("unexpected request (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewWebhookInvalidHeader(t *testing.T) {
+	t.Parallel()
+
+	if _, err := sms.NewWebhook("http://localhost", []string{"invalid"}, ""); err == nil {
+		t.Error("expected error")
+	}
+}
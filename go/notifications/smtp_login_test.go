@@ -37,6 +37,7 @@ func TestSMTPAuthLogin(t *testing.T) {
 					"x-something": "asd",
 				},
 				nil,
+				nil,
 			)
 			headers := map[string]string{
 				"x-another": "qwe",
@@ -7,9 +7,9 @@ package notifications
 import (
 	"crypto/tls"
 	"errors"
-	"fmt"
 	"net"
 	"net/smtp"
+	"strconv"
 	"strings"
 )
 
@@ -40,7 +40,7 @@ func sendMail( //nolint:funlen,cyclop
 		}
 	}
 
-	addr := fmt.Sprintf("%s:%d", host, port)
+	addr := net.JoinHostPort(host, strconv.Itoa(int(port)))
 	var conn net.Conn
 	var err error
 	if useTLSConnection {
@@ -1,12 +1,14 @@
 package notifications
 
 import (
-	"errors"
 	"fmt"
+	"html"
+	htmltemplate "html/template"
 	"log/slog"
 	"maps"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/valyala/fasttemplate"
 )
@@ -19,10 +21,33 @@ const (
 	TemplateNameSigninPasswordless TemplateName = "signin-passwordless"
 	TemplateNameSigninOTP          TemplateName = "signin-otp"
 	TemplateNamePasswordReset      TemplateName = "password-reset"
+	TemplateNameUserInvite         TemplateName = "user-invite"
+	TemplateNamePasswordChanged    TemplateName = "password-changed"
+	TemplateNameEmailChanged       TemplateName = "email-changed"
+	TemplateNameMfaEnabled         TemplateName = "mfa-enabled"
+	TemplateNameMfaDisabled        TemplateName = "mfa-disabled"
+	TemplateNameSecurityKeyAdded   TemplateName = "security-key-added"
+	TemplateNamePATCreated         TemplateName = "pat-created"
+	TemplateNameSigninNewDevice    TemplateName = "signin-new-device"
 )
 
+// SecurityNotifications are the emails that can be sent to let users know
+// something security-sensitive happened on their account.
+func SecurityNotifications() []TemplateName {
+	return []TemplateName{
+		TemplateNamePasswordChanged,
+		TemplateNameEmailChanged,
+		TemplateNameMfaEnabled,
+		TemplateNameMfaDisabled,
+		TemplateNameSecurityKeyAdded,
+		TemplateNamePATCreated,
+		TemplateNameSigninNewDevice,
+	}
+}
+
 type Templates struct {
 	templates     map[string]*fasttemplate.Template
+	goTemplates   map[string]goTemplate
 	defaultLocale string
 	logger        *slog.Logger
 }
@@ -31,13 +56,36 @@ func NewTemplatesFromFilesystem(
 	basePath string,
 	defaultLocale string,
 	logger *slog.Logger,
+) (*Templates, error) {
+	return NewTemplatesFromFilesystemWithEngine(
+		basePath, defaultLocale, TemplateEngineFasttemplate, logger,
+	)
+}
+
+// NewTemplatesFromFilesystemWithEngine loads the templates in basePath. With
+// TemplateEngineGo, templates using Go template syntax can use the layouts and
+// partials defined in the _layouts and _partials directories.
+func NewTemplatesFromFilesystemWithEngine( //nolint:cyclop,funlen
+	basePath string,
+	defaultLocale string,
+	engine TemplateEngine,
+	logger *slog.Logger,
 ) (*Templates, error) {
 	basePath, err := filepath.EvalSymlinks(basePath)
 	if err != nil {
 		return nil, fmt.Errorf("error resolving symlinks in base path: %w", err)
 	}
 
+	var shared *htmltemplate.Template
+	if engine == TemplateEngineGo {
+		shared, err = parseSharedTemplates(basePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	templates := make(map[string]*fasttemplate.Template)
+	goTemplates := make(map[string]goTemplate)
 	if err := filepath.Walk(basePath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -49,12 +97,24 @@ func NewTemplatesFromFilesystem(
 				return fmt.Errorf("error getting relative path: %w", err)
 			}
 
+			if isSharedTemplateDir(relativePath) {
+				return nil
+			}
+
 			if info.Name() == "body.html" || info.Name() == "body.txt" || info.Name() == "subject.txt" {
 				f, err := os.ReadFile(path)
 				if err != nil {
 					return fmt.Errorf("error reading file: %w", err)
 				}
 				templates[relativePath] = fasttemplate.New(string(f), "${", "}")
+
+				if shared != nil && strings.Contains(string(f), "{{") {
+					t, err := parseGoTemplate(shared, relativePath, string(f))
+					if err != nil {
+						return err
+					}
+					goTemplates[relativePath] = t
+				}
 			}
 		}
 
@@ -65,6 +125,7 @@ func NewTemplatesFromFilesystem(
 
 	return &Templates{
 		templates:     templates,
+		goTemplates:   goTemplates,
 		defaultLocale: defaultLocale,
 		logger:        logger,
 	}, nil
@@ -95,7 +156,7 @@ func (t *Templates) GetTemplate(
 }
 
 func (t *Templates) GetTemplateSMS(locale string) (*fasttemplate.Template, error) {
-	path := filepath.Join(locale, "signin-passwordless-sms", "body.txt")
+	path := filepath.Join(locale, templateNameSMS, "body.txt")
 	template, ok := t.templates[path]
 	if !ok {
 		return nil, ErrTemplateNotFound
@@ -113,6 +174,8 @@ type TemplateData struct {
 	Locale      string
 	ServerURL   string
 	ClientURL   string
+	IPAddress   string
+	UserAgent   string
 }
 
 func (data TemplateData) ToMap(extra map[string]any) map[string]any {
@@ -126,6 +189,8 @@ func (data TemplateData) ToMap(extra map[string]any) map[string]any {
 		"locale":      data.Locale,
 		"serverUrl":   data.ServerURL,
 		"clientUrl":   data.ClientURL,
+		"ipAddress":   data.IPAddress,
+		"userAgent":   data.UserAgent,
 	}
 
 	maps.Copy(m, extra)
@@ -138,24 +203,70 @@ func (t *Templates) Render(
 	templateName TemplateName,
 	data TemplateData,
 ) (string, string, error) {
-	bodyTemplate, subjectTemplate, err := t.GetTemplate(templateName, locale)
-	if errors.Is(err, ErrTemplateNotFound) {
-		t.logger.Warn("template not found, falling back to default locale",
+	resolved, err := t.resolveLocale(locale, string(templateName))
+	if err != nil {
+		return "", "", fmt.Errorf("error getting email template: %w", err)
+	}
+	if resolved != locale {
+		t.logger.Warn("template not found, falling back to another locale",
 			slog.String("template", string(templateName)),
-			slog.String("locale", locale))
-		locale = t.defaultLocale
-		bodyTemplate, subjectTemplate, err = t.GetTemplate("email-verify", locale)
+			slog.String("locale", locale),
+			slog.String("fallback", resolved))
+		locale = resolved
 	}
+
+	data.Locale = locale
+	m := data.ToMap(nil)
+
+	body, err := t.execute(
+		filepath.Join(locale, string(templateName), "body.html"), data, htmlEscapeValues(m),
+	)
 	if err != nil {
-		return "", "", fmt.Errorf("error getting email template: %w", err)
+		return "", "", err
+	}
+
+	subject, err := t.execute(filepath.Join(locale, string(templateName), "subject.txt"), data, m)
+	if err != nil {
+		return "", "", err
 	}
 
-	m := data.ToMap(map[string]any{"locale": locale})
-	body := bodyTemplate.ExecuteString(m)
-	subject := subjectTemplate.ExecuteString(m)
 	return body, subject, nil
 }
 
+// htmlEscapeValues escapes the values rendered in `${var}` HTML bodies as they
+// can come from users, html/template does the same for the Go template engine.
+// Plain text subjects are rendered with the raw values.
+func htmlEscapeValues(m map[string]any) map[string]any {
+	escaped := make(map[string]any, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			v = html.EscapeString(s)
+		}
+		escaped[k] = v
+	}
+
+	return escaped
+}
+
+// execute renders the template at path with the Go template engine if it was
+// parsed with it and as a `${var}` template otherwise.
+func (t *Templates) execute(path string, data any, m map[string]any) (string, error) {
+	if tmpl, ok := t.goTemplates[path]; ok {
+		var b strings.Builder
+		if err := tmpl.ExecuteTemplate(&b, path, data); err != nil {
+			return "", fmt.Errorf("error rendering template %s: %w", path, err)
+		}
+		return b.String(), nil
+	}
+
+	tmpl, ok := t.templates[path]
+	if !ok {
+		return "", ErrTemplateNotFound
+	}
+
+	return tmpl.ExecuteString(m), nil
+}
+
 type TemplateSMSData struct {
 	Code string
 }
@@ -170,18 +281,16 @@ func (t *Templates) RenderSMS(
 	locale string,
 	data TemplateSMSData,
 ) (string, error) {
-	bodyTemplate, err := t.GetTemplateSMS(locale)
-	if errors.Is(err, ErrTemplateNotFound) {
-		t.logger.Warn("signin-passwordless-sms template not found, falling back to default locale",
-			slog.String("locale", locale))
-		locale = t.defaultLocale
-		bodyTemplate, err = t.GetTemplateSMS(locale)
-	}
+	resolved, err := t.resolveLocale(locale, templateNameSMS)
 	if err != nil {
 		return "", fmt.Errorf("error getting email template: %w", err)
 	}
+	if resolved != locale {
+		t.logger.Warn("signin-passwordless-sms template not found, falling back to another locale",
+			slog.String("locale", locale),
+			slog.String("fallback", resolved))
+		locale = resolved
+	}
 
-	m := data.ToMap()
-	body := bodyTemplate.ExecuteString(m)
-	return body, nil
+	return t.execute(filepath.Join(locale, templateNameSMS, "body.txt"), data, data.ToMap())
 }
@@ -0,0 +1,88 @@
+package notifications
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+)
+
+type TemplateEngine string
+
+const (
+	// TemplateEngineFasttemplate only substitutes `${var}` placeholders.
+	TemplateEngineFasttemplate TemplateEngine = "fasttemplate"
+	// TemplateEngineGo renders html/template and text/template templates with
+	// shared layouts and partials. Files without `{{` are still rendered as
+	// `${var}` templates so existing ones keep working.
+	TemplateEngineGo TemplateEngine = "go"
+)
+
+const (
+	// layoutsDir and partialsDir hold the files shared by every html template
+	// when using TemplateEngineGo. They are named after their file name, like
+	// with html/template's ParseFiles.
+	layoutsDir  = "_layouts"
+	partialsDir = "_partials"
+)
+
+type goTemplate interface {
+	ExecuteTemplate(wr io.Writer, name string, data any) error
+}
+
+func isSharedTemplateDir(relativePath string) bool {
+	dir := strings.Split(filepath.ToSlash(relativePath), "/")[0]
+	return dir == layoutsDir || dir == partialsDir
+}
+
+func parseSharedTemplates(basePath string) (*htmltemplate.Template, error) {
+	shared := htmltemplate.New("")
+	for _, dir := range []string{layoutsDir, partialsDir} {
+		files, err := filepath.Glob(filepath.Join(basePath, dir, "*.html"))
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s: %w", dir, err)
+		}
+
+		for _, file := range files {
+			b, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("error reading file: %w", err)
+			}
+
+			if _, err := shared.New(filepath.Base(file)).Parse(string(b)); err != nil {
+				return nil, fmt.Errorf("error parsing %s: %w", file, err)
+			}
+		}
+	}
+
+	return shared, nil
+}
+
+// parseGoTemplate parses the contents of body.html with html/template, so
+// values are escaped depending on the context, and of body.txt and
+// subject.txt with text/template.
+func parseGoTemplate(
+	shared *htmltemplate.Template, relativePath string, contents string,
+) (goTemplate, error) {
+	if filepath.Ext(relativePath) != ".html" {
+		t, err := texttemplate.New(relativePath).Parse(contents)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", relativePath, err)
+		}
+		return t, nil
+	}
+
+	t, err := shared.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error cloning shared templates: %w", err)
+	}
+
+	if _, err := t.New(relativePath).Parse(contents); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", relativePath, err)
+	}
+
+	return t, nil
+}
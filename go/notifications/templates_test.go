@@ -24,61 +24,141 @@ func TestGetRawTemplates(t *testing.T) {
 			name: "success",
 			path: "../../email-templates/",
 			expectedTemplates: []string{
+				"bg/email-changed/body.html",
+				"bg/email-changed/subject.txt",
 				"bg/email-confirm-change/body.html",
 				"bg/email-confirm-change/subject.txt",
 				"bg/email-verify/body.html",
 				"bg/email-verify/subject.txt",
+				"bg/mfa-disabled/body.html",
+				"bg/mfa-disabled/subject.txt",
+				"bg/mfa-enabled/body.html",
+				"bg/mfa-enabled/subject.txt",
+				"bg/password-changed/body.html",
+				"bg/password-changed/subject.txt",
 				"bg/password-reset/body.html",
 				"bg/password-reset/subject.txt",
+				"bg/pat-created/body.html",
+				"bg/pat-created/subject.txt",
+				"bg/security-key-added/body.html",
+				"bg/security-key-added/subject.txt",
+				"bg/signin-new-device/body.html",
+				"bg/signin-new-device/subject.txt",
 				"bg/signin-otp/body.html",
 				"bg/signin-otp/subject.txt",
 				"bg/signin-passwordless-sms/body.txt",
 				"bg/signin-passwordless/body.html",
 				"bg/signin-passwordless/subject.txt",
+				"bg/user-invite/body.html",
+				"bg/user-invite/subject.txt",
+				"cs/email-changed/body.html",
+				"cs/email-changed/subject.txt",
 				"cs/email-confirm-change/body.html",
 				"cs/email-confirm-change/subject.txt",
 				"cs/email-verify/body.html",
 				"cs/email-verify/subject.txt",
+				"cs/mfa-disabled/body.html",
+				"cs/mfa-disabled/subject.txt",
+				"cs/mfa-enabled/body.html",
+				"cs/mfa-enabled/subject.txt",
+				"cs/password-changed/body.html",
+				"cs/password-changed/subject.txt",
 				"cs/password-reset/body.html",
 				"cs/password-reset/subject.txt",
+				"cs/pat-created/body.html",
+				"cs/pat-created/subject.txt",
+				"cs/security-key-added/body.html",
+				"cs/security-key-added/subject.txt",
+				"cs/signin-new-device/body.html",
+				"cs/signin-new-device/subject.txt",
 				"cs/signin-otp/body.html",
 				"cs/signin-otp/subject.txt",
 				"cs/signin-passwordless-sms/body.txt",
 				"cs/signin-passwordless/body.html",
 				"cs/signin-passwordless/subject.txt",
+				"cs/user-invite/body.html",
+				"cs/user-invite/subject.txt",
+				"en/email-changed/body.html",
+				"en/email-changed/subject.txt",
 				"en/email-confirm-change/body.html",
 				"en/email-confirm-change/subject.txt",
 				"en/email-verify/body.html",
 				"en/email-verify/subject.txt",
+				"en/mfa-disabled/body.html",
+				"en/mfa-disabled/subject.txt",
+				"en/mfa-enabled/body.html",
+				"en/mfa-enabled/subject.txt",
+				"en/password-changed/body.html",
+				"en/password-changed/subject.txt",
 				"en/password-reset/body.html",
 				"en/password-reset/subject.txt",
+				"en/pat-created/body.html",
+				"en/pat-created/subject.txt",
+				"en/security-key-added/body.html",
+				"en/security-key-added/subject.txt",
+				"en/signin-new-device/body.html",
+				"en/signin-new-device/subject.txt",
 				"en/signin-otp/body.html",
 				"en/signin-otp/subject.txt",
 				"en/signin-passwordless-sms/body.txt",
 				"en/signin-passwordless/body.html",
 				"en/signin-passwordless/subject.txt",
+				"en/user-invite/body.html",
+				"en/user-invite/subject.txt",
+				"es/email-changed/body.html",
+				"es/email-changed/subject.txt",
 				"es/email-confirm-change/body.html",
 				"es/email-confirm-change/subject.txt",
 				"es/email-verify/body.html",
 				"es/email-verify/subject.txt",
+				"es/mfa-disabled/body.html",
+				"es/mfa-disabled/subject.txt",
+				"es/mfa-enabled/body.html",
+				"es/mfa-enabled/subject.txt",
+				"es/password-changed/body.html",
+				"es/password-changed/subject.txt",
 				"es/password-reset/body.html",
 				"es/password-reset/subject.txt",
+				"es/pat-created/body.html",
+				"es/pat-created/subject.txt",
+				"es/security-key-added/body.html",
+				"es/security-key-added/subject.txt",
+				"es/signin-new-device/body.html",
+				"es/signin-new-device/subject.txt",
 				"es/signin-otp/body.html",
 				"es/signin-otp/subject.txt",
 				"es/signin-passwordless-sms/body.txt",
 				"es/signin-passwordless/body.html",
 				"es/signin-passwordless/subject.txt",
+				"es/user-invite/body.html",
+				"es/user-invite/subject.txt",
+				"fr/email-changed/body.html",
+				"fr/email-changed/subject.txt",
 				"fr/email-confirm-change/body.html",
 				"fr/email-confirm-change/subject.txt",
 				"fr/email-verify/body.html",
 				"fr/email-verify/subject.txt",
+				"fr/mfa-disabled/body.html",
+				"fr/mfa-disabled/subject.txt",
+				"fr/mfa-enabled/body.html",
+				"fr/mfa-enabled/subject.txt",
+				"fr/password-changed/body.html",
+				"fr/password-changed/subject.txt",
 				"fr/password-reset/body.html",
 				"fr/password-reset/subject.txt",
+				"fr/pat-created/body.html",
+				"fr/pat-created/subject.txt",
+				"fr/security-key-added/body.html",
+				"fr/security-key-added/subject.txt",
+				"fr/signin-new-device/body.html",
+				"fr/signin-new-device/subject.txt",
 				"fr/signin-otp/body.html",
 				"fr/signin-otp/subject.txt",
 				"fr/signin-passwordless-sms/body.txt",
 				"fr/signin-passwordless/body.html",
 				"fr/signin-passwordless/subject.txt",
+				"fr/user-invite/body.html",
+				"fr/user-invite/subject.txt",
 				"test/email-verify/body.html",
 				"test/email-verify/subject.txt",
 			},
@@ -146,6 +226,23 @@ func TestRenderEmailVerify(t *testing.T) {
 			expectedBody:    "http://link.test,\nJane Doe,\njane@doe.com,\nemail-verify:xxxxxxxx,\nhttp://redirect.test,\nhttp://server.test,\nhttp://client.test,\ntest,\n", //nolint:lll
 			expectedSubject: "http://link.test, Jane Doe, jane@doe.com, email-verify:xxxxxxxx, http://redirect.test, http://server.test, http://client.test, test\n",         //nolint:lll
 		},
+		{
+			name: "escaped in the body only",
+			data: notifications.TemplateData{
+				Link:        "http://link.test/?a=1&b=2",
+				DisplayName: "Jane <b>Doe</b>",
+				Email:       "jane@doe.com",
+				NewEmail:    "",
+				Ticket:      "email-verify:xxxxxxxx",
+				RedirectTo:  "http://redirect.test",
+				Locale:      "en",
+				ServerURL:   "http://server.test",
+				ClientURL:   "http://client.test",
+			},
+			locale:          "test",
+			expectedBody:    "http://link.test/?a=1&amp;b=2,\nJane &lt;b&gt;Doe&lt;/b&gt;,\njane@doe.com,\nemail-verify:xxxxxxxx,\nhttp://redirect.test,\nhttp://server.test,\nhttp://client.test,\ntest,\n", //nolint:lll
+			expectedSubject: "http://link.test/?a=1&b=2, Jane <b>Doe</b>, jane@doe.com, email-verify:xxxxxxxx, http://redirect.test, http://server.test, http://client.test, test\n",                         //nolint:lll
+		},
 		{
 			name: "non-existent-locale",
 			data: notifications.TemplateData{
@@ -250,3 +347,101 @@ func TestRenderEmailVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderGoTemplates(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	data := notifications.TemplateData{
+		Link:        "http://link.test/?ticket=a&b",
+		DisplayName: "<b>Jane</b>",
+		Email:       "jane@doe.com",
+		NewEmail:    "",
+		Ticket:      "email-verify:xxxxxxxx",
+		RedirectTo:  "http://redirect.test",
+		Locale:      "",
+		ServerURL:   "http://server.test",
+		ClientURL:   "http://client.test",
+	}
+
+	cases := []struct {
+		name            string
+		data            notifications.TemplateData
+		locale          string
+		expectedBody    string
+		expectedSubject string
+	}{
+		{
+			name:   "layout and partials",
+			data:   data,
+			locale: "en",
+			expectedBody: `<html lang="en">
+  <body>
+    <h1>Hi &lt;b&gt;Jane&lt;/b&gt;,</h1>
+    <a href="http://link.test/?ticket=a&amp;b">Verify your email</a>
+    <p>Sent to jane@doe.com by <a href="http://client.test">http://client.test</a></p>
+  </body>
+</html>`,
+			expectedSubject: "Verify your email, <b>Jane</b>",
+		},
+		{
+			name: "conditional",
+			data: func() notifications.TemplateData {
+				d := data
+				d.DisplayName = ""
+				return d
+			}(),
+			locale: "en",
+			expectedBody: `<html lang="en">
+  <body>
+    <h1>Hi,</h1>
+    <a href="http://link.test/?ticket=a&amp;b">Verify your email</a>
+    <p>Sent to jane@doe.com by <a href="http://client.test">http://client.test</a></p>
+  </body>
+</html>`,
+			expectedSubject: "Verify your email",
+		},
+		{
+			name:            "legacy template",
+			data:            data,
+			locale:          "fr",
+			expectedBody:    "<p>Bonjour &lt;b&gt;Jane&lt;/b&gt;, http://link.test/?ticket=a&amp;b</p>",
+			expectedSubject: "Vérifiez votre email",
+		},
+	}
+
+	templates, err := notifications.NewTemplatesFromFilesystemWithEngine(
+		"testdata/go-templates", "en", notifications.TemplateEngineGo, slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, subject, err := templates.Render(
+				tc.locale, notifications.TemplateNameEmailVerify, tc.data,
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedBody, body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.expectedSubject, subject); diff != "" {
+				t.Errorf("unexpected subject (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	sms, err := templates.RenderSMS("en", notifications.TemplateSMSData{Code: "123456"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if sms != "Your code is 123456" {
+		t.Errorf("unexpected sms body: %q", sms)
+	}
+}
@@ -59,6 +59,128 @@ SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: audit_events; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.audit_events (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    event text NOT NULL,
+    ip_address text,
+    user_agent text,
+    trace_id text,
+    metadata jsonb
+);
+
+
+ALTER TABLE auth.audit_events OWNER TO postgres;
+
+--
+-- Name: TABLE audit_events; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.audit_events IS 'Security events of users such as sign-ins, password changes or MFA changes. Rows are kept but anonymized after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: notification_outbox; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.notification_outbox (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    kind text NOT NULL,
+    recipient text NOT NULL,
+    payload jsonb NOT NULL,
+    status text DEFAULT 'pending'::text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+    sent_at timestamp with time zone,
+    last_error text,
+    expires_at timestamp with time zone
+);
+
+
+ALTER TABLE auth.notification_outbox OWNER TO postgres;
+
+--
+-- Name: TABLE notification_outbox; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.notification_outbox IS 'Emails and SMS pending or already sent to users. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: organization_invitations; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.organization_invitations (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    organization_id uuid NOT NULL,
+    email text NOT NULL,
+    roles text[] DEFAULT '{}'::text[] NOT NULL,
+    invited_by uuid,
+    expires_at timestamp with time zone NOT NULL,
+    accepted_at timestamp with time zone
+);
+
+
+ALTER TABLE auth.organization_invitations OWNER TO postgres;
+
+--
+-- Name: TABLE organization_invitations; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.organization_invitations IS 'Pending and accepted invitations to join organizations. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: organization_members; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.organization_members (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    roles text[] DEFAULT '{}'::text[] NOT NULL
+);
+
+
+ALTER TABLE auth.organization_members OWNER TO postgres;
+
+--
+-- Name: TABLE organization_members; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.organization_members IS 'Membership of users in organizations and their roles within them. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: organizations; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.organizations (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    name text NOT NULL,
+    slug text NOT NULL,
+    metadata jsonb
+);
+
+
+ALTER TABLE auth.organizations OWNER TO postgres;
+
+--
+-- Name: TABLE organizations; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.organizations IS 'Organizations users can be members of. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
 --
 -- Name: provider_requests; Type: TABLE; Schema: auth; Owner: postgres
 --
@@ -119,7 +241,8 @@ CREATE TABLE auth.refresh_tokens (
     user_id uuid NOT NULL,
     metadata jsonb,
     type text DEFAULT 'regular'::text NOT NULL,
-    refresh_token_hash character varying(255)
+    refresh_token_hash character varying(255),
+    organization_id uuid
 );
 
 
@@ -169,6 +292,28 @@ ALTER TABLE auth.schema_migrations OWNER TO postgres;
 COMMENT ON TABLE auth.schema_migrations IS 'Internal table for tracking migrations. Don''t modify its structure as Hasura Auth relies on it to function properly.';
 
 
+--
+-- Name: user_impersonations; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.user_impersonations (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    impersonator text NOT NULL,
+    reason text NOT NULL
+);
+
+
+ALTER TABLE auth.user_impersonations OWNER TO postgres;
+
+--
+-- Name: TABLE user_impersonations; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.user_impersonations IS 'Audit log of admin impersonation sessions. Rows are kept but anonymized after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
 --
 -- Name: user_providers; Type: TABLE; Schema: auth; Owner: postgres
 --
@@ -269,6 +414,7 @@ CREATE TABLE auth.users (
     ticket_expires_at timestamp with time zone DEFAULT now() NOT NULL,
     metadata jsonb,
     webauthn_current_challenge text,
+    otp_hash_attempts integer DEFAULT 0 NOT NULL,
     CONSTRAINT active_mfa_types_check CHECK (((active_mfa_type = 'totp'::text) OR (active_mfa_type = 'sms'::text)))
 );
 
@@ -282,6 +428,89 @@ ALTER TABLE auth.users OWNER TO postgres;
 COMMENT ON TABLE auth.users IS 'User account information. Don''t modify its structure as Hasura Auth relies on it to function properly.';
 
 
+--
+-- Name: webhook_outbox; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.webhook_outbox (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    event text NOT NULL,
+    url text NOT NULL,
+    payload jsonb NOT NULL,
+    status text DEFAULT 'pending'::text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+    delivered_at timestamp with time zone,
+    last_error text
+);
+
+
+ALTER TABLE auth.webhook_outbox OWNER TO postgres;
+
+--
+-- Name: TABLE webhook_outbox; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.webhook_outbox IS 'Outbound webhook deliveries pending or already sent to subscribers. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: audit_events audit_events_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.audit_events
+    ADD CONSTRAINT audit_events_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: notification_outbox notification_outbox_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.notification_outbox
+    ADD CONSTRAINT notification_outbox_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organization_invitations organization_invitations_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_invitations
+    ADD CONSTRAINT organization_invitations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organization_members organization_members_organization_id_user_id_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_members
+    ADD CONSTRAINT organization_members_organization_id_user_id_key UNIQUE (organization_id, user_id);
+
+
+--
+-- Name: organization_members organization_members_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_members
+    ADD CONSTRAINT organization_members_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organizations organizations_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organizations
+    ADD CONSTRAINT organizations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organizations organizations_slug_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organizations
+    ADD CONSTRAINT organizations_slug_key UNIQUE (slug);
+
+
 --
 -- Name: provider_requests provider_requests_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
 --
@@ -330,6 +559,14 @@ ALTER TABLE ONLY auth.schema_migrations
     ADD CONSTRAINT schema_migrations_pkey PRIMARY KEY (version);
 
 
+--
+-- Name: user_impersonations user_impersonations_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_impersonations
+    ADD CONSTRAINT user_impersonations_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: user_providers user_providers_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
 --
@@ -402,6 +639,42 @@ ALTER TABLE ONLY auth.users
     ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: webhook_outbox webhook_outbox_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.webhook_outbox
+    ADD CONSTRAINT webhook_outbox_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: audit_events_user_id_created_at_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX audit_events_user_id_created_at_idx ON auth.audit_events USING btree (user_id, created_at DESC);
+
+
+--
+-- Name: notification_outbox_status_next_attempt_at_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX notification_outbox_status_next_attempt_at_idx ON auth.notification_outbox USING btree (status, next_attempt_at);
+
+
+--
+-- Name: organization_invitations_email_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX organization_invitations_email_idx ON auth.organization_invitations USING btree (email);
+
+
+--
+-- Name: organization_members_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX organization_members_user_id_idx ON auth.organization_members USING btree (user_id);
+
+
 --
 -- Name: refresh_tokens_refresh_token_hash_expires_at_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
@@ -409,6 +682,27 @@ ALTER TABLE ONLY auth.users
 CREATE INDEX refresh_tokens_refresh_token_hash_expires_at_user_id_idx ON auth.refresh_tokens USING btree (refresh_token_hash, expires_at, user_id);
 
 
+--
+-- Name: user_impersonations_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX user_impersonations_user_id_idx ON auth.user_impersonations USING btree (user_id);
+
+
+--
+-- Name: users_phone_number_digits_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX users_phone_number_digits_idx ON auth.users USING btree (regexp_replace(phone_number, '[^0-9]'::text, ''::text, 'g'::text)) WHERE (phone_number !~ '^\+[0-9]+$'::text);
+
+
+--
+-- Name: webhook_outbox_status_next_attempt_at_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX webhook_outbox_status_next_attempt_at_idx ON auth.webhook_outbox USING btree (status, next_attempt_at);
+
+
 --
 -- Name: user_providers set_auth_user_providers_updated_at; Type: TRIGGER; Schema: auth; Owner: postgres
 --
@@ -479,6 +773,46 @@ ALTER TABLE ONLY auth.user_security_keys
     ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
+--
+-- Name: organization_invitations organization_invitations_invited_by_fkey; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_invitations
+    ADD CONSTRAINT organization_invitations_invited_by_fkey FOREIGN KEY (invited_by) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+
+--
+-- Name: organization_invitations organization_invitations_organization_id_fkey; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_invitations
+    ADD CONSTRAINT organization_invitations_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES auth.organizations(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: organization_members organization_members_organization_id_fkey; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_members
+    ADD CONSTRAINT organization_members_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES auth.organizations(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: organization_members organization_members_user_id_fkey; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_members
+    ADD CONSTRAINT organization_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: refresh_tokens refresh_tokens_organization_id_fkey; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.refresh_tokens
+    ADD CONSTRAINT refresh_tokens_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES auth.organizations(id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+
 --
 -- Name: refresh_tokens refresh_tokens_types_fkey; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
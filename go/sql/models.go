@@ -9,6 +9,75 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// Security events of users such as sign-ins, password changes or MFA changes. Rows are kept but anonymized after the user is deleted. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthAuditEvent struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	UserID    uuid.UUID
+	Event     string
+	IpAddress pgtype.Text
+	UserAgent pgtype.Text
+	TraceID   pgtype.Text
+	Metadata  []byte
+}
+
+// Pending email and SMS multi-factor authentication challenges. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthMfaChallenge struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	UserID    uuid.UUID
+	Ticket    string
+	MfaType   string
+	OtpHash   string
+	Attempts  int32
+	ExpiresAt pgtype.Timestamptz
+}
+
+// Emails and SMS pending or already sent to users. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthNotificationOutbox struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamptz
+	Kind          string
+	Recipient     string
+	Payload       []byte
+	Status        string
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamptz
+	SentAt        pgtype.Timestamptz
+	LastError     pgtype.Text
+	ExpiresAt     pgtype.Timestamptz
+}
+
+// Organizations users can be members of. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOrganization struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	Name      string
+	Slug      string
+	Metadata  []byte
+}
+
+// Pending and accepted invitations to join organizations. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOrganizationInvitation struct {
+	ID             uuid.UUID
+	CreatedAt      pgtype.Timestamptz
+	OrganizationID uuid.UUID
+	Email          string
+	Roles          []string
+	InvitedBy      pgtype.UUID
+	ExpiresAt      pgtype.Timestamptz
+	AcceptedAt     pgtype.Timestamptz
+}
+
+// Membership of users in organizations and their roles within them. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOrganizationMember struct {
+	ID             uuid.UUID
+	CreatedAt      pgtype.Timestamptz
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Roles          []string
+}
+
 // List of available Oauth providers. Don't modify its structure as Hasura Auth relies on it to function properly.
 type AuthProvider struct {
 	ID string
@@ -29,6 +98,7 @@ type AuthRefreshToken struct {
 	Metadata         []byte
 	Type             RefreshTokenType
 	RefreshTokenHash pgtype.Text
+	OrganizationID   pgtype.UUID
 }
 
 type AuthRefreshTokenType struct {
@@ -74,6 +144,16 @@ type AuthUser struct {
 	TicketExpiresAt          pgtype.Timestamptz
 	Metadata                 []byte
 	WebauthnCurrentChallenge pgtype.Text
+	OtpHashAttempts          int32
+}
+
+// Audit log of admin impersonation sessions. Rows are kept but anonymized after the user is deleted. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthUserImpersonation struct {
+	ID           uuid.UUID
+	CreatedAt    pgtype.Timestamptz
+	UserID       uuid.UUID
+	Impersonator string
+	Reason       string
 }
 
 // Active providers for a given user. Don't modify its structure as Hasura Auth relies on it to function properly.
@@ -106,3 +186,17 @@ type AuthUserSecurityKey struct {
 	Transports          string
 	Nickname            pgtype.Text
 }
+
+// Outbound webhook deliveries pending or already sent to subscribers. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthWebhookOutbox struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamptz
+	Event         string
+	Url           string
+	Payload       []byte
+	Status        string
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamptz
+	DeliveredAt   pgtype.Timestamptz
+	LastError     pgtype.Text
+}
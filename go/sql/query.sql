@@ -10,6 +10,12 @@ WHERE email = $1 LIMIT 1;
 SELECT * FROM auth.users
 WHERE phone_number = $1 LIMIT 1;
 
+-- name: GetUsersByPhoneNumberLegacy :many
+SELECT * FROM auth.users
+WHERE regexp_replace(phone_number, '[^0-9]', '', 'g') = @digits::TEXT
+    AND phone_number !~ '^\+[0-9]+$'
+LIMIT 2;
+
 -- name: GetUserRoles :many
 SELECT * FROM auth.user_roles
 WHERE user_id = $1;
@@ -278,7 +284,7 @@ WITH refreshed_token AS (
         expires_at = $2,
         refresh_token_hash = sqlc.arg(new_refresh_token_hash)
     WHERE refresh_token_hash = sqlc.arg(old_refresh_token_hash)
-    RETURNING id AS refresh_token_id, user_id
+    RETURNING id AS refresh_token_id, user_id, organization_id
 ),
 updated_user AS (
     UPDATE auth.users
@@ -286,7 +292,7 @@ updated_user AS (
     FROM refreshed_token
     WHERE auth.users.id = refreshed_token.user_id
 )
-SELECT refreshed_token.refresh_token_id, role FROM auth.user_roles
+SELECT refreshed_token.refresh_token_id, refreshed_token.organization_id, role FROM auth.user_roles
 RIGHT JOIN refreshed_token ON auth.user_roles.user_id = refreshed_token.user_id;
 
 -- name: UpdateUserLastSeen :one
@@ -387,12 +393,217 @@ WHERE id = $1;
 
 -- name: UpdateUserOTPHash :one
 UPDATE auth.users
-SET (otp_hash, otp_hash_expires_at, otp_method_last_used) = ($2, $3, $4)
+SET (otp_hash, otp_hash_expires_at, otp_method_last_used, otp_hash_attempts) = ($2, $3, $4, 0)
 WHERE id = $1
 RETURNING id;
 
+-- name: UpdateUserPhoneNumber :exec
+UPDATE auth.users
+SET phone_number = $2
+WHERE id = $1;
+
+-- name: IncrementUserOTPHashAttempts :one
+UPDATE auth.users
+SET otp_hash_attempts = otp_hash_attempts + 1
+WHERE id = @id AND otp_hash_attempts < @max_attempts::INT
+RETURNING otp_hash_attempts;
+
 -- name: UpsertRoles :many
 INSERT INTO auth.roles (role)
 SELECT unnest(@roles::TEXT[])
 ON CONFLICT (role) DO NOTHING
 RETURNING role;
+
+-- name: InsertUserImpersonation :one
+INSERT INTO auth.user_impersonations (user_id, impersonator, reason)
+VALUES ($1, $2, $3)
+RETURNING id;
+
+-- name: InsertAuditEvent :exec
+INSERT INTO auth.audit_events (user_id, event, ip_address, user_agent, trace_id, metadata)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetUserAuditEvents :many
+SELECT * FROM auth.audit_events
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: GetUserImpersonations :many
+SELECT * FROM auth.user_impersonations
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: GetUserSignInHistory :one
+SELECT
+    EXISTS (
+        SELECT 1 FROM auth.audit_events
+        WHERE user_id = $1 AND event = 'signin'
+    ) AS has_signed_in,
+    EXISTS (
+        SELECT 1 FROM auth.audit_events
+        WHERE user_id = $1 AND event = 'signin' AND ip_address = $2 AND user_agent = $3
+    ) AS from_known_device;
+
+-- name: InsertWebhookOutbox :exec
+INSERT INTO auth.webhook_outbox (event, url, payload)
+VALUES ($1, $2, $3);
+
+-- name: ClaimWebhookOutbox :many
+UPDATE auth.webhook_outbox
+SET next_attempt_at = @lease_until
+WHERE id IN (
+    SELECT id FROM auth.webhook_outbox
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateWebhookOutboxDelivered :exec
+UPDATE auth.webhook_outbox
+SET status = 'delivered', attempts = attempts + 1, delivered_at = now(), last_error = NULL
+WHERE id = $1;
+
+-- name: UpdateWebhookOutboxFailed :exec
+UPDATE auth.webhook_outbox
+SET status = $2, attempts = attempts + 1, next_attempt_at = $3, last_error = $4
+WHERE id = $1;
+
+-- name: InsertNotificationOutbox :exec
+INSERT INTO auth.notification_outbox (kind, recipient, payload, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: ClaimNotificationOutbox :many
+UPDATE auth.notification_outbox
+SET next_attempt_at = @lease_until
+WHERE id IN (
+    SELECT id FROM auth.notification_outbox
+    WHERE status = 'pending' AND next_attempt_at <= now()
+        AND (expires_at IS NULL OR expires_at > now())
+    ORDER BY next_attempt_at
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: DeleteNotificationOutboxExpired :execrows
+DELETE FROM auth.notification_outbox
+WHERE status = 'pending' AND expires_at <= now();
+
+-- name: UpdateNotificationOutboxSent :exec
+UPDATE auth.notification_outbox
+SET status = 'sent', attempts = attempts + 1, sent_at = now(), payload = '{}', last_error = NULL
+WHERE id = $1;
+
+-- name: UpdateNotificationOutboxFailed :exec
+UPDATE auth.notification_outbox
+SET status = @status, attempts = attempts + 1, next_attempt_at = @next_attempt_at,
+    last_error = @last_error,
+    payload = CASE WHEN @clear_payload::BOOLEAN THEN '{}'::JSONB ELSE payload END
+WHERE id = @id;
+
+-- name: CountNotificationOutboxByStatus :many
+SELECT kind, status, COUNT(*) AS count FROM auth.notification_outbox
+WHERE status <> 'sent'
+GROUP BY kind, status;
+
+-- name: InsertOrganizationWithOwner :one
+WITH inserted_organization AS (
+    INSERT INTO auth.organizations (name, slug, metadata)
+    VALUES ($1, $2, $3)
+    RETURNING id, created_at
+),
+inserted_member AS (
+    INSERT INTO auth.organization_members (organization_id, user_id, roles)
+    SELECT id, @user_id, @roles::TEXT[] FROM inserted_organization
+)
+SELECT id, created_at FROM inserted_organization;
+
+-- name: GetUserOrganizations :many
+SELECT o.id, o.name, o.slug, o.created_at, m.roles
+FROM auth.organization_members m
+JOIN auth.organizations o ON o.id = m.organization_id
+WHERE m.user_id = $1
+ORDER BY o.name;
+
+-- name: GetOrganizationMember :one
+SELECT * FROM auth.organization_members
+WHERE organization_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: InsertOrganizationInvitation :one
+INSERT INTO auth.organization_invitations (organization_id, email, roles, invited_by, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetUserOrganizationInvitations :many
+SELECT i.id, i.organization_id, o.name AS organization_name, i.roles, i.expires_at
+FROM auth.organization_invitations i
+JOIN auth.organizations o ON o.id = i.organization_id
+WHERE i.email = $1 AND i.accepted_at IS NULL AND i.expires_at > now()
+ORDER BY i.created_at DESC;
+
+-- name: AcceptOrganizationInvitation :one
+WITH accepted_invitation AS (
+    UPDATE auth.organization_invitations
+    SET accepted_at = now()
+    WHERE id = @id AND email = @email AND accepted_at IS NULL AND expires_at > now()
+    RETURNING organization_id, roles
+)
+INSERT INTO auth.organization_members (organization_id, user_id, roles)
+SELECT organization_id, @user_id, roles FROM accepted_invitation
+ON CONFLICT (organization_id, user_id) DO UPDATE SET roles = EXCLUDED.roles
+RETURNING organization_id;
+
+-- name: UpdateRefreshTokenOrganization :exec
+UPDATE auth.refresh_tokens
+SET organization_id = $2
+WHERE refresh_token_hash = $1;
+
+-- name: DeleteUser :exec
+WITH anonymized_audit_events AS (
+    UPDATE auth.audit_events
+    SET ip_address = NULL, user_agent = NULL, metadata = NULL
+    WHERE user_id = $1
+), anonymized_impersonations AS (
+    UPDATE auth.user_impersonations
+    SET reason = ''
+    WHERE user_id = $1
+)
+DELETE FROM auth.users
+WHERE id = $1;
+
+-- name: GetUserProviders :many
+SELECT * FROM auth.user_providers
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: GetUserRefreshTokens :many
+SELECT id, created_at, expires_at, type FROM auth.refresh_tokens
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: InsertMfaChallenge :exec
+INSERT INTO auth.mfa_challenges (user_id, ticket, mfa_type, otp_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: CountActiveMfaChallenges :one
+SELECT COUNT(*) FROM auth.mfa_challenges
+WHERE user_id = $1 AND expires_at > now();
+
+-- name: GetMfaChallengeByTicket :one
+UPDATE auth.mfa_challenges
+SET attempts = attempts + 1
+WHERE ticket = @ticket AND expires_at > now() AND attempts < @max_attempts::INT
+RETURNING *;
+
+-- name: DeleteMfaChallenge :exec
+DELETE FROM auth.mfa_challenges
+WHERE id = $1;
+
+-- name: UpdateUserVerifyPhoneNumber :one
+UPDATE auth.users
+SET phone_number_verified = true, otp_hash = NULL, otp_hash_expires_at = now(), otp_hash_attempts = 0
+WHERE id = $1
+RETURNING *;
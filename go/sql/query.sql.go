@@ -12,6 +12,176 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const acceptOrganizationInvitation = `-- name: AcceptOrganizationInvitation :one
+WITH accepted_invitation AS (
+    UPDATE auth.organization_invitations
+    SET accepted_at = now()
+    WHERE id = $1 AND email = $2 AND accepted_at IS NULL AND expires_at > now()
+    RETURNING organization_id, roles
+)
+INSERT INTO auth.organization_members (organization_id, user_id, roles)
+SELECT organization_id, $3, roles FROM accepted_invitation
+ON CONFLICT (organization_id, user_id) DO UPDATE SET roles = EXCLUDED.roles
+RETURNING organization_id
+`
+
+type AcceptOrganizationInvitationParams struct {
+	ID     uuid.UUID
+	Email  string
+	UserID uuid.UUID
+}
+
+func (q *Queries) AcceptOrganizationInvitation(ctx context.Context, arg AcceptOrganizationInvitationParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, acceptOrganizationInvitation, arg.ID, arg.Email, arg.UserID)
+	var organization_id uuid.UUID
+	err := row.Scan(&organization_id)
+	return organization_id, err
+}
+
+const claimNotificationOutbox = `-- name: ClaimNotificationOutbox :many
+UPDATE auth.notification_outbox
+SET next_attempt_at = $1
+WHERE id IN (
+    SELECT id FROM auth.notification_outbox
+    WHERE status = 'pending' AND next_attempt_at <= now()
+        AND (expires_at IS NULL OR expires_at > now())
+    ORDER BY next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, created_at, kind, recipient, payload, status, attempts, next_attempt_at, sent_at, last_error, expires_at
+`
+
+type ClaimNotificationOutboxParams struct {
+	LeaseUntil pgtype.Timestamptz
+	BatchSize  int32
+}
+
+func (q *Queries) ClaimNotificationOutbox(ctx context.Context, arg ClaimNotificationOutboxParams) ([]AuthNotificationOutbox, error) {
+	rows, err := q.db.Query(ctx, claimNotificationOutbox, arg.LeaseUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthNotificationOutbox
+	for rows.Next() {
+		var i AuthNotificationOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Kind,
+			&i.Recipient,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.SentAt,
+			&i.LastError,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimWebhookOutbox = `-- name: ClaimWebhookOutbox :many
+UPDATE auth.webhook_outbox
+SET next_attempt_at = $1
+WHERE id IN (
+    SELECT id FROM auth.webhook_outbox
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, created_at, event, url, payload, status, attempts, next_attempt_at, delivered_at, last_error
+`
+
+type ClaimWebhookOutboxParams struct {
+	LeaseUntil pgtype.Timestamptz
+	BatchSize  int32
+}
+
+func (q *Queries) ClaimWebhookOutbox(ctx context.Context, arg ClaimWebhookOutboxParams) ([]AuthWebhookOutbox, error) {
+	rows, err := q.db.Query(ctx, claimWebhookOutbox, arg.LeaseUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthWebhookOutbox
+	for rows.Next() {
+		var i AuthWebhookOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Event,
+			&i.Url,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.DeliveredAt,
+			&i.LastError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countActiveMfaChallenges = `-- name: CountActiveMfaChallenges :one
+SELECT COUNT(*) FROM auth.mfa_challenges
+WHERE user_id = $1 AND expires_at > now()
+`
+
+func (q *Queries) CountActiveMfaChallenges(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveMfaChallenges, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countNotificationOutboxByStatus = `-- name: CountNotificationOutboxByStatus :many
+SELECT kind, status, COUNT(*) AS count FROM auth.notification_outbox
+WHERE status <> 'sent'
+GROUP BY kind, status
+`
+
+type CountNotificationOutboxByStatusRow struct {
+	Kind   string
+	Status string
+	Count  int64
+}
+
+func (q *Queries) CountNotificationOutboxByStatus(ctx context.Context) ([]CountNotificationOutboxByStatusRow, error) {
+	rows, err := q.db.Query(ctx, countNotificationOutboxByStatus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountNotificationOutboxByStatusRow
+	for rows.Next() {
+		var i CountNotificationOutboxByStatusRow
+		if err := rows.Scan(&i.Kind, &i.Status, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countSecurityKeysUser = `-- name: CountSecurityKeysUser :one
 SELECT COUNT(*) FROM auth.user_security_keys
 WHERE user_id = $1
@@ -24,6 +194,29 @@ func (q *Queries) CountSecurityKeysUser(ctx context.Context, userID uuid.UUID) (
 	return count, err
 }
 
+const deleteMfaChallenge = `-- name: DeleteMfaChallenge :exec
+DELETE FROM auth.mfa_challenges
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMfaChallenge(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteMfaChallenge, id)
+	return err
+}
+
+const deleteNotificationOutboxExpired = `-- name: DeleteNotificationOutboxExpired :execrows
+DELETE FROM auth.notification_outbox
+WHERE status = 'pending' AND expires_at <= now()
+`
+
+func (q *Queries) DeleteNotificationOutboxExpired(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNotificationOutboxExpired)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteRefreshToken = `-- name: DeleteRefreshToken :exec
 DELETE FROM auth.refresh_tokens
 WHERE refresh_token_hash = $1
@@ -44,6 +237,25 @@ func (q *Queries) DeleteRefreshTokens(ctx context.Context, userID uuid.UUID) err
 	return err
 }
 
+const deleteUser = `-- name: DeleteUser :exec
+WITH anonymized_audit_events AS (
+    UPDATE auth.audit_events
+    SET ip_address = NULL, user_agent = NULL, metadata = NULL
+    WHERE user_id = $1
+), anonymized_impersonations AS (
+    UPDATE auth.user_impersonations
+    SET reason = ''
+    WHERE user_id = $1
+)
+DELETE FROM auth.users
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUser, id)
+	return err
+}
+
 const deleteUserRoles = `-- name: DeleteUserRoles :exec
 DELETE FROM auth.user_roles
 WHERE user_id = $1
@@ -80,6 +292,57 @@ func (q *Queries) FindUserProviderByProviderId(ctx context.Context, arg FindUser
 	return i, err
 }
 
+const getMfaChallengeByTicket = `-- name: GetMfaChallengeByTicket :one
+UPDATE auth.mfa_challenges
+SET attempts = attempts + 1
+WHERE ticket = $1 AND expires_at > now() AND attempts < $2::INT
+RETURNING id, created_at, user_id, ticket, mfa_type, otp_hash, attempts, expires_at
+`
+
+type GetMfaChallengeByTicketParams struct {
+	Ticket      string
+	MaxAttempts int32
+}
+
+func (q *Queries) GetMfaChallengeByTicket(ctx context.Context, arg GetMfaChallengeByTicketParams) (AuthMfaChallenge, error) {
+	row := q.db.QueryRow(ctx, getMfaChallengeByTicket, arg.Ticket, arg.MaxAttempts)
+	var i AuthMfaChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UserID,
+		&i.Ticket,
+		&i.MfaType,
+		&i.OtpHash,
+		&i.Attempts,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getOrganizationMember = `-- name: GetOrganizationMember :one
+SELECT id, created_at, organization_id, user_id, roles FROM auth.organization_members
+WHERE organization_id = $1 AND user_id = $2 LIMIT 1
+`
+
+type GetOrganizationMemberParams struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+func (q *Queries) GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (AuthOrganizationMember, error) {
+	row := q.db.QueryRow(ctx, getOrganizationMember, arg.OrganizationID, arg.UserID)
+	var i AuthOrganizationMember
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Roles,
+	)
+	return i, err
+}
+
 const getSecurityKeys = `-- name: GetSecurityKeys :many
 SELECT id, user_id, credential_id, credential_public_key, counter, transports, nickname
 FROM auth.user_security_keys
@@ -115,7 +378,7 @@ func (q *Queries) GetSecurityKeys(ctx context.Context, userID uuid.UUID) ([]Auth
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts FROM auth.users
 WHERE id = $1 LIMIT 1
 `
 
@@ -148,12 +411,55 @@ func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (AuthUser, error) {
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
 
+const getUserAuditEvents = `-- name: GetUserAuditEvents :many
+SELECT id, created_at, user_id, event, ip_address, user_agent, trace_id, metadata FROM auth.audit_events
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetUserAuditEventsParams struct {
+	UserID uuid.UUID
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) GetUserAuditEvents(ctx context.Context, arg GetUserAuditEventsParams) ([]AuthAuditEvent, error) {
+	rows, err := q.db.Query(ctx, getUserAuditEvents, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthAuditEvent
+	for rows.Next() {
+		var i AuthAuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UserID,
+			&i.Event,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.TraceID,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts FROM auth.users
 WHERE email = $1 LIMIT 1
 `
 
@@ -186,6 +492,7 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (AuthUs
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
@@ -194,7 +501,7 @@ const getUserByEmailAndTicket = `-- name: GetUserByEmailAndTicket :one
 UPDATE auth.users
 SET ticket = NULL, ticket_expires_at = now(), email_verified = true
 WHERE email = $1 AND ticket = $2 AND ticket_expires_at > now()
-RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts
 `
 
 type GetUserByEmailAndTicketParams struct {
@@ -231,12 +538,13 @@ func (q *Queries) GetUserByEmailAndTicket(ctx context.Context, arg GetUserByEmai
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
 
 const getUserByPhoneNumber = `-- name: GetUserByPhoneNumber :one
-SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts FROM auth.users
 WHERE phone_number = $1 LIMIT 1
 `
 
@@ -269,6 +577,7 @@ func (q *Queries) GetUserByPhoneNumber(ctx context.Context, phoneNumber pgtype.T
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
@@ -277,7 +586,7 @@ const getUserByPhoneNumberAndOTP = `-- name: GetUserByPhoneNumberAndOTP :one
 UPDATE auth.users
 SET otp_hash = NULL, otp_hash_expires_at = now(), phone_number_verified = true
 WHERE phone_number = $1 AND otp_hash = $2 AND otp_hash_expires_at > now() AND otp_method_last_used = 'sms'
-RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts
 `
 
 type GetUserByPhoneNumberAndOTPParams struct {
@@ -314,6 +623,7 @@ func (q *Queries) GetUserByPhoneNumberAndOTP(ctx context.Context, arg GetUserByP
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
@@ -325,7 +635,7 @@ WITH user_providers AS (
     AND provider_id = $2
     LIMIT 1
 )
-SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts FROM auth.users
 WHERE id = (SELECT user_id FROM user_providers) LIMIT 1
 `
 
@@ -363,6 +673,7 @@ func (q *Queries) GetUserByProviderID(ctx context.Context, arg GetUserByProvider
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
@@ -373,7 +684,7 @@ WITH refresh_token AS (
     WHERE refresh_token_hash = $1 AND type = $2 AND expires_at > now()
     LIMIT 1
 )
-SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts FROM auth.users
 WHERE id = (SELECT user_id FROM refresh_token) LIMIT 1
 `
 
@@ -411,20 +722,21 @@ func (q *Queries) GetUserByRefreshTokenHash(ctx context.Context, arg GetUserByRe
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
 
 const getUserByTicket = `-- name: GetUserByTicket :one
 WITH selected_user AS (
-    SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
+    SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts FROM auth.users
     WHERE ticket = $1  AND ticket_expires_at > now()
     LIMIT 1
 )
 UPDATE auth.users
 SET ticket = NULL, ticket_expires_at = now()
 WHERE id = (SELECT id FROM selected_user)
-RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts
 `
 
 func (q *Queries) GetUserByTicket(ctx context.Context, dollar_1 pgtype.Text) (AuthUser, error) {
@@ -456,10 +768,200 @@ func (q *Queries) GetUserByTicket(ctx context.Context, dollar_1 pgtype.Text) (Au
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
 
+const getUserImpersonations = `-- name: GetUserImpersonations :many
+SELECT id, created_at, user_id, impersonator, reason FROM auth.user_impersonations
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetUserImpersonations(ctx context.Context, userID uuid.UUID) ([]AuthUserImpersonation, error) {
+	rows, err := q.db.Query(ctx, getUserImpersonations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthUserImpersonation
+	for rows.Next() {
+		var i AuthUserImpersonation
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UserID,
+			&i.Impersonator,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserOrganizationInvitations = `-- name: GetUserOrganizationInvitations :many
+SELECT i.id, i.organization_id, o.name AS organization_name, i.roles, i.expires_at
+FROM auth.organization_invitations i
+JOIN auth.organizations o ON o.id = i.organization_id
+WHERE i.email = $1 AND i.accepted_at IS NULL AND i.expires_at > now()
+ORDER BY i.created_at DESC
+`
+
+type GetUserOrganizationInvitationsRow struct {
+	ID               uuid.UUID
+	OrganizationID   uuid.UUID
+	OrganizationName string
+	Roles            []string
+	ExpiresAt        pgtype.Timestamptz
+}
+
+func (q *Queries) GetUserOrganizationInvitations(ctx context.Context, email string) ([]GetUserOrganizationInvitationsRow, error) {
+	rows, err := q.db.Query(ctx, getUserOrganizationInvitations, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserOrganizationInvitationsRow
+	for rows.Next() {
+		var i GetUserOrganizationInvitationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.OrganizationName,
+			&i.Roles,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserOrganizations = `-- name: GetUserOrganizations :many
+SELECT o.id, o.name, o.slug, o.created_at, m.roles
+FROM auth.organization_members m
+JOIN auth.organizations o ON o.id = m.organization_id
+WHERE m.user_id = $1
+ORDER BY o.name
+`
+
+type GetUserOrganizationsRow struct {
+	ID        uuid.UUID
+	Name      string
+	Slug      string
+	CreatedAt pgtype.Timestamptz
+	Roles     []string
+}
+
+func (q *Queries) GetUserOrganizations(ctx context.Context, userID uuid.UUID) ([]GetUserOrganizationsRow, error) {
+	rows, err := q.db.Query(ctx, getUserOrganizations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserOrganizationsRow
+	for rows.Next() {
+		var i GetUserOrganizationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Slug,
+			&i.CreatedAt,
+			&i.Roles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserProviders = `-- name: GetUserProviders :many
+SELECT id, created_at, updated_at, user_id, access_token, refresh_token, provider_id, provider_user_id FROM auth.user_providers
+WHERE user_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) GetUserProviders(ctx context.Context, userID uuid.UUID) ([]AuthUserProvider, error) {
+	rows, err := q.db.Query(ctx, getUserProviders, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthUserProvider
+	for rows.Next() {
+		var i AuthUserProvider
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserID,
+			&i.AccessToken,
+			&i.RefreshToken,
+			&i.ProviderID,
+			&i.ProviderUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserRefreshTokens = `-- name: GetUserRefreshTokens :many
+SELECT id, created_at, expires_at, type FROM auth.refresh_tokens
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+type GetUserRefreshTokensRow struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	ExpiresAt pgtype.Timestamptz
+	Type      RefreshTokenType
+}
+
+func (q *Queries) GetUserRefreshTokens(ctx context.Context, userID uuid.UUID) ([]GetUserRefreshTokensRow, error) {
+	rows, err := q.db.Query(ctx, getUserRefreshTokens, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserRefreshTokensRow
+	for rows.Next() {
+		var i GetUserRefreshTokensRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.Type,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserRoles = `-- name: GetUserRoles :many
 SELECT id, created_at, user_id, role FROM auth.user_roles
 WHERE user_id = $1
@@ -490,6 +992,256 @@ func (q *Queries) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]AuthUse
 	return items, nil
 }
 
+const getUserSignInHistory = `-- name: GetUserSignInHistory :one
+SELECT
+    EXISTS (
+        SELECT 1 FROM auth.audit_events
+        WHERE user_id = $1 AND event = 'signin'
+    ) AS has_signed_in,
+    EXISTS (
+        SELECT 1 FROM auth.audit_events
+        WHERE user_id = $1 AND event = 'signin' AND ip_address = $2 AND user_agent = $3
+    ) AS from_known_device
+`
+
+type GetUserSignInHistoryParams struct {
+	UserID    uuid.UUID
+	IpAddress pgtype.Text
+	UserAgent pgtype.Text
+}
+
+type GetUserSignInHistoryRow struct {
+	HasSignedIn     bool
+	FromKnownDevice bool
+}
+
+func (q *Queries) GetUserSignInHistory(ctx context.Context, arg GetUserSignInHistoryParams) (GetUserSignInHistoryRow, error) {
+	row := q.db.QueryRow(ctx, getUserSignInHistory, arg.UserID, arg.IpAddress, arg.UserAgent)
+	var i GetUserSignInHistoryRow
+	err := row.Scan(&i.HasSignedIn, &i.FromKnownDevice)
+	return i, err
+}
+
+const getUsersByPhoneNumberLegacy = `-- name: GetUsersByPhoneNumberLegacy :many
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts FROM auth.users
+WHERE regexp_replace(phone_number, '[^0-9]', '', 'g') = $1::TEXT
+    AND phone_number !~ '^\+[0-9]+$'
+LIMIT 2
+`
+
+func (q *Queries) GetUsersByPhoneNumberLegacy(ctx context.Context, digits string) ([]AuthUser, error) {
+	rows, err := q.db.Query(ctx, getUsersByPhoneNumberLegacy, digits)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthUser
+	for rows.Next() {
+		var i AuthUser
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastSeen,
+			&i.Disabled,
+			&i.DisplayName,
+			&i.AvatarUrl,
+			&i.Locale,
+			&i.Email,
+			&i.PhoneNumber,
+			&i.PasswordHash,
+			&i.EmailVerified,
+			&i.PhoneNumberVerified,
+			&i.NewEmail,
+			&i.OtpMethodLastUsed,
+			&i.OtpHash,
+			&i.OtpHashExpiresAt,
+			&i.DefaultRole,
+			&i.IsAnonymous,
+			&i.TotpSecret,
+			&i.ActiveMfaType,
+			&i.Ticket,
+			&i.TicketExpiresAt,
+			&i.Metadata,
+			&i.WebauthnCurrentChallenge,
+			&i.OtpHashAttempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementUserOTPHashAttempts = `-- name: IncrementUserOTPHashAttempts :one
+UPDATE auth.users
+SET otp_hash_attempts = otp_hash_attempts + 1
+WHERE id = $1 AND otp_hash_attempts < $2::INT
+RETURNING otp_hash_attempts
+`
+
+type IncrementUserOTPHashAttemptsParams struct {
+	ID          uuid.UUID
+	MaxAttempts int32
+}
+
+func (q *Queries) IncrementUserOTPHashAttempts(ctx context.Context, arg IncrementUserOTPHashAttemptsParams) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementUserOTPHashAttempts, arg.ID, arg.MaxAttempts)
+	var otp_hash_attempts int32
+	err := row.Scan(&otp_hash_attempts)
+	return otp_hash_attempts, err
+}
+
+const insertAuditEvent = `-- name: InsertAuditEvent :exec
+INSERT INTO auth.audit_events (user_id, event, ip_address, user_agent, trace_id, metadata)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertAuditEventParams struct {
+	UserID    uuid.UUID
+	Event     string
+	IpAddress pgtype.Text
+	UserAgent pgtype.Text
+	TraceID   pgtype.Text
+	Metadata  []byte
+}
+
+func (q *Queries) InsertAuditEvent(ctx context.Context, arg InsertAuditEventParams) error {
+	_, err := q.db.Exec(ctx, insertAuditEvent,
+		arg.UserID,
+		arg.Event,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.TraceID,
+		arg.Metadata,
+	)
+	return err
+}
+
+const insertMfaChallenge = `-- name: InsertMfaChallenge :exec
+INSERT INTO auth.mfa_challenges (user_id, ticket, mfa_type, otp_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertMfaChallengeParams struct {
+	UserID    uuid.UUID
+	Ticket    string
+	MfaType   string
+	OtpHash   string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertMfaChallenge(ctx context.Context, arg InsertMfaChallengeParams) error {
+	_, err := q.db.Exec(ctx, insertMfaChallenge,
+		arg.UserID,
+		arg.Ticket,
+		arg.MfaType,
+		arg.OtpHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertNotificationOutbox = `-- name: InsertNotificationOutbox :exec
+INSERT INTO auth.notification_outbox (kind, recipient, payload, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertNotificationOutboxParams struct {
+	Kind      string
+	Recipient string
+	Payload   []byte
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertNotificationOutbox(ctx context.Context, arg InsertNotificationOutboxParams) error {
+	_, err := q.db.Exec(ctx, insertNotificationOutbox,
+		arg.Kind,
+		arg.Recipient,
+		arg.Payload,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertOrganizationInvitation = `-- name: InsertOrganizationInvitation :one
+INSERT INTO auth.organization_invitations (organization_id, email, roles, invited_by, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at, organization_id, email, roles, invited_by, expires_at, accepted_at
+`
+
+type InsertOrganizationInvitationParams struct {
+	OrganizationID uuid.UUID
+	Email          string
+	Roles          []string
+	InvitedBy      pgtype.UUID
+	ExpiresAt      pgtype.Timestamptz
+}
+
+func (q *Queries) InsertOrganizationInvitation(ctx context.Context, arg InsertOrganizationInvitationParams) (AuthOrganizationInvitation, error) {
+	row := q.db.QueryRow(ctx, insertOrganizationInvitation,
+		arg.OrganizationID,
+		arg.Email,
+		arg.Roles,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	var i AuthOrganizationInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Roles,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.AcceptedAt,
+	)
+	return i, err
+}
+
+const insertOrganizationWithOwner = `-- name: InsertOrganizationWithOwner :one
+WITH inserted_organization AS (
+    INSERT INTO auth.organizations (name, slug, metadata)
+    VALUES ($1, $2, $3)
+    RETURNING id, created_at
+),
+inserted_member AS (
+    INSERT INTO auth.organization_members (organization_id, user_id, roles)
+    SELECT id, $4, $5::TEXT[] FROM inserted_organization
+)
+SELECT id, created_at FROM inserted_organization
+`
+
+type InsertOrganizationWithOwnerParams struct {
+	Name     string
+	Slug     string
+	Metadata []byte
+	UserID   uuid.UUID
+	Roles    []string
+}
+
+type InsertOrganizationWithOwnerRow struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertOrganizationWithOwner(ctx context.Context, arg InsertOrganizationWithOwnerParams) (InsertOrganizationWithOwnerRow, error) {
+	row := q.db.QueryRow(ctx, insertOrganizationWithOwner,
+		arg.Name,
+		arg.Slug,
+		arg.Metadata,
+		arg.UserID,
+		arg.Roles,
+	)
+	var i InsertOrganizationWithOwnerRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
 const insertRefreshtoken = `-- name: InsertRefreshtoken :one
 INSERT INTO auth.refresh_tokens (user_id, refresh_token_hash, expires_at, type, metadata)
 VALUES ($1, $2, $3, $4, $5)
@@ -566,7 +1318,7 @@ WITH inserted_user AS (
     ) VALUES (
       $1, $2, $3, $4, $5, $6, COALESCE($17, now()), $8, $9, $10, $11, $12, $13, $14, $15, $16
     )
-    RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
+    RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts
 )
 INSERT INTO auth.user_roles (user_id, role)
     SELECT inserted_user.id, roles.role
@@ -624,6 +1376,25 @@ func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (InsertU
 	return i, err
 }
 
+const insertUserImpersonation = `-- name: InsertUserImpersonation :one
+INSERT INTO auth.user_impersonations (user_id, impersonator, reason)
+VALUES ($1, $2, $3)
+RETURNING id
+`
+
+type InsertUserImpersonationParams struct {
+	UserID       uuid.UUID
+	Impersonator string
+	Reason       string
+}
+
+func (q *Queries) InsertUserImpersonation(ctx context.Context, arg InsertUserImpersonationParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertUserImpersonation, arg.UserID, arg.Impersonator, arg.Reason)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const insertUserProvider = `-- name: InsertUserProvider :one
 INSERT INTO auth.user_providers (user_id, provider_id, provider_user_id, access_token)
 VALUES ($1, $2, $3, 'unset')
@@ -1053,6 +1824,22 @@ func (q *Queries) InsertUserWithUserProviderAndRefreshToken(ctx context.Context,
 	return i, err
 }
 
+const insertWebhookOutbox = `-- name: InsertWebhookOutbox :exec
+INSERT INTO auth.webhook_outbox (event, url, payload)
+VALUES ($1, $2, $3)
+`
+
+type InsertWebhookOutboxParams struct {
+	Event   string
+	Url     string
+	Payload []byte
+}
+
+func (q *Queries) InsertWebhookOutbox(ctx context.Context, arg InsertWebhookOutboxParams) error {
+	_, err := q.db.Exec(ctx, insertWebhookOutbox, arg.Event, arg.Url, arg.Payload)
+	return err
+}
+
 const refreshTokenAndGetUserRoles = `-- name: RefreshTokenAndGetUserRoles :many
 WITH refreshed_token AS (
     UPDATE auth.refresh_tokens
@@ -1060,7 +1847,7 @@ WITH refreshed_token AS (
         expires_at = $2,
         refresh_token_hash = $1
     WHERE refresh_token_hash = $3
-    RETURNING id AS refresh_token_id, user_id
+    RETURNING id AS refresh_token_id, user_id, organization_id
 ),
 updated_user AS (
     UPDATE auth.users
@@ -1068,7 +1855,7 @@ updated_user AS (
     FROM refreshed_token
     WHERE auth.users.id = refreshed_token.user_id
 )
-SELECT refreshed_token.refresh_token_id, role FROM auth.user_roles
+SELECT refreshed_token.refresh_token_id, refreshed_token.organization_id, role FROM auth.user_roles
 RIGHT JOIN refreshed_token ON auth.user_roles.user_id = refreshed_token.user_id
 `
 
@@ -1080,6 +1867,7 @@ type RefreshTokenAndGetUserRolesParams struct {
 
 type RefreshTokenAndGetUserRolesRow struct {
 	RefreshTokenID uuid.UUID
+	OrganizationID pgtype.UUID
 	Role           pgtype.Text
 }
 
@@ -1092,7 +1880,7 @@ func (q *Queries) RefreshTokenAndGetUserRoles(ctx context.Context, arg RefreshTo
 	var items []RefreshTokenAndGetUserRolesRow
 	for rows.Next() {
 		var i RefreshTokenAndGetUserRolesRow
-		if err := rows.Scan(&i.RefreshTokenID, &i.Role); err != nil {
+		if err := rows.Scan(&i.RefreshTokenID, &i.OrganizationID, &i.Role); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1103,6 +1891,60 @@ func (q *Queries) RefreshTokenAndGetUserRoles(ctx context.Context, arg RefreshTo
 	return items, nil
 }
 
+const updateNotificationOutboxFailed = `-- name: UpdateNotificationOutboxFailed :exec
+UPDATE auth.notification_outbox
+SET status = $1, attempts = attempts + 1, next_attempt_at = $2,
+    last_error = $3,
+    payload = CASE WHEN $4::BOOLEAN THEN '{}'::JSONB ELSE payload END
+WHERE id = $5
+`
+
+type UpdateNotificationOutboxFailedParams struct {
+	Status        string
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+	ClearPayload  bool
+	ID            uuid.UUID
+}
+
+func (q *Queries) UpdateNotificationOutboxFailed(ctx context.Context, arg UpdateNotificationOutboxFailedParams) error {
+	_, err := q.db.Exec(ctx, updateNotificationOutboxFailed,
+		arg.Status,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.ClearPayload,
+		arg.ID,
+	)
+	return err
+}
+
+const updateNotificationOutboxSent = `-- name: UpdateNotificationOutboxSent :exec
+UPDATE auth.notification_outbox
+SET status = 'sent', attempts = attempts + 1, sent_at = now(), payload = '{}', last_error = NULL
+WHERE id = $1
+`
+
+func (q *Queries) UpdateNotificationOutboxSent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, updateNotificationOutboxSent, id)
+	return err
+}
+
+const updateRefreshTokenOrganization = `-- name: UpdateRefreshTokenOrganization :exec
+UPDATE auth.refresh_tokens
+SET organization_id = $2
+WHERE refresh_token_hash = $1
+`
+
+type UpdateRefreshTokenOrganizationParams struct {
+	RefreshTokenHash pgtype.Text
+	OrganizationID   pgtype.UUID
+}
+
+func (q *Queries) UpdateRefreshTokenOrganization(ctx context.Context, arg UpdateRefreshTokenOrganizationParams) error {
+	_, err := q.db.Exec(ctx, updateRefreshTokenOrganization, arg.RefreshTokenHash, arg.OrganizationID)
+	return err
+}
+
 const updateUserActiveMFAType = `-- name: UpdateUserActiveMFAType :exec
 UPDATE auth.users
 SET active_mfa_type = $2
@@ -1123,7 +1965,7 @@ const updateUserChangeEmail = `-- name: UpdateUserChangeEmail :one
 UPDATE auth.users
 SET (ticket, ticket_expires_at, new_email, email_verified) = ($2, $3, $4, true)
 WHERE id = $1
-RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts
 `
 
 type UpdateUserChangeEmailParams struct {
@@ -1167,6 +2009,7 @@ func (q *Queries) UpdateUserChangeEmail(ctx context.Context, arg UpdateUserChang
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
@@ -1194,7 +2037,7 @@ const updateUserConfirmChangeEmail = `-- name: UpdateUserConfirmChangeEmail :one
 UPDATE auth.users
 SET (email, new_email) = (new_email, null)
 WHERE id = $1
-RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts
 `
 
 func (q *Queries) UpdateUserConfirmChangeEmail(ctx context.Context, id uuid.UUID) (AuthUser, error) {
@@ -1226,6 +2069,7 @@ func (q *Queries) UpdateUserConfirmChangeEmail(ctx context.Context, id uuid.UUID
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
@@ -1296,7 +2140,7 @@ func (q *Queries) UpdateUserLastSeen(ctx context.Context, id uuid.UUID) (pgtype.
 
 const updateUserOTPHash = `-- name: UpdateUserOTPHash :one
 UPDATE auth.users
-SET (otp_hash, otp_hash_expires_at, otp_method_last_used) = ($2, $3, $4)
+SET (otp_hash, otp_hash_expires_at, otp_method_last_used, otp_hash_attempts) = ($2, $3, $4, 0)
 WHERE id = $1
 RETURNING id
 `
@@ -1320,6 +2164,22 @@ func (q *Queries) UpdateUserOTPHash(ctx context.Context, arg UpdateUserOTPHashPa
 	return id, err
 }
 
+const updateUserPhoneNumber = `-- name: UpdateUserPhoneNumber :exec
+UPDATE auth.users
+SET phone_number = $2
+WHERE id = $1
+`
+
+type UpdateUserPhoneNumberParams struct {
+	ID          uuid.UUID
+	PhoneNumber pgtype.Text
+}
+
+func (q *Queries) UpdateUserPhoneNumber(ctx context.Context, arg UpdateUserPhoneNumberParams) error {
+	_, err := q.db.Exec(ctx, updateUserPhoneNumber, arg.ID, arg.PhoneNumber)
+	return err
+}
+
 const updateUserTicket = `-- name: UpdateUserTicket :one
 UPDATE auth.users
 SET (ticket, ticket_expires_at) = ($2, $3)
@@ -1360,7 +2220,7 @@ const updateUserVerifyEmail = `-- name: UpdateUserVerifyEmail :one
 UPDATE auth.users
 SET email_verified = true
 WHERE id = $1
-RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts
 `
 
 func (q *Queries) UpdateUserVerifyEmail(ctx context.Context, id uuid.UUID) (AuthUser, error) {
@@ -1392,10 +2252,86 @@ func (q *Queries) UpdateUserVerifyEmail(ctx context.Context, id uuid.UUID) (Auth
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
+	)
+	return i, err
+}
+
+const updateUserVerifyPhoneNumber = `-- name: UpdateUserVerifyPhoneNumber :one
+UPDATE auth.users
+SET phone_number_verified = true, otp_hash = NULL, otp_hash_expires_at = now(), otp_hash_attempts = 0
+WHERE id = $1
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, otp_hash_attempts
+`
+
+func (q *Queries) UpdateUserVerifyPhoneNumber(ctx context.Context, id uuid.UUID) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserVerifyPhoneNumber, id)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.OtpHashAttempts,
 	)
 	return i, err
 }
 
+const updateWebhookOutboxDelivered = `-- name: UpdateWebhookOutboxDelivered :exec
+UPDATE auth.webhook_outbox
+SET status = 'delivered', attempts = attempts + 1, delivered_at = now(), last_error = NULL
+WHERE id = $1
+`
+
+func (q *Queries) UpdateWebhookOutboxDelivered(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, updateWebhookOutboxDelivered, id)
+	return err
+}
+
+const updateWebhookOutboxFailed = `-- name: UpdateWebhookOutboxFailed :exec
+UPDATE auth.webhook_outbox
+SET status = $2, attempts = attempts + 1, next_attempt_at = $3, last_error = $4
+WHERE id = $1
+`
+
+type UpdateWebhookOutboxFailedParams struct {
+	ID            uuid.UUID
+	Status        string
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+}
+
+func (q *Queries) UpdateWebhookOutboxFailed(ctx context.Context, arg UpdateWebhookOutboxFailedParams) error {
+	_, err := q.db.Exec(ctx, updateWebhookOutboxFailed,
+		arg.ID,
+		arg.Status,
+		arg.NextAttemptAt,
+		arg.LastError,
+	)
+	return err
+}
+
 const upsertRoles = `-- name: UpsertRoles :many
 INSERT INTO auth.roles (role)
 SELECT unnest($1::TEXT[])
@@ -0,0 +1,197 @@
+//go:generate mockgen -package mock -destination mock/dispatcher.go --source=dispatcher.go
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+
+	defaultBatchSize    = 50
+	defaultInterval     = 5 * time.Second
+	defaultMaxAttempts  = 10
+	defaultTimeout      = 10 * time.Second
+	defaultLease        = time.Minute
+	backoffBase         = 10 * time.Second
+	backoffMax          = time.Hour
+	maxErrorBodyLength  = 512
+	maxBackoffExponent  = 20
+	errorMessageNoMatch = "no subscription configured for url"
+)
+
+type DBClient interface {
+	ClaimWebhookOutbox(
+		ctx context.Context, arg sql.ClaimWebhookOutboxParams,
+	) ([]sql.AuthWebhookOutbox, error)
+	UpdateWebhookOutboxDelivered(ctx context.Context, id uuid.UUID) error
+	UpdateWebhookOutboxFailed(ctx context.Context, arg sql.UpdateWebhookOutboxFailedParams) error
+}
+
+// Dispatcher delivers the rows of auth.webhook_outbox to the subscribers. Rows are
+// claimed with a lease so several instances can run concurrently, failed deliveries
+// are retried with exponential backoff until MaxAttempts is reached.
+type Dispatcher struct {
+	db            DBClient
+	subscriptions map[string]Subscription
+	httpClient    *http.Client
+	logger        *slog.Logger
+	now           func() time.Time
+	Interval      time.Duration
+	BatchSize     int32
+	MaxAttempts   int32
+}
+
+func NewDispatcher(
+	db DBClient, subscriptions []Subscription, logger *slog.Logger,
+) *Dispatcher {
+	subs := make(map[string]Subscription, len(subscriptions))
+	for _, sub := range subscriptions {
+		subs[sub.URL] = sub
+	}
+
+	return &Dispatcher{
+		db:            db,
+		subscriptions: subs,
+		httpClient:    &http.Client{Timeout: defaultTimeout}, //nolint:exhaustruct
+		logger:        logger,
+		now:           time.Now,
+		Interval:      defaultInterval,
+		BatchSize:     defaultBatchSize,
+		MaxAttempts:   defaultMaxAttempts,
+	}
+}
+
+// Run dispatches pending deliveries every Interval until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Dispatch(ctx); err != nil {
+			d.logger.Error("error dispatching webhooks", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch claims a batch of pending deliveries and sends them.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	rows, err := d.db.ClaimWebhookOutbox(ctx, sql.ClaimWebhookOutboxParams{
+		LeaseUntil: sql.TimestampTz(d.now().Add(defaultLease)),
+		BatchSize:  d.BatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error claiming webhook deliveries: %w", err)
+	}
+
+	for _, row := range rows {
+		d.deliver(ctx, row)
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, row sql.AuthWebhookOutbox) {
+	logger := d.logger.With(
+		slog.String("delivery", row.ID.String()),
+		slog.String("event", row.Event),
+		slog.String("url", row.Url),
+	)
+
+	sub, ok := d.subscriptions[row.Url]
+	if !ok {
+		logger.Warn(errorMessageNoMatch)
+		d.markFailed(ctx, row, StatusFailed, errorMessageNoMatch, logger)
+		return
+	}
+
+	if err := d.send(ctx, sub, row); err != nil {
+		logger.Warn("error delivering webhook", slog.String("error", err.Error()))
+
+		status := StatusPending
+		if row.Attempts+1 >= d.MaxAttempts {
+			status = StatusFailed
+		}
+		d.markFailed(ctx, row, status, err.Error(), logger)
+
+		return
+	}
+
+	if err := d.db.UpdateWebhookOutboxDelivered(ctx, row.ID); err != nil {
+		logger.Error("error marking webhook as delivered", slog.String("error", err.Error()))
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, sub Subscription, row sql.AuthWebhookOutbox) error {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, sub.URL, bytes.NewReader(row.Payload),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, row.Event)
+	req.Header.Set(HeaderDelivery, row.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, row.Payload))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, b) //nolint:err113
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) markFailed(
+	ctx context.Context,
+	row sql.AuthWebhookOutbox,
+	status string,
+	msg string,
+	logger *slog.Logger,
+) {
+	if err := d.db.UpdateWebhookOutboxFailed(ctx, sql.UpdateWebhookOutboxFailedParams{
+		ID:            row.ID,
+		Status:        status,
+		NextAttemptAt: sql.TimestampTz(d.now().Add(Backoff(row.Attempts))),
+		LastError:     sql.Text(msg),
+	}); err != nil {
+		logger.Error("error marking webhook as failed", slog.String("error", err.Error()))
+	}
+}
+
+// Backoff returns the time to wait before the next attempt after the given number
+// of previous attempts.
+func Backoff(attempts int32) time.Duration {
+	if attempts > maxBackoffExponent {
+		return backoffMax
+	}
+
+	return min(backoffBase<<attempts, backoffMax)
+}
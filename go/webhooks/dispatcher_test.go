@@ -0,0 +1,223 @@
+package webhooks_test
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+	"github.com/nhost/hasura-auth/go/webhooks/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type receiver struct {
+	statusCode int
+	requests   []*http.Request
+	bodies     [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	b, _ := io.ReadAll(req.Body)
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, b)
+	w.WriteHeader(r.statusCode)
+}
+
+func TestDispatcherDispatch(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	deliveryID := uuid.MustParse("a9a8e2e5-9b2f-4b9d-8d1b-6e1c3f7f6f3e")
+	payload := []byte(`{"event":"user.signed-up"}`)
+
+	cases := []struct {
+		name        string
+		statusCode  int
+		unsubscribe bool
+		dbFn        func(ctrl *gomock.Controller, url string) *mock.MockDBClient
+		requests    int
+	}{
+		{
+			name:        "delivered",
+			statusCode:  http.StatusNoContent,
+			unsubscribe: false,
+			dbFn: func(ctrl *gomock.Controller, url string) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().ClaimWebhookOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthWebhookOutbox{
+						{ //nolint:exhaustruct
+							ID:      deliveryID,
+							Event:   string(webhooks.EventUserSignedUp),
+							Url:     url,
+							Payload: payload,
+							Status:  webhooks.StatusPending,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateWebhookOutboxDelivered(gomock.Any(), deliveryID).Return(nil)
+				return mock
+			},
+			requests: 1,
+		},
+		{
+			name:        "receiver fails, retried",
+			statusCode:  http.StatusInternalServerError,
+			unsubscribe: false,
+			dbFn: func(ctrl *gomock.Controller, url string) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().ClaimWebhookOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthWebhookOutbox{
+						{ //nolint:exhaustruct
+							ID:       deliveryID,
+							Event:    string(webhooks.EventUserSignedUp),
+							Url:      url,
+							Payload:  payload,
+							Status:   webhooks.StatusPending,
+							Attempts: 2,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateWebhookOutboxFailed(
+					gomock.Any(), gomock.Cond(func(arg sql.UpdateWebhookOutboxFailedParams) bool {
+						return arg.ID == deliveryID &&
+							arg.Status == webhooks.StatusPending &&
+							arg.LastError.Valid &&
+							arg.NextAttemptAt.Time.After(time.Now().Add(30*time.Second))
+					}),
+				).Return(nil)
+				return mock
+			},
+			requests: 1,
+		},
+		{
+			name:        "receiver fails, max attempts reached",
+			statusCode:  http.StatusBadRequest,
+			unsubscribe: false,
+			dbFn: func(ctrl *gomock.Controller, url string) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().ClaimWebhookOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthWebhookOutbox{
+						{ //nolint:exhaustruct
+							ID:       deliveryID,
+							Event:    string(webhooks.EventUserSignedUp),
+							Url:      url,
+							Payload:  payload,
+							Status:   webhooks.StatusPending,
+							Attempts: 9,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateWebhookOutboxFailed(
+					gomock.Any(), gomock.Cond(func(arg sql.UpdateWebhookOutboxFailedParams) bool {
+						return arg.ID == deliveryID && arg.Status == webhooks.StatusFailed
+					}),
+				).Return(nil)
+				return mock
+			},
+			requests: 1,
+		},
+		{
+			name:        "subscription removed",
+			statusCode:  http.StatusOK,
+			unsubscribe: true,
+			dbFn: func(ctrl *gomock.Controller, url string) *mock.MockDBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().ClaimWebhookOutbox(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthWebhookOutbox{
+						{ //nolint:exhaustruct
+							ID:      deliveryID,
+							Event:   string(webhooks.EventUserSignedUp),
+							Url:     url,
+							Payload: payload,
+							Status:  webhooks.StatusPending,
+						},
+					}, nil,
+				)
+				mock.EXPECT().UpdateWebhookOutboxFailed(
+					gomock.Any(), gomock.Cond(func(arg sql.UpdateWebhookOutboxFailedParams) bool {
+						return arg.ID == deliveryID && arg.Status == webhooks.StatusFailed
+					}),
+				).Return(nil)
+				return mock
+			},
+			requests: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recv := &receiver{statusCode: tc.statusCode, requests: nil, bodies: nil}
+			server := httptest.NewServer(recv)
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+
+			var subscriptions []webhooks.Subscription
+			if !tc.unsubscribe {
+				subscriptions = []webhooks.Subscription{
+					{URL: server.URL, Events: nil, Secret: "secret"},
+				}
+			}
+
+			dispatcher := webhooks.NewDispatcher(
+				tc.dbFn(ctrl, server.URL), subscriptions, slog.Default(),
+			)
+			if err := dispatcher.Dispatch(t.Context()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(recv.requests) != tc.requests {
+				t.Fatalf("expected %d requests, got %d", tc.requests, len(recv.requests))
+			}
+
+			for i, req := range recv.requests {
+				if req.Header.Get(webhooks.HeaderEvent) != string(webhooks.EventUserSignedUp) {
+					t.Errorf("unexpected event header: %s", req.Header.Get(webhooks.HeaderEvent))
+				}
+				if req.Header.Get(webhooks.HeaderDelivery) != deliveryID.String() {
+					t.Errorf(
+						"unexpected delivery header: %s", req.Header.Get(webhooks.HeaderDelivery),
+					)
+				}
+
+				timestamp, err := strconv.ParseInt(req.Header.Get(webhooks.HeaderTimestamp), 10, 64)
+				if err != nil {
+					t.Fatalf("invalid timestamp header: %v", err)
+				}
+				if !webhooks.Verify(
+					"secret", timestamp, recv.bodies[i], req.Header.Get(webhooks.HeaderSignature),
+				) {
+					t.Errorf("invalid signature")
+				}
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		attempts int32
+		expected time.Duration
+	}{
+		{attempts: 0, expected: 10 * time.Second},
+		{attempts: 1, expected: 20 * time.Second},
+		{attempts: 5, expected: 320 * time.Second},
+		{attempts: 10, expected: time.Hour},
+		{attempts: 100, expected: time.Hour},
+	}
+
+	for _, tc := range cases {
+		if got := webhooks.Backoff(tc.attempts); got != tc.expected {
+			t.Errorf("attempts %d: expected %s, got %s", tc.attempts, tc.expected, got)
+		}
+	}
+}
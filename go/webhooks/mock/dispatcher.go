@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dispatcher.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination mock/dispatcher.go --source=dispatcher.go
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	sql "github.com/nhost/hasura-auth/go/sql"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDBClient is a mock of DBClient interface.
+type MockDBClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBClientMockRecorder
+	isgomock struct{}
+}
+
+// MockDBClientMockRecorder is the mock recorder for MockDBClient.
+type MockDBClientMockRecorder struct {
+	mock *MockDBClient
+}
+
+// NewMockDBClient creates a new mock instance.
+func NewMockDBClient(ctrl *gomock.Controller) *MockDBClient {
+	mock := &MockDBClient{ctrl: ctrl}
+	mock.recorder = &MockDBClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBClient) EXPECT() *MockDBClientMockRecorder {
+	return m.recorder
+}
+
+// ClaimWebhookOutbox mocks base method.
+func (m *MockDBClient) ClaimWebhookOutbox(ctx context.Context, arg sql.ClaimWebhookOutboxParams) ([]sql.AuthWebhookOutbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookOutbox", ctx, arg)
+	ret0, _ := ret[0].([]sql.AuthWebhookOutbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookOutbox indicates an expected call of ClaimWebhookOutbox.
+func (mr *MockDBClientMockRecorder) ClaimWebhookOutbox(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookOutbox", reflect.TypeOf((*MockDBClient)(nil).ClaimWebhookOutbox), ctx, arg)
+}
+
+// UpdateWebhookOutboxDelivered mocks base method.
+func (m *MockDBClient) UpdateWebhookOutboxDelivered(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookOutboxDelivered", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookOutboxDelivered indicates an expected call of UpdateWebhookOutboxDelivered.
+func (mr *MockDBClientMockRecorder) UpdateWebhookOutboxDelivered(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookOutboxDelivered", reflect.TypeOf((*MockDBClient)(nil).UpdateWebhookOutboxDelivered), ctx, id)
+}
+
+// UpdateWebhookOutboxFailed mocks base method.
+func (m *MockDBClient) UpdateWebhookOutboxFailed(ctx context.Context, arg sql.UpdateWebhookOutboxFailedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookOutboxFailed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookOutboxFailed indicates an expected call of UpdateWebhookOutboxFailed.
+func (mr *MockDBClientMockRecorder) UpdateWebhookOutboxFailed(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookOutboxFailed", reflect.TypeOf((*MockDBClient)(nil).UpdateWebhookOutboxFailed), ctx, arg)
+}
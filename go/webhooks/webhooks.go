@@ -0,0 +1,109 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Event string
+
+const (
+	EventUserSignedUp        Event = "user.signed-up"
+	EventUserEmailVerified   Event = "user.email-verified"
+	EventUserPasswordChanged Event = "user.password-changed"
+	EventUserDeanonymized    Event = "user.deanonymized"
+	EventUserDeleted         Event = "user.deleted"
+)
+
+const (
+	HeaderEvent     = "X-Hasura-Auth-Event"
+	HeaderDelivery  = "X-Hasura-Auth-Delivery"
+	HeaderTimestamp = "X-Hasura-Auth-Timestamp"
+	HeaderSignature = "X-Hasura-Auth-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	ErrMissingURL    = errors.New("webhook subscription is missing the url")
+	ErrMissingSecret = errors.New("webhook subscription is missing the secret")
+)
+
+// Subscription describes a receiver of webhook events. If Events is empty the
+// receiver is subscribed to all events.
+type Subscription struct {
+	URL    string  `json:"url"`
+	Events []Event `json:"events"`
+	Secret string  `json:"secret"`
+}
+
+func (s Subscription) Matches(event Event) bool {
+	return len(s.Events) == 0 || slices.Contains(s.Events, event)
+}
+
+// ParseSubscriptions parses a JSON array of subscriptions. An empty string
+// returns no subscriptions.
+func ParseSubscriptions(s string) ([]Subscription, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var subscriptions []Subscription
+	if err := json.Unmarshal([]byte(s), &subscriptions); err != nil {
+		return nil, fmt.Errorf("error unmarshalling webhook subscriptions: %w", err)
+	}
+
+	for _, sub := range subscriptions {
+		if sub.URL == "" {
+			return nil, ErrMissingURL
+		}
+		if sub.Secret == "" {
+			return nil, fmt.Errorf("%w: %s", ErrMissingSecret, sub.URL)
+		}
+	}
+
+	return subscriptions, nil
+}
+
+// Payload is the JSON body sent to the subscribers.
+type Payload struct {
+	ID        uuid.UUID      `json:"id"`
+	Event     Event          `json:"event"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Data      map[string]any `json:"data"`
+}
+
+func NewPayload(event Event, data map[string]any) Payload {
+	return Payload{
+		ID:        uuid.New(),
+		Event:     event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+}
+
+// Sign returns the value of the signature header for the given body. The signed
+// content is the unix timestamp and the body joined by a dot so receivers can
+// reject replayed deliveries.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature generated with Sign in constant time.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,118 @@
+package webhooks_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+func TestParseSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		input       string
+		expected    []webhooks.Subscription
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			input:       "",
+			expected:    nil,
+			expectedErr: nil,
+		},
+		{
+			name: "success",
+			input: `[
+				{"url": "https://a.example.com", "events": ["user.signed-up"], "secret": "a"},
+				{"url": "https://b.example.com", "secret": "b"}
+			]`,
+			expected: []webhooks.Subscription{
+				{
+					URL:    "https://a.example.com",
+					Events: []webhooks.Event{webhooks.EventUserSignedUp},
+					Secret: "a",
+				},
+				{
+					URL:    "https://b.example.com",
+					Events: nil,
+					Secret: "b",
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "missing url",
+			input:       `[{"secret": "a"}]`,
+			expected:    nil,
+			expectedErr: webhooks.ErrMissingURL,
+		},
+		{
+			name:        "missing secret",
+			input:       `[{"url": "https://a.example.com"}]`,
+			expected:    nil,
+			expectedErr: webhooks.ErrMissingSecret,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := webhooks.ParseSubscriptions(tc.input)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected subscriptions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubscriptionMatches(t *testing.T) {
+	t.Parallel()
+
+	all := webhooks.Subscription{URL: "https://a.example.com", Events: nil, Secret: "a"}
+	if !all.Matches(webhooks.EventUserDeleted) {
+		t.Errorf("subscription without events should match all events")
+	}
+
+	some := webhooks.Subscription{
+		URL:    "https://a.example.com",
+		Events: []webhooks.Event{webhooks.EventUserSignedUp},
+		Secret: "a",
+	}
+	if !some.Matches(webhooks.EventUserSignedUp) {
+		t.Errorf("expected subscription to match %s", webhooks.EventUserSignedUp)
+	}
+	if some.Matches(webhooks.EventUserDeleted) {
+		t.Errorf("expected subscription not to match %s", webhooks.EventUserDeleted)
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"event":"user.signed-up"}`)
+	// echo -n '1700000000.{"event":"user.signed-up"}' | openssl dgst -sha256 -hmac secret
+	expected := "sha256=a75c9e910a93e9302636119b79adae7803a648b73107c8db80e4f07da78b28a0"
+
+	got := webhooks.Sign("secret", 1700000000, body)
+	if got != expected {
+		t.Fatalf("expected signature %s, got %s", expected, got)
+	}
+
+	if !webhooks.Verify("secret", 1700000000, body, got) {
+		t.Errorf("signature should verify")
+	}
+	if webhooks.Verify("other", 1700000000, body, got) {
+		t.Errorf("signature should not verify with a different secret")
+	}
+	if webhooks.Verify("secret", 1700000001, body, got) {
+		t.Errorf("signature should not verify with a different timestamp")
+	}
+}